@@ -44,6 +44,10 @@ type Config struct {
 	// ServerDbcDir holds DBC files used by the TrinityCore server.
 	ServerDbcDir string
 
+	// MetaDir holds workspace DBC meta overrides (*.meta.json). These take
+	// precedence over per-mod meta/ directories and the embedded metas.
+	MetaDir string
+
 	// DockerComposeFile is the path to the generated docker-compose.yml.
 	DockerComposeFile string
 
@@ -54,6 +58,14 @@ type Config struct {
 	// Must be uppercase A-Z. Defaults to "M".
 	PatchLetter string
 
+	// DBCFidelity re-encodes built DBCs against their baseline files so that
+	// unchanged records keep their original bytes. Off by default.
+	DBCFidelity bool
+
+	// DBCConflict decides which mod wins when two mods change the same DBC
+	// field differently: "last" (default), "first", or "error".
+	DBCConflict string
+
 	// MySQL credentials.
 	MySQLRootPassword string
 	MySQLUser         string
@@ -77,9 +89,11 @@ func DefaultConfig() *Config {
 		BaselineAddonsDir: filepath.Join(dir, "modules", "baseline", "addons"),
 		ModulesBuildDir:   filepath.Join(dir, "modules", "build"),
 		ServerDbcDir:      filepath.Join(dir, "data", "dbc"),
+		MetaDir:           filepath.Join(dir, "meta"),
 		DockerComposeFile: filepath.Join(dir, "docker-compose.yml"),
 		DockerProjectName: "mithril",
 		PatchLetter:       "M",
+		DBCConflict:       "last",
 		MySQLRootPassword: "mithril",
 		MySQLUser:         "trinity",
 		MySQLPassword:     "trinity",
@@ -94,6 +108,8 @@ func DefaultConfig() *Config {
 // workspaceConfig represents the user-editable settings in mithril.json.
 type workspaceConfig struct {
 	PatchLetter string `json:"patch_letter,omitempty"`
+	DBCFidelity bool   `json:"dbc_fidelity,omitempty"`
+	DBCConflict string `json:"dbc_conflict,omitempty"`
 }
 
 // loadWorkspaceConfig reads mithril-data/mithril.json and applies overrides.
@@ -109,6 +125,19 @@ func (c *Config) loadWorkspaceConfig() {
 	if letter := strings.TrimSpace(wc.PatchLetter); letter != "" {
 		c.PatchLetter = strings.ToUpper(letter)
 	}
+	c.DBCFidelity = wc.DBCFidelity
+	if policy := strings.ToLower(strings.TrimSpace(wc.DBCConflict)); policy != "" {
+		c.DBCConflict = policy
+	}
+}
+
+// FidelityBaselineDir returns the baseline DBC directory to re-encode exports
+// against when DBCFidelity is on, or "" when it is off.
+func (c *Config) FidelityBaselineDir() string {
+	if !c.DBCFidelity {
+		return ""
+	}
+	return c.BaselineDbcDir
 }
 
 // ModDir returns the directory for a named mod.
@@ -161,7 +161,7 @@ if [ ! -d "/var/lib/mysql/mysql" ]; then
 fi
 
 echo "Starting MySQL..."
-mysqld --user=mysql --datadir=/var/lib/mysql --bind-address=0.0.0.0 &
+mysqld --user=mysql --datadir=/var/lib/mysql --bind-address=0.0.0.0 --local-infile=1 &
 
 echo "Waiting for MySQL..."
 for i in $(seq 1 60); do
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// lookupSource is a directory of DBC files searched by lookup: the baseline
+// or one mod's build output.
+type lookupSource struct {
+	name string // "baseline" or the mod name
+	dir  string
+}
+
+// runLookup searches the string and Loc columns of every baseline and
+// mod-built DBC for text, without MySQL. Parsed strings are cached in an
+// index under modules/build/, so only files that changed since the last
+// lookup are parsed again.
+func runLookup(args []string) error {
+	format, args, err := parseQueryFormat(args)
+	if err != nil {
+		return err
+	}
+	modName, args := parseModFlag(args)
+	table, args := parseStringFlag(args, "table")
+	limitFlag, args := parseStringFlag(args, "limit")
+	exact, rebuild := false, false
+	var terms []string
+	for _, a := range args {
+		switch a {
+		case "--exact":
+			exact = true
+		case "--rebuild":
+			rebuild = true
+		default:
+			terms = append(terms, a)
+		}
+	}
+	if len(terms) == 0 {
+		return fmt.Errorf("usage: mithril lookup <text> [--table <table>] [--mod <mod>] [--exact] [--limit <n>] [--format <format>] [--rebuild]")
+	}
+	query := strings.Join(terms, " ")
+	limit := 100
+	if limitFlag != "" {
+		if limit, err = strconv.Atoi(limitFlag); err != nil || limit < 0 {
+			return fmt.Errorf("invalid --limit %q", limitFlag)
+		}
+	}
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+	configureMetaDirs(cfg)
+
+	// The baseline is always indexed: mod hits are only shown where they
+	// differ from it.
+	sources := []lookupSource{{name: "baseline", dir: cfg.BaselineDbcDir}}
+	mods := getAllMods(cfg)
+	if modName != "" {
+		if !fileExists(cfg.ModDir(modName)) {
+			return fmt.Errorf("mod not found: %s", modName)
+		}
+		mods = []string{modName}
+	}
+	for _, mod := range mods {
+		sources = append(sources, lookupSource{name: mod, dir: filepath.Join(cfg.ModulesBuildDir, mod, "DBFilesClient")})
+	}
+
+	indexPath := filepath.Join(cfg.ModulesBuildDir, "lookup.idx")
+	idx := dbc.LoadStringIndex(indexPath)
+	if rebuild {
+		idx.Files = make(map[string]*dbc.IndexedFile)
+	}
+
+	type sourceFile struct {
+		source string
+		path   string
+	}
+	var files []sourceFile
+	keep := make(map[string]bool)
+	changed, noMeta := 0, 0
+	announced := false
+	for _, src := range sources {
+		paths, err := findRawDBCFiles(src.dir)
+		if err != nil {
+			return fmt.Errorf("list %s: %w", src.dir, err)
+		}
+		for _, path := range paths {
+			meta, err := dbc.GetMetaForDBC(filepath.Base(path))
+			if err != nil {
+				noMeta++
+				continue
+			}
+			if table != "" && !lookupTableMatches(meta, table) {
+				continue
+			}
+			if !announced {
+				if _, ok := idx.Files[path]; !ok {
+					fmt.Fprintln(os.Stderr, "Indexing DBC strings (later lookups reuse the index)...")
+					announced = true
+				}
+			}
+			updated, err := idx.Update(path, meta)
+			if err != nil {
+				printWarning(fmt.Sprintf("%s (%s): %v", filepath.Base(path), src.name, err))
+				continue
+			}
+			if updated {
+				changed++
+			}
+			keep[path] = true
+			files = append(files, sourceFile{source: src.name, path: path})
+		}
+	}
+	if table != "" && len(files) == 0 {
+		return fmt.Errorf("no DBC with a meta matches --table %s", table)
+	}
+
+	// Only prune when every source was looked at, so a filtered lookup
+	// doesn't throw away the rest of the index.
+	if changed > 0 || (table == "" && modName == "" && idx.Prune(keep)) {
+		if err := idx.Save(indexPath); err != nil {
+			printWarning(fmt.Sprintf("could not save lookup index: %v", err))
+		}
+	}
+
+	res := &queryResult{
+		cols:    []string{"table", "key", "column", "source", "text"},
+		numeric: make([]bool, 5),
+	}
+	baselineHits := make(map[string]bool)
+	total := 0
+	for _, f := range files {
+		indexed := idx.Files[f.path]
+		for _, hit := range indexed.Search(query, exact) {
+			id := indexed.File + "\x00" + hit.Key + "\x00" + hit.Column + "\x00" + hit.Text
+			if f.source == "baseline" {
+				baselineHits[strings.ToLower(id)] = true
+			} else if baselineHits[strings.ToLower(id)] {
+				continue // the mod didn't change this string
+			}
+			total++
+			if limit > 0 && total > limit {
+				continue
+			}
+			res.rows = append(res.rows, []sql.NullString{
+				{String: indexed.File, Valid: true},
+				{String: hit.Key, Valid: true},
+				{String: hit.Column, Valid: true},
+				{String: f.source, Valid: true},
+				{String: hit.Text, Valid: true},
+			})
+		}
+	}
+
+	if total == 0 {
+		fmt.Printf("No DBC strings match %q\n", query)
+	} else if err := writeQueryResult(os.Stdout, res, format); err != nil {
+		return err
+	}
+	if limit > 0 && total > limit {
+		fmt.Fprintf(os.Stderr, "Showing the first %d of %d matches — narrow the search with --table or raise --limit\n", limit, total)
+	}
+	if noMeta > 0 && table == "" {
+		fmt.Fprintf(os.Stderr, "%d DBC file(s) without a meta were not searched\n", noMeta)
+	}
+	return nil
+}
+
+// lookupTableMatches reports whether --table names a meta, by DBC file name
+// (with or without .dbc) or SQL table name.
+func lookupTableMatches(meta *dbc.MetaFile, table string) bool {
+	t := strings.ToLower(strings.TrimSuffix(strings.ToLower(table), ".dbc"))
+	return t == strings.ToLower(strings.TrimSuffix(meta.File, ".dbc")) || t == dbc.TableName(meta)
+}
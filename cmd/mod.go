@@ -21,15 +21,57 @@ Commands:
   remove <name>             Remove a mod (directory, build order, tracker entries)
   list                      List all mods and their status
   status [--mod <name>]     Show which DBCs a mod has changed
-  build                     Build combined patch MPQ from all mods
+  build [--mod <name>]... [--only|--skip dbc,addons,scripts,core,sql] [--plan]
+        [--skip-validate] [--fidelity] [--conflict last|first|error] [--locked]
+                            Build combined patch MPQ from all mods (--mod: only
+                            rebuild those, reuse the others' last build;
+                            --plan: print what would be done, change nothing;
+                            --locked: refuse inputs that differ from mithril.lock)
+  build rollback [--to <generation>] [--list]
+                            Restore the previous deployment of patches and server DBCs
 
   dbc create <name> --mod <mod>
                             Create a DBC SQL migration (shorthand for sql create --db dbc)
   dbc remove <migration> --mod <mod>
                             Remove a DBC SQL migration
-  dbc import                Import baseline DBCs into MySQL
-  dbc query "<SQL>"         Run ad-hoc SQL against the DBC database
-  dbc export                Export modified DBC tables to .dbc files
+  dbc import [--force] [--jobs <n>]
+                            Import baseline DBCs into MySQL
+  dbc query "<SQL>" [--decode] [--format table|tsv|csv|json|markdown]
+                            Run ad-hoc SQL against the DBC database (--decode: enum/flag names)
+  dbc shell [--decode] [--format <format>]
+                            Interactive SQL prompt with completion and \d <table>
+  dbc show <table> <id> [--mod <mod>] [--all]
+                            Print one record, with enum and flag values by name
+  dbc export [--fidelity]   Export modified DBC tables to .dbc files
+  dbc dump <table> [--format csv|json] [--mod <mod>] [-o <file>]
+                            Dump a baseline DBC as CSV/JSON (no MySQL needed)
+  dbc load <file> [--table <table>] [-o <out.dbc>] [--fidelity]
+                            Build a .dbc from a CSV/JSON file (no MySQL needed)
+  dbc compile --mod <mod> [--rollback] [<file.yaml>...]
+                            Print the SQL a mod's dbc/*.yaml edits compile to
+  dbc diff [--mod <mod>] [<table>]
+                            Show added/removed/changed records vs. baseline
+  dbc capture <name> --mod <mod> [--table <table>]
+                            Generate a migration pair from ad-hoc DBC edits
+  dbc infer <File.dbc> [--mod <mod>] [-o <file>]
+                            Draft a meta.json schema for a DBC without one
+  dbc coverage [-v] [<File.dbc>...]
+                            Check every baseline DBC against its meta (sizes, strings)
+  dbc validate [--mod <mod>] [<table>]
+                            Check built DBCs for dangling cross-table references
+  dbc verify-roundtrip [--offline] [<table>]
+                            Check that untouched baseline DBCs re-export byte-for-byte
+  dbc reserve [<table> <count> --mod <mod>]
+                            Reserve a range of new IDs for a mod (no args: list)
+  dbc l10n export --mod <mod> --lang <lang> [--format po|xliff] [-o <file>] [<table>]
+                            Export a mod's new/changed Loc strings for translation
+  dbc l10n import <file> --mod <mod> [--name <migration>]
+                            Generate a DBC migration from a translated PO/XLIFF file
+
+  spell clone <srcId> --mod <mod> [--id <id>] [--set <field>=<value>]...
+        [--clone icon,visual,duration,range|all] [--script <ScriptName>]
+                            Copy a spell into a new ID as a DBC migration pair
+                            (no MySQL needed)
 
   addon create <path> --mod <name>
                             Copy a baseline addon file into a mod for editing
@@ -90,10 +132,16 @@ Commands:
 Examples:
   mithril mod create my-spell-mod
   mithril mod dbc create rename_spell --mod my-spell-mod
+  mithril mod dbc dump Spell --format csv --mod my-spell-mod
+  mithril mod spell clone 133 --mod my-spell-mod --set spell_name_enus="Big Fireball" --clone icon
   mithril mod addon create Interface/FrameXML/SpellBookFrame.lua --mod my-mod
   mithril mod patch create my-fix --mod my-mod
   mithril mod core create enable-feature --mod my-mod
   mithril mod build
+  mithril mod build --mod my-mod --only addons
+  mithril mod build --plan
+  mithril mod build --locked
+  mithril mod build rollback
   mithril mod remove my-spell-mod
 `
 
@@ -103,6 +151,7 @@ Examples:
 type ModMeta struct {
 	Name        string `json:"name"`
 	Description string `json:"description,omitempty"`
+	Version     string `json:"version,omitempty"`
 	CreatedAt   string `json:"created_at"`
 }
 
@@ -113,6 +162,8 @@ func runMod(args []string) error {
 		return nil
 	}
 
+	configureMetaDirs(DefaultConfig())
+
 	switch args[0] {
 	case "init":
 		return runModInit(args[1:])
@@ -129,7 +180,7 @@ func runMod(args []string) error {
 	case "dbc":
 		if len(args) < 2 {
 			fmt.Print(modUsage)
-			return fmt.Errorf("mod dbc requires a subcommand: create, import, query, export, remove")
+			return fmt.Errorf("mod dbc requires a subcommand: create, import, query, export, remove, dump, load, diff, capture, infer, validate, verify-roundtrip, reserve, l10n, show, coverage, shell, compile")
 		}
 		return runModDBC(args[1], args[2:])
 	case "addon":
@@ -162,6 +213,12 @@ func runMod(args []string) error {
 			return fmt.Errorf("mod script requires a subcommand: create, list, remove")
 		}
 		return runModScript(args[1], args[2:])
+	case "spell":
+		if len(args) < 2 {
+			fmt.Print(modUsage)
+			return fmt.Errorf("mod spell requires a subcommand: clone")
+		}
+		return runModSpell(args[1], args[2:])
 	case "registry":
 		if len(args) < 2 {
 			fmt.Print(modUsage)
@@ -277,6 +334,10 @@ func runModRemove(args []string) error {
 	if len(appliedMigrations) > 0 {
 		fmt.Printf("  • Roll back %d applied SQL migration(s)\n", len(appliedMigrations))
 	}
+	hadDBCEdits := hasDBCEditState(cfg, modName)
+	if hadDBCEdits {
+		fmt.Printf("  • Roll back its YAML DBC edits\n")
+	}
 	if len(appliedCorePatches) > 0 {
 		fmt.Printf("  • Reverse %d applied core patch(es) from TrinityCore source\n", len(appliedCorePatches))
 	}
@@ -323,13 +384,13 @@ func runModRemove(args []string) error {
 				a := appliedMigrations[i]
 				rollbackFile := strings.TrimSuffix(a.File, ".sql") + ".rollback.sql"
 				rollbackPath := filepath.Join(cfg.ModDir(modName), "sql", a.Database, rollbackFile)
-				data, err := os.ReadFile(rollbackPath)
+				data, err := readMigrationSQL(cfg, modName, a.Database, rollbackPath)
 				if err != nil {
 					fmt.Printf("  ⚠ No rollback file for %s (%s) — skipping\n", a.File, a.Database)
 					continue
 				}
 				fmt.Printf("  Rolling back %s/%s... ", a.Database, a.File)
-				if err := execSQL(cfg, containerID, a.Database, string(data)); err != nil {
+				if err := execSQL(cfg, containerID, a.Database, data); err != nil {
 					fmt.Printf("⚠ failed: %v\n", err)
 				} else {
 					fmt.Println("✓")
@@ -343,6 +404,25 @@ func runModRemove(args []string) error {
 		}
 	}
 
+	// Roll back YAML DBC edits applied to the dbc database
+	if hadDBCEdits {
+		db, err := openDBCDB(cfg)
+		if err == nil {
+			fmt.Println()
+			fmt.Println("Rolling back YAML DBC edits...")
+			n, err := rollbackModDBCEdits(cfg, modName, db)
+			db.Close()
+			if err != nil {
+				fmt.Printf("  ⚠ %v\n", err)
+			} else {
+				fmt.Printf("  ✓ Rolled back %d edit file(s)\n", n)
+			}
+		} else {
+			fmt.Println()
+			fmt.Printf("  ⚠ Cannot connect to the dbc database — its YAML DBC edits will remain there.\n")
+		}
+	}
+
 	// Reverse applied core patches inside the container
 	if len(appliedCorePatches) > 0 {
 		containerID, err := composeContainerID(cfg)
@@ -417,6 +497,11 @@ func runModRemove(args []string) error {
 		fmt.Printf("  ⚠ Failed to update build order: %v\n", err)
 	}
 
+	// Release its DBC ID reservations
+	if err := releaseModReservations(cfg, modName); err != nil {
+		fmt.Printf("  ⚠ Failed to release ID reservations: %v\n", err)
+	}
+
 	// Clean up SQL tracker entries
 	if len(appliedMigrations) > 0 {
 		var kept []AppliedMigration
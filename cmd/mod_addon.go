@@ -296,7 +296,7 @@ func runModAddonEdit(args []string) error {
 	}
 
 	fmt.Println("File saved.")
-	fmt.Printf("Run 'mithril mod build --mod %s' to build the patch MPQs.\n", modName)
+	fmt.Printf("Run 'mithril mod build --mod %s --only addons' to build the patch MPQs.\n", modName)
 
 	return nil
 }
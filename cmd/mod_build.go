@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"crypto/md5"
+	"database/sql"
 	"encoding/hex"
 	"fmt"
 	"os"
@@ -21,65 +22,210 @@ type builtFile struct {
 }
 
 func runModBuild(args []string) error {
+	if len(args) > 0 && args[0] == "rollback" {
+		return runModBuildRollback(args[1:])
+	}
 	cfg := DefaultConfig()
 
+	conflict, args := parseStringFlag(args, "conflict")
+	if conflict != "" {
+		cfg.DBCConflict = strings.ToLower(conflict)
+	}
+	if !validDBCConflictPolicy(cfg.DBCConflict) {
+		return fmt.Errorf("invalid DBC conflict policy %q (use last, first or error)", cfg.DBCConflict)
+	}
+
+	modNames, args := parseModFlags(args)
+	only, args := parseStringFlag(args, "only")
+	skip, args := parseStringFlag(args, "skip")
+	phases, err := parseBuildPhases(only, skip)
+	if err != nil {
+		return err
+	}
+
+	skipValidate, plan, locked := false, false, false
+	for _, a := range args {
+		if a == "--skip-validate" {
+			skipValidate = true
+		}
+		if a == "--plan" {
+			plan = true
+		}
+		if a == "--locked" {
+			locked = true
+		}
+		if a == "--fidelity" {
+			cfg.DBCFidelity = true
+		}
+	}
+
 	// Ensure baseline exists
 	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
 		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
 	}
 
+	modsToBuild, err := selectBuildMods(cfg, modNames)
+	if err != nil {
+		return err
+	}
+
+	allMods := getAllMods(cfg)
+	if plan && len(allMods) > 0 {
+		return printBuildPlan(cfg, allMods, modsToBuild, phases)
+	}
+
 	fmt.Println("=== Mithril Mod Build ===")
 
-	// Always build all mods
-	modsToBuild := getAllMods(cfg)
-	if len(modsToBuild) == 0 {
+	if len(allMods) == 0 {
 		fmt.Println("No mods found. Create one with 'mithril mod create <name>'.")
 		return nil
 	}
+	selected := make(map[string]bool, len(modsToBuild))
+	for _, mod := range modsToBuild {
+		selected[mod] = true
+	}
+	if !phases.all() {
+		fmt.Printf("  Phases: %s\n", phases)
+	}
 
 	// Ensure build directory exists
 	if err := os.MkdirAll(cfg.ModulesBuildDir, 0755); err != nil {
 		return fmt.Errorf("create build dir: %w", err)
 	}
 
-	// Phase 1: Build DBC binaries and collect addon files from all mods
-	var allDbcFiles []builtFile
-	var allAddonFiles []builtFile
-	seenDBCs := make(map[string]bool)
-	seenAddons := make(map[string]bool)
+	// Record the inputs for modules/mithril.lock. With --locked they must be
+	// the ones the lock was written from.
+	cache := loadBuildCache(cfg)
+	lock, err := currentBuildLock(cfg, cache, allMods)
+	if err != nil {
+		return err
+	}
+	var lockedTo *buildLock
+	if locked {
+		if lockedTo, err = loadBuildLock(cfg); os.IsNotExist(err) {
+			return fmt.Errorf("--locked: %s not found — run 'mithril mod build' first", buildLockPath(cfg))
+		} else if err != nil {
+			return err
+		}
+		if diffs := lockedTo.inputDiffs(lock); len(diffs) > 0 {
+			return lockMismatch(cfg, "build inputs", diffs, "Run 'mithril mod build' without --locked to update the lock")
+		}
+		fmt.Printf("  Inputs match %s\n", buildLockPath(cfg))
+	}
+
+	// Phase 1: Build DBC binaries and collect addon files. The patches hold
+	// every mod, so mods not selected with --mod contribute what their last
+	// build left behind instead of being built again.
+	modDbcFiles := make(map[string][]builtFile)
+	modAddonFiles := make(map[string][]builtFile)
+	sharedDBTables := make(map[string]bool) // exported from the shared dbc database
+
+	// A mod whose DBC inputs are unchanged since its last build reuses that
+	// build instead of exporting again
+	var dbcKeys map[string]string
+	if phases["dbc"] {
+		if dbcKeys, err = cache.dbcInputKeys(cfg, allMods); err != nil {
+			return err
+		}
+	}
 
 	for _, mod := range modsToBuild {
 		fmt.Printf("  Mod '%s':\n", mod)
 
-		// Build DBC files (SQL-based) — apply sql/dbc/ migrations and export
-		dbcFiles, err := buildModDBCsFromSQL(cfg, mod)
-		if err != nil {
-			fmt.Printf("  ⚠ Error building DBCs for mod '%s': %v\n", mod, err)
+		var dbcFiles []builtFile
+		if phases["dbc"] {
+			if cached, ok := cache.cachedDBCs(cfg, mod, dbcKeys[mod]); ok {
+				if len(cached) > 0 {
+					fmt.Printf("    %d DBC(s) unchanged since the last build\n", len(cached))
+				}
+				dbcFiles = cached
+			} else {
+				// Build DBC files (SQL-based) — apply sql/dbc/ migrations and export.
+				// This goes through the shared dbc database even with --mod,
+				// unlike the isolated build of 'mod publish': a mod's migrations
+				// may build on rows of the mods before it, and the other mods'
+				// last builds were exported from this database, so a copy built
+				// from the baseline alone wouldn't line up with them.
+				sqlDbcFiles, sqlErr := buildModDBCsFromSQL(cfg, mod)
+				if sqlErr != nil {
+					fmt.Printf("  ⚠ Error building DBCs for mod '%s': %v\n", mod, sqlErr)
+				}
+				for _, bf := range sqlDbcFiles {
+					sharedDBTables[strings.ToLower(bf.mpqPath)] = true
+				}
+
+				// Build DBC files (file-based) — merge dbc/*.csv and dbc/*.json onto the baseline
+				textDbcFiles, textErr := buildModDBCsFromFiles(cfg, mod, filepath.Join(cfg.ModulesBuildDir, mod, "DBFilesClient"), sqlDbcFiles)
+				if textErr != nil {
+					fmt.Printf("  ⚠ Error building DBC files for mod '%s': %v\n", mod, textErr)
+				}
+				dbcFiles = append(sqlDbcFiles, textDbcFiles...)
+
+				// Only a clean build is worth reusing
+				delete(cache.DBCs, mod)
+				if sqlErr == nil && textErr == nil {
+					if err := cache.storeDBCs(mod, dbcKeys[mod], dbcFiles); err != nil {
+						return fmt.Errorf("cache DBCs of mod '%s': %w", mod, err)
+					}
+				}
+			}
 		}
 
 		// Collect addon files
-		addonFiles := collectModAddons(cfg, mod)
+		var addonFiles []builtFile
+		if phases["addons"] {
+			addonFiles = collectModAddons(cfg, mod)
+		}
 
 		// Show script count for this mod
-		modScripts := findModScripts(cfg, mod)
+		var modScripts []string
+		if phases["scripts"] {
+			modScripts = findModScripts(cfg, mod)
+		}
 		if len(modScripts) > 0 {
 			fmt.Printf("    %d script(s)\n", len(modScripts))
 		}
 
 		if len(dbcFiles) == 0 && len(addonFiles) == 0 && len(modScripts) == 0 {
 			fmt.Printf("    (no changes)\n")
+		}
+		modDbcFiles[mod] = dbcFiles
+		modAddonFiles[mod] = addonFiles
+	}
+
+	for _, mod := range allMods {
+		if selected[mod] {
 			continue
 		}
+		if phases["dbc"] {
+			files, err := reusedModDBCs(cfg, mod, sharedDBTables)
+			if err != nil {
+				return fmt.Errorf("read last build of mod '%s': %w", mod, err)
+			}
+			modDbcFiles[mod] = files
+		}
+		if phases["addons"] {
+			modAddonFiles[mod] = modAddons(cfg, mod)
+		}
+		if len(modDbcFiles[mod]) > 0 || len(modAddonFiles[mod]) > 0 {
+			fmt.Printf("  Mod '%s': last build reused (%d DBC, %d addon file(s))\n", mod, len(modDbcFiles[mod]), len(modAddonFiles[mod]))
+		}
+	}
 
-		// Add to combined lists (later mods override earlier ones for the same file)
-		for _, bf := range dbcFiles {
+	// Combine in build order (DBCs built by several mods are merged below)
+	var allDbcFiles []builtFile
+	var allAddonFiles []builtFile
+	dbcSources := make(map[string][]modDBC)
+	seenAddons := make(map[string]bool)
+	for _, mod := range allMods {
+		for _, bf := range modDbcFiles[mod] {
 			key := strings.ToLower(bf.mpqPath)
-			if !seenDBCs[key] {
+			if len(dbcSources[key]) == 0 {
 				allDbcFiles = append(allDbcFiles, bf)
-				seenDBCs[key] = true
 			}
+			dbcSources[key] = append(dbcSources[key], modDBC{mod: mod, file: bf})
 		}
-		for _, bf := range addonFiles {
+		for _, bf := range modAddonFiles[mod] {
 			key := strings.ToLower(bf.mpqPath)
 			if !seenAddons[key] {
 				allAddonFiles = append(allAddonFiles, bf)
@@ -88,66 +234,101 @@ func runModBuild(args []string) error {
 		}
 	}
 
-	// Phase 2: Build and deploy combined MPQs.
+	// New records must stay inside their mod's reserved IDs and not collide
+	if err := checkDBCReservations(cfg, dbcSources); err != nil {
+		return err
+	}
+
+	// Merge DBCs that more than one mod changed, baseline as the common ancestor
+	allDbcFiles, err = mergeModDBCs(cfg, allDbcFiles, dbcSources)
+	if err != nil {
+		return err
+	}
+
+	// Check cross-table references before anything is packed
+	if len(allDbcFiles) > 0 && !skipValidate {
+		dangling, err := validateDBCReferences(cfg, allDbcFiles, allDbcFiles)
+		if err != nil {
+			return fmt.Errorf("validate DBCs: %w", err)
+		}
+		if len(dangling) > 0 {
+			printDanglingRefs(dangling)
+			return fmt.Errorf("DBC validation failed — fix the references above, or rerun with --skip-validate")
+		}
+	}
+
+	// Phase 2: Build the combined MPQs in modules/build. An MPQ is only
+	// rebuilt when the files that go into it changed.
 	clientDataDir := filepath.Join(cfg.ClientDir, "Data")
 	locale := detectLocaleFromManifest(cfg)
 	clientLocaleDir := filepath.Join(clientDataDir, locale)
+	dbcMpqName := "patch-" + cfg.PatchLetter + ".MPQ"
+	addonMpqName := "patch-" + locale + "-" + cfg.PatchLetter + ".MPQ"
 
-	// Clean all mithril patches from both Data/ and Data/<locale>/
-	cleanedCount := cleanMithrilPatches(clientDataDir)
-	cleanedCount += cleanMithrilPatches(clientLocaleDir)
-	if cleanedCount > 0 {
-		fmt.Printf("\nCleaned %d previous mithril patch(es) from client\n", cleanedCount)
+	// What the client and the server should have once the build is deployed.
+	// A skipped phase leaves its patch (and the server DBCs) as they are.
+	state := newDeployState()
+	prevDeployment, err := currentDeployment(cfg)
+	if err != nil {
+		return err
 	}
-
-	// Deploy DBC MPQ to Data/
-	if len(allDbcFiles) > 0 {
-		dbcMpqName := "patch-" + cfg.PatchLetter + ".MPQ"
-		buildDbcMpqPath := filepath.Join(cfg.ModulesBuildDir, dbcMpqName)
-		fmt.Printf("\nBuilding %s (%d DBC files)...\n", dbcMpqName, len(allDbcFiles))
-		if err := createMPQ(buildDbcMpqPath, allDbcFiles); err != nil {
-			return fmt.Errorf("create DBC MPQ: %w", err)
+	if phases["dbc"] {
+		if len(allDbcFiles) > 0 {
+			buildPath := filepath.Join(cfg.ModulesBuildDir, dbcMpqName)
+			if err := buildCachedMPQ(cache, buildPath, allDbcFiles, "DBC"); err != nil {
+				return err
+			}
+			state.client["Data/"+dbcMpqName] = buildPath
 		}
-		clientDbcMpqPath := filepath.Join(clientDataDir, dbcMpqName)
-		if err := copyFile(buildDbcMpqPath, clientDbcMpqPath); err != nil {
-			return fmt.Errorf("deploy DBC MPQ: %w", err)
+		if _, err := os.Stat(cfg.ServerDbcDir); err == nil {
+			for _, bf := range allDbcFiles {
+				state.server[filepath.Base(strings.ReplaceAll(bf.mpqPath, "\\", "/"))] = bf.diskPath
+			}
+		}
+	} else {
+		state.keepClientDir(cfg, "Data")
+		state.keepServer(cfg, prevDeployment)
+	}
+	if phases["addons"] {
+		if len(allAddonFiles) > 0 {
+			buildPath := filepath.Join(cfg.ModulesBuildDir, addonMpqName)
+			if err := buildCachedMPQ(cache, buildPath, allAddonFiles, "addon"); err != nil {
+				return err
+			}
+			state.client["Data/"+locale+"/"+addonMpqName] = buildPath
 		}
+	} else {
+		state.keepClientDir(cfg, filepath.Join("Data", locale))
 	}
 
-	// Deploy addon MPQ to Data/<locale>/
-	if len(allAddonFiles) > 0 {
-		addonMpqName := "patch-" + locale + "-" + cfg.PatchLetter + ".MPQ"
-		buildAddonMpqPath := filepath.Join(cfg.ModulesBuildDir, addonMpqName)
-		fmt.Printf("Building %s (%d addon files)...\n", addonMpqName, len(allAddonFiles))
-		if err := createMPQ(buildAddonMpqPath, allAddonFiles); err != nil {
-			return fmt.Errorf("create addon MPQ: %w", err)
-		}
-		clientAddonMpqPath := filepath.Join(clientLocaleDir, addonMpqName)
-		if err := copyFile(buildAddonMpqPath, clientAddonMpqPath); err != nil {
-			return fmt.Errorf("deploy addon MPQ: %w", err)
-		}
-	}
-
-	// Phase 3: Deploy modified DBCs to the server's data/dbc/ directory.
-	serverDeployed := 0
-	if _, err := os.Stat(cfg.ServerDbcDir); err == nil && len(allDbcFiles) > 0 {
-		fmt.Printf("\nDeploying to server (data/dbc/)...\n")
-		for _, bf := range allDbcFiles {
-			dbcFileName := filepath.Base(strings.ReplaceAll(bf.mpqPath, "\\", "/"))
-			serverPath := filepath.Join(cfg.ServerDbcDir, dbcFileName)
-			if err := copyFile(bf.diskPath, serverPath); err != nil {
-				fmt.Printf("  ⚠ Failed to deploy %s to server: %v\n", dbcFileName, err)
-			} else {
-				fmt.Printf("  ✓ %s\n", dbcFileName)
-				serverDeployed++
-			}
+	if err := lock.setOutputs(cache, state); err != nil {
+		return err
+	}
+	if err := cache.save(cfg); err != nil {
+		printWarning(fmt.Sprintf("could not save build cache: %v", err))
+	}
+	if lockedTo != nil {
+		if diffs := lockedTo.outputDiffs(lock); len(diffs) > 0 {
+			return lockMismatch(cfg, "build outputs", diffs, "The same inputs built different patches; nothing was deployed")
 		}
 	}
 
-	// Phase 4: Sync custom C++ scripts to the container.
+	// Phase 3: Deploy to the client's Data/ and the server's data/dbc/ in one
+	// step, keeping the previous deployment for 'mithril mod build rollback'.
+	// Files that already have the right bytes are not touched.
+	serverDeployed, err := deploy(cfg, modsToBuild, state)
+	if err != nil {
+		return fmt.Errorf("deploy: %w", err)
+	}
+	if err := lock.save(cfg); err != nil {
+		printWarning(fmt.Sprintf("could not write %s: %v", buildLockPath(cfg), err))
+	}
+
+	// Phase 4: Sync custom C++ scripts to the container. The container has
+	// one script loader for all mods, so every mod's scripts are synced.
 	totalScripts := countAllScripts(cfg)
 	scriptsChanged := false
-	if totalScripts > 0 {
+	if phases["scripts"] && modsHaveScripts(cfg, modsToBuild) {
 		var err error
 		scriptsChanged, err = syncScriptsToContainer(cfg)
 		if err != nil {
@@ -188,7 +369,10 @@ func runModBuild(args []string) error {
 		}
 	}
 	// Phase 4c: Apply pending core patches inside the container.
-	corePatchesApplied := applyPendingCorePatches(cfg, modsToBuild)
+	corePatchesApplied := 0
+	if phases["core"] {
+		corePatchesApplied = applyPendingCorePatches(cfg, modsToBuild)
+	}
 
 	needsRebuild := scriptsChanged || corePatchesApplied > 0
 	if needsRebuild {
@@ -201,7 +385,10 @@ func runModBuild(args []string) error {
 	}
 
 	// Phase 5: Apply pending server SQL migrations (world/auth/characters).
-	sqlApplied := applyPendingSQLMigrations(cfg, modsToBuild)
+	sqlApplied := 0
+	if phases["sql"] {
+		sqlApplied = applyPendingSQLMigrations(cfg, modsToBuild)
+	}
 	needsRestart := needsRebuild || serverDeployed > 0 || sqlApplied > 0
 
 	if needsRestart {
@@ -215,20 +402,27 @@ func runModBuild(args []string) error {
 
 // collectModAddons returns builtFile entries for addon files modified in a mod.
 func collectModAddons(cfg *Config, mod string) []builtFile {
-	modifiedAddons := findModifiedAddons(cfg, mod)
-	if len(modifiedAddons) == 0 {
+	files := modAddons(cfg, mod)
+	if len(files) == 0 {
 		return nil
 	}
 
-	fmt.Printf("    %d modified addon file(s)\n", len(modifiedAddons))
+	fmt.Printf("    %d modified addon file(s)\n", len(files))
+	for _, bf := range files {
+		fmt.Printf("    ✓ %s\n", strings.ReplaceAll(bf.mpqPath, "\\", "/"))
+	}
+	return files
+}
 
+// modAddons returns builtFile entries for a mod's modified addon files
+// without printing them.
+func modAddons(cfg *Config, mod string) []builtFile {
 	var files []builtFile
-	for _, relPath := range modifiedAddons {
+	for _, relPath := range findModifiedAddons(cfg, mod) {
 		diskPath := filepath.Join(cfg.ModAddonsDir(mod), relPath)
 		// MPQ paths use backslashes
 		mpqPath := strings.ReplaceAll(relPath, "/", "\\")
 		files = append(files, builtFile{diskPath: diskPath, mpqPath: mpqPath})
-		fmt.Printf("    ✓ %s\n", relPath)
 	}
 	return files
 }
@@ -259,28 +453,19 @@ func listMithrilPatches(clientDataDir string) []string {
 	return patches
 }
 
-// cleanMithrilPatches removes all mithril-generated patch files from the given
-// directory. Works for both Data/ and Data/<locale>/.
-func cleanMithrilPatches(clientDataDir string) int {
-	entries, err := os.ReadDir(clientDataDir)
+// buildCachedMPQ builds an MPQ in the build directory, reusing the one there
+// if its files are unchanged.
+func buildCachedMPQ(cache *buildCache, buildPath string, files []builtFile, kind string) error {
+	built, err := cache.buildMPQ(buildPath, files)
 	if err != nil {
-		return 0
+		return fmt.Errorf("create %s MPQ: %w", kind, err)
 	}
-
-	removed := 0
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		if isMithrilPatch(name) {
-			path := filepath.Join(clientDataDir, name)
-			if err := os.Remove(path); err == nil {
-				removed++
-			}
-		}
+	if built {
+		fmt.Printf("\nBuilt %s (%d %s files)\n", filepath.Base(buildPath), len(files), kind)
+	} else {
+		fmt.Printf("\n%s unchanged (%d %s files)\n", filepath.Base(buildPath), len(files), kind)
 	}
-	return removed
+	return nil
 }
 
 // isMithrilPatch returns true if a filename looks like a mithril-generated patch.
@@ -333,27 +518,34 @@ func isMithrilPatch(filename string) bool {
 }
 
 // createMPQ creates an MPQ archive at the given path containing the given files.
+// The archive is written next to the path and renamed into place, so a failed
+// build never leaves a partial MPQ behind.
 func createMPQ(mpqOutPath string, files []builtFile) error {
 	if err := os.MkdirAll(filepath.Dir(mpqOutPath), 0755); err != nil {
 		return fmt.Errorf("create output dir: %w", err)
 	}
 
-	archive, err := mpq.Create(mpqOutPath, len(files)+2)
+	tmpPath := mpqOutPath + ".tmp"
+	os.Remove(tmpPath)
+	archive, err := mpq.Create(tmpPath, len(files)+2)
 	if err != nil {
 		return fmt.Errorf("create MPQ: %w", err)
 	}
 
 	for _, bf := range files {
 		if err := archive.AddFile(bf.diskPath, bf.mpqPath); err != nil {
+			archive.Close()
+			os.Remove(tmpPath)
 			return fmt.Errorf("add file %s: %w", bf.mpqPath, err)
 		}
 	}
 
 	if err := archive.Close(); err != nil {
+		os.Remove(tmpPath)
 		return fmt.Errorf("close MPQ: %w", err)
 	}
 
-	return nil
+	return os.Rename(tmpPath, mpqOutPath)
 }
 
 func runModStatus(args []string) error {
@@ -380,8 +572,10 @@ func runModStatus(args []string) error {
 		sqlMigrations := findMigrations(cfg, mod)
 		corePatches := findCorePatches(cfg, mod)
 		scripts := findModScripts(cfg, mod)
+		dbcTextFiles := findModDBCTextFiles(cfg, mod)
+		dbcEditFiles := findModDBCEditFiles(cfg, mod)
 
-		if len(modifiedAddons) == 0 && len(sqlMigrations) == 0 && len(corePatches) == 0 && len(scripts) == 0 {
+		if len(modifiedAddons) == 0 && len(sqlMigrations) == 0 && len(corePatches) == 0 && len(scripts) == 0 && len(dbcTextFiles) == 0 && len(dbcEditFiles) == 0 {
 			fmt.Printf("  %s: no modifications\n", mod)
 			return
 		}
@@ -390,6 +584,12 @@ func runModStatus(args []string) error {
 		for _, name := range modifiedAddons {
 			fmt.Printf("    ✏ addon: %s\n", name)
 		}
+		for _, path := range dbcTextFiles {
+			fmt.Printf("    📄 dbc file: %s\n", filepath.Base(path))
+		}
+		for _, path := range dbcEditFiles {
+			fmt.Printf("    📝 dbc edits [%s]: %s\n", dbcEditStatus(cfg, mod, path), filepath.Base(path))
+		}
 		for _, m := range sqlMigrations {
 			status := "pending"
 			if sqlTracker.IsApplied(m.mod, m.filename) {
@@ -571,12 +771,12 @@ func applyPendingSQLMigrations(cfg *Config, mods []string) int {
 	applied := 0
 	for _, m := range pending {
 		fmt.Printf("  Applying %s/%s → %s... ", m.mod, m.filename, m.database)
-		sqlContent, err := os.ReadFile(m.path)
+		sqlContent, err := readMigrationSQL(cfg, m.mod, m.database, m.path)
 		if err != nil {
 			fmt.Printf("⚠ read error: %v\n", err)
 			continue
 		}
-		if err := execSQL(cfg, containerID, m.database, string(sqlContent)); err != nil {
+		if err := execSQL(cfg, containerID, m.database, sqlContent); err != nil {
 			fmt.Printf("⚠ failed: %v\n", err)
 			fmt.Println("    Stopping SQL apply to prevent out-of-order execution.")
 			break
@@ -602,13 +802,13 @@ func applyPendingSQLMigrations(cfg *Config, mods []string) int {
 	return applied
 }
 
-// buildModDBCsFromSQL applies a mod's sql/dbc/ migrations and exports modified DBC tables.
+// buildModDBCsFromSQL applies a mod's sql/dbc/ migrations and dbc/*.yaml edits and exports modified DBC tables.
 // Uses native MySQL driver for both migration execution and DBC export.
 // Uses CHECKSUM TABLE to detect which tables actually changed.
 func buildModDBCsFromSQL(cfg *Config, mod string) ([]builtFile, error) {
-	// Check if this mod has any dbc SQL migrations
+	// Check if this mod has any dbc SQL migrations or YAML edits
 	dbcMigrations := findDBCMigrations(cfg, mod)
-	if len(dbcMigrations) == 0 {
+	if len(dbcMigrations) == 0 && len(findModDBCEditFiles(cfg, mod)) == 0 && !hasDBCEditState(cfg, mod) {
 		return nil, nil
 	}
 
@@ -619,6 +819,11 @@ func buildModDBCsFromSQL(cfg *Config, mod string) ([]builtFile, error) {
 	}
 	defer db.Close()
 
+	// Custom DBCs declared after the last import need their (empty) tables
+	if err := ensureCustomDBCTables(cfg, db); err != nil {
+		return nil, err
+	}
+
 	// Apply pending DBC SQL migrations
 	tracker, _ := loadSQLTracker(cfg)
 	applied := 0
@@ -628,12 +833,12 @@ func buildModDBCsFromSQL(cfg *Config, mod string) ([]builtFile, error) {
 		}
 
 		fmt.Printf("    Applying DBC SQL: %s ...\n", m.filename)
-		sqlContent, err := os.ReadFile(m.path)
+		sqlContent, err := readMigrationSQL(cfg, m.mod, m.database, m.path)
 		if err != nil {
 			return nil, fmt.Errorf("read migration %s: %w", m.filename, err)
 		}
 
-		if _, err := db.Exec(string(sqlContent)); err != nil {
+		if _, err := db.Exec(sqlContent); err != nil {
 			return nil, fmt.Errorf("apply migration %s: %w", m.filename, err)
 		}
 
@@ -653,8 +858,13 @@ func buildModDBCsFromSQL(cfg *Config, mod string) ([]builtFile, error) {
 		}
 	}
 
+	// Apply dbc/*.yaml edits on top of the migrations
+	if _, err := applyModDBCEdits(cfg, mod, db); err != nil {
+		return nil, err
+	}
+
 	// Export modified DBC tables using CHECKSUM TABLE for change detection
-	metaFiles, err := dbc.GetEmbeddedMetaFiles()
+	metas, err := dbc.AllMetas()
 	if err != nil {
 		return nil, fmt.Errorf("get meta files: %w", err)
 	}
@@ -664,7 +874,7 @@ func buildModDBCsFromSQL(cfg *Config, mod string) ([]builtFile, error) {
 		return nil, fmt.Errorf("create build dir: %w", err)
 	}
 
-	exported, err := dbc.ExportModifiedDBCs(db, metaFiles, cfg.BaselineDbcDir, buildDbcDir)
+	exported, err := dbc.ExportModifiedDBCs(db, metas, cfg.FidelityBaselineDir(), buildDbcDir)
 	if err != nil {
 		return nil, fmt.Errorf("export modified DBCs: %w", err)
 	}
@@ -673,11 +883,7 @@ func buildModDBCsFromSQL(cfg *Config, mod string) ([]builtFile, error) {
 	var files []builtFile
 	for _, tableName := range exported {
 		// Find the meta to get the original .dbc filename
-		for _, metaFile := range metaFiles {
-			meta, err := dbc.LoadEmbeddedMeta(metaFile)
-			if err != nil {
-				continue
-			}
+		for _, meta := range metas {
 			if dbc.TableName(meta) == tableName {
 				dbcOutPath := filepath.Join(buildDbcDir, meta.File)
 				mpqInternalPath := "DBFilesClient\\" + meta.File
@@ -690,6 +896,24 @@ func buildModDBCsFromSQL(cfg *Config, mod string) ([]builtFile, error) {
 	return files, nil
 }
 
+// ensureCustomDBCTables creates empty tables for custom metas (DBC files with
+// no embedded meta and no baseline file) that don't exist in the dbc database yet.
+func ensureCustomDBCTables(cfg *Config, db *sql.DB) error {
+	metas, err := dbc.AllMetas()
+	if err != nil {
+		return fmt.Errorf("get meta files: %w", err)
+	}
+	for _, meta := range metas {
+		if !dbc.IsCustomMeta(meta) || dbc.FindDBCFile(cfg.BaselineDbcDir, meta.File) != "" {
+			continue
+		}
+		if _, err := dbc.ImportDBC(db, "", meta, false); err != nil {
+			return fmt.Errorf("create custom table for %s: %w", meta.File, err)
+		}
+	}
+	return nil
+}
+
 // findDBCMigrations returns SQL migrations specifically for the dbc database.
 func findDBCMigrations(cfg *Config, modName string) []migrationInfo {
 	allMigrations := findMigrations(cfg, modName)
@@ -0,0 +1,325 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// buildCacheVersion changes whenever the cache format does, so an old cache
+// is dropped rather than misread.
+const buildCacheVersion = 1
+
+// buildCache remembers, in modules/build/cache.json, what earlier builds
+// produced and from which inputs. Every key is a content hash that covers the
+// tool version, so a build reuses an output only when everything it was made
+// from is byte-for-byte the same.
+type buildCache struct {
+	Version int `json:"version"`
+	// Files memoizes content hashes by path, re-hashing a file only when its
+	// size or modification time changes.
+	Files map[string]cachedSum `json:"files"`
+	// DBCs is each mod's last DBC build, by mod name.
+	DBCs map[string]*cachedDBCBuild `json:"dbcs"`
+	// MPQs is the input key of each MPQ in modules/build, by file name.
+	MPQs map[string]string `json:"mpqs"`
+}
+
+type cachedSum struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mod_time"` // UnixNano
+	Sum     string `json:"sum"`      // sha256
+}
+
+// cachedDBCBuild is the DBCs one mod's build exported, and the key of the
+// inputs they were built from.
+type cachedDBCBuild struct {
+	Key   string          `json:"key"`
+	Files []cachedDBCFile `json:"files"`
+}
+
+type cachedDBCFile struct {
+	Path    string `json:"path"`
+	MPQPath string `json:"mpq_path"`
+	Sum     string `json:"sum"`
+}
+
+func buildCachePath(cfg *Config) string {
+	return filepath.Join(cfg.ModulesBuildDir, "cache.json")
+}
+
+// loadBuildCache reads the build cache. A missing, unreadable or outdated
+// cache gives an empty one.
+func loadBuildCache(cfg *Config) *buildCache {
+	empty := &buildCache{
+		Version: buildCacheVersion,
+		Files:   make(map[string]cachedSum),
+		DBCs:    make(map[string]*cachedDBCBuild),
+		MPQs:    make(map[string]string),
+	}
+	data, err := os.ReadFile(buildCachePath(cfg))
+	if err != nil {
+		return empty
+	}
+	var c buildCache
+	if err := json.Unmarshal(data, &c); err != nil || c.Version != buildCacheVersion {
+		return empty
+	}
+	if c.Files == nil {
+		c.Files = empty.Files
+	}
+	if c.DBCs == nil {
+		c.DBCs = empty.DBCs
+	}
+	if c.MPQs == nil {
+		c.MPQs = empty.MPQs
+	}
+	return &c
+}
+
+// save writes the cache, replacing the old one atomically. Hashes of files
+// that no longer exist are dropped.
+func (c *buildCache) save(cfg *Config) error {
+	for path := range c.Files {
+		if !fileExists(path) {
+			delete(c.Files, path)
+		}
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := buildCachePath(cfg)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// fileSum returns the sha256 of a file's contents.
+func (c *buildCache) fileSum(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if s, ok := c.Files[path]; ok && s.Size == info.Size() && s.ModTime == info.ModTime().UnixNano() {
+		return s.Sum, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	c.Files[path] = cachedSum{Size: info.Size(), ModTime: info.ModTime().UnixNano(), Sum: sum}
+	return sum, nil
+}
+
+// dirSum hashes every file under dir, with its path relative to dir. A
+// directory that doesn't exist hashes like an empty one.
+func (c *buildCache) dirSum(dir string, include func(rel string) bool) (string, error) {
+	var rels []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == dir {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if include == nil || include(rel) {
+			rels = append(rels, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(rels)
+	h := sha256.New()
+	for _, rel := range rels {
+		sum, err := c.fileSum(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\x00%s\n", rel, sum)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// dbcInputKeys returns, for each mod, the key of everything its DBC build
+// depends on: the tool version, the baseline DBCs, every schema (metas apply
+// to all mods), the fidelity setting and the mod's own sql/dbc/ and dbc/
+// inputs. Mods that build through the shared dbc database export whatever
+// it holds, so their keys also cover the database inputs of every such mod.
+func (c *buildCache) dbcInputKeys(cfg *Config, mods []string) (map[string]string, error) {
+	common := sha256.New()
+	fmt.Fprintf(common, "tool %s\nfidelity %v\n", toolVersion(), cfg.DBCFidelity)
+	baseline, err := c.dirSum(cfg.BaselineDbcDir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("hash baseline: %w", err)
+	}
+	fmt.Fprintf(common, "baseline %s\n", baseline)
+	metas, err := c.dirSum(cfg.MetaDir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("hash metas: %w", err)
+	}
+	fmt.Fprintf(common, "meta %s\n", metas)
+
+	// Migrations read their reservation variables from the manifest
+	shared := sha256.New()
+	if manifest, err := loadManifest(cfg.ModulesDir); err == nil {
+		fmt.Fprintf(shared, "reservations %v\n", manifest.Reservations)
+	}
+	own := make(map[string]string, len(mods))
+	usesDB := make(map[string]bool, len(mods))
+	for _, mod := range mods {
+		meta, err := c.dirSum(filepath.Join(cfg.ModDir(mod), "meta"), nil)
+		if err != nil {
+			return nil, fmt.Errorf("hash %s metas: %w", mod, err)
+		}
+		fmt.Fprintf(common, "meta %s %s\n", mod, meta)
+
+		sqlDBC, err := c.dirSum(filepath.Join(cfg.ModDir(mod), "sql", "dbc"), nil)
+		if err != nil {
+			return nil, fmt.Errorf("hash %s sql/dbc: %w", mod, err)
+		}
+		edits, err := c.dirSum(filepath.Join(cfg.ModDir(mod), "dbc"), dbc.IsEditFile)
+		if err != nil {
+			return nil, fmt.Errorf("hash %s dbc edits: %w", mod, err)
+		}
+		text, err := c.dirSum(filepath.Join(cfg.ModDir(mod), "dbc"), func(rel string) bool { return !dbc.IsEditFile(rel) })
+		if err != nil {
+			return nil, fmt.Errorf("hash %s dbc files: %w", mod, err)
+		}
+		usesDB[mod] = len(findDBCMigrations(cfg, mod)) > 0 || len(findModDBCEditFiles(cfg, mod)) > 0 || hasDBCEditState(cfg, mod)
+		if usesDB[mod] {
+			fmt.Fprintf(shared, "%s %s %s\n", mod, sqlDBC, edits)
+		}
+		own[mod] = text
+	}
+
+	commonSum := hex.EncodeToString(common.Sum(nil))
+	sharedSum := hex.EncodeToString(shared.Sum(nil))
+	keys := make(map[string]string, len(mods))
+	for _, mod := range mods {
+		h := sha256.New()
+		fmt.Fprintf(h, "%s\n%s\n", commonSum, own[mod])
+		if usesDB[mod] {
+			fmt.Fprintf(h, "db %s\n", sharedSum)
+		}
+		keys[mod] = hex.EncodeToString(h.Sum(nil))
+	}
+	return keys, nil
+}
+
+// cachedDBCs returns a mod's DBCs from its last build if they were built
+// from the same inputs, are still on disk unchanged, and everything the mod
+// has is applied to the shared dbc database.
+func (c *buildCache) cachedDBCs(cfg *Config, mod, key string) ([]builtFile, bool) {
+	entry := c.DBCs[mod]
+	if entry == nil || entry.Key != key || dbcChangesPending(cfg, mod) {
+		return nil, false
+	}
+	var files []builtFile
+	for _, f := range entry.Files {
+		if sum, err := c.fileSum(f.Path); err != nil || sum != f.Sum {
+			return nil, false
+		}
+		files = append(files, builtFile{diskPath: f.Path, mpqPath: f.MPQPath})
+	}
+	return files, true
+}
+
+// storeDBCs records the DBCs a mod's build produced from the inputs with key.
+func (c *buildCache) storeDBCs(mod, key string, files []builtFile) error {
+	entry := &cachedDBCBuild{Key: key}
+	for _, bf := range files {
+		sum, err := c.fileSum(bf.diskPath)
+		if err != nil {
+			return err
+		}
+		entry.Files = append(entry.Files, cachedDBCFile{Path: bf.diskPath, MPQPath: bf.mpqPath, Sum: sum})
+	}
+	c.DBCs[mod] = entry
+	return nil
+}
+
+// mpqKey hashes what goes into an MPQ: each file's path in the archive and
+// its contents.
+func (c *buildCache) mpqKey(files []builtFile) (string, error) {
+	sorted := append([]builtFile(nil), files...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].mpqPath < sorted[j].mpqPath })
+	h := sha256.New()
+	fmt.Fprintf(h, "tool %s\n", toolVersion())
+	for _, bf := range sorted {
+		sum, err := c.fileSum(bf.diskPath)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\x00%s\n", bf.mpqPath, sum)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// buildMPQ writes an MPQ of files to path, unless the MPQ already there was
+// built from the same files. Reports whether it was (re)built.
+func (c *buildCache) buildMPQ(path string, files []builtFile) (bool, error) {
+	key, err := c.mpqKey(files)
+	if err != nil {
+		return false, err
+	}
+	name := filepath.Base(path)
+	if c.MPQs[name] == key && fileExists(path) {
+		return false, nil
+	}
+	delete(c.MPQs, name)
+	if err := createMPQ(path, files); err != nil {
+		return false, err
+	}
+	c.MPQs[name] = key
+	return true, nil
+}
+
+// dbcChangesPending reports whether a mod has DBC migrations or YAML edits
+// the shared dbc database doesn't have yet.
+func dbcChangesPending(cfg *Config, mod string) bool {
+	tracker, err := loadSQLTracker(cfg)
+	if err != nil {
+		return true
+	}
+	for _, m := range findDBCMigrations(cfg, mod) {
+		if !tracker.IsApplied(m.mod, m.filename) {
+			return true
+		}
+	}
+	editFiles := findModDBCEditFiles(cfg, mod)
+	for _, path := range editFiles {
+		if dbcEditStatus(cfg, mod, path) != "applied" {
+			return true
+		}
+	}
+	return len(appliedDBCEditFiles(cfg, mod)) != len(editFiles)
+}
@@ -0,0 +1,515 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// deployGenerationsKept is how many deployments are kept for rollback,
+// the current one included.
+const deployGenerationsKept = 3
+
+// deployment is one generation of what mod build put into the client and
+// the server. Each generation lives in modules/build/deployments/<n>/ with a
+// copy of every file, so it can be restored as a whole.
+type deployment struct {
+	Generation int      `json:"generation"`
+	DeployedAt string   `json:"deployed_at"`
+	Mods       []string `json:"mods"`
+	// Client is the mithril patches in place, relative to the client
+	// directory (e.g. "Data/patch-M.MPQ").
+	Client []string `json:"client"`
+	// Server is the DBC files mithril placed in the server's data/dbc/.
+	Server []string `json:"server"`
+}
+
+// deployState is the files a deployment should leave in place: client paths
+// (relative to the client directory) and server DBC names, each mapped to
+// the file that holds its contents.
+type deployState struct {
+	client map[string]string
+	server map[string]string
+}
+
+func newDeployState() *deployState {
+	return &deployState{client: make(map[string]string), server: make(map[string]string)}
+}
+
+// keepClientDir keeps the mithril patches in a client directory as they are,
+// for a build that doesn't replace them.
+func (s *deployState) keepClientDir(cfg *Config, rel string) {
+	for _, name := range listMithrilPatches(filepath.Join(cfg.ClientDir, rel)) {
+		s.client[filepath.ToSlash(filepath.Join(rel, name))] = filepath.Join(cfg.ClientDir, rel, name)
+	}
+}
+
+// keepServer keeps the server DBCs of a deployment as they are.
+func (s *deployState) keepServer(cfg *Config, d *deployment) {
+	if d == nil {
+		return
+	}
+	for _, name := range d.Server {
+		if path := filepath.Join(cfg.ServerDbcDir, name); fileExists(path) {
+			s.server[name] = path
+		}
+	}
+}
+
+// fileSwap replaces target with the contents of source, or removes target
+// if source is empty.
+type fileSwap struct {
+	target string
+	source string
+	label  string // shown while deploying, e.g. "client Data/patch-M.MPQ"
+	note   string // e.g. "removed", "original restored"
+}
+
+func deploymentsDir(cfg *Config) string {
+	return filepath.Join(cfg.ModulesBuildDir, "deployments")
+}
+
+func generationDir(cfg *Config, n int) string {
+	return filepath.Join(deploymentsDir(cfg), strconv.Itoa(n))
+}
+
+// originalsDir holds the server DBCs as they were before mithril first
+// replaced them. A DBC mithril added has no original and is removed again.
+func originalsDir(cfg *Config) string {
+	return filepath.Join(deploymentsDir(cfg), "original")
+}
+
+// listGenerations returns the generation numbers on disk, oldest first.
+func listGenerations(cfg *Config) []int {
+	entries, err := os.ReadDir(deploymentsDir(cfg))
+	if err != nil {
+		return nil
+	}
+	var gens []int
+	for _, entry := range entries {
+		if n, err := strconv.Atoi(entry.Name()); err == nil && entry.IsDir() {
+			gens = append(gens, n)
+		}
+	}
+	sort.Ints(gens)
+	return gens
+}
+
+func loadDeployment(cfg *Config, n int) (*deployment, error) {
+	data, err := os.ReadFile(filepath.Join(generationDir(cfg, n), "deployment.json"))
+	if err != nil {
+		return nil, err
+	}
+	var d deployment
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, fmt.Errorf("read deployment %d: %w", n, err)
+	}
+	return &d, nil
+}
+
+// currentDeployment returns the generation in place, or nil before the first
+// deployment.
+func currentDeployment(cfg *Config) (*deployment, error) {
+	data, err := os.ReadFile(filepath.Join(deploymentsDir(cfg), "current"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid deployments/current: %q", strings.TrimSpace(string(data)))
+	}
+	return loadDeployment(cfg, n)
+}
+
+func setCurrentDeployment(cfg *Config, n int) error {
+	path := filepath.Join(deploymentsDir(cfg), "current")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.Itoa(n)+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// deploy brings the client and the server in line with state. Every file is
+// first copied next to its target and only then renamed over it, so a failed
+// copy changes nothing; if a rename fails, the previous generation is put
+// back. The result is kept as a new generation for rollback. A state that
+// matches what is deployed writes no generation. Returns the number of server
+// DBCs that changed.
+func deploy(cfg *Config, mods []string, state *deployState) (int, error) {
+	prev, err := currentDeployment(cfg)
+	if err != nil {
+		return 0, err
+	}
+	swaps := planSwaps(cfg, prev, state)
+	if len(swaps) == 0 {
+		return 0, nil
+	}
+
+	gens := listGenerations(cfg)
+	if prev == nil && len(gens) == 0 && len(listDeployedPatches(cfg)) > 0 {
+		// Keep what an earlier mithril left in the client, so the first
+		// deployment can be rolled back too
+		found := newDeployState()
+		found.keepClientDir(cfg, "Data")
+		found.keepClientDir(cfg, filepath.Join("Data", detectLocaleFromManifest(cfg)))
+		if prev, err = writeGeneration(cfg, 1, nil, found); err != nil {
+			return 0, err
+		}
+		if err := setCurrentDeployment(cfg, 1); err != nil {
+			return 0, err
+		}
+		gens = []int{1}
+	}
+
+	n := 1
+	if len(gens) > 0 {
+		n = gens[len(gens)-1] + 1
+	}
+	next, err := writeGeneration(cfg, n, mods, state)
+	if err != nil {
+		return 0, err
+	}
+	if err := saveOriginals(cfg, prev, swaps); err != nil {
+		os.RemoveAll(generationDir(cfg, n))
+		return 0, err
+	}
+
+	// Swap in the copies kept with the generation
+	for i := range swaps {
+		if swaps[i].source != "" {
+			swaps[i].source = generationSource(cfg, next, swaps[i].target)
+		}
+	}
+	fmt.Printf("\nDeploying generation %d...\n", n)
+	if err := swapFiles(swaps); err != nil {
+		os.RemoveAll(generationDir(cfg, n))
+		if prev != nil {
+			if rerr := restoreDeployment(cfg, prev, prev, swaps); rerr != nil {
+				return 0, fmt.Errorf("%w (restoring generation %d also failed: %v)", err, prev.Generation, rerr)
+			}
+			return 0, fmt.Errorf("%w — generation %d restored", err, prev.Generation)
+		}
+		return 0, err
+	}
+	if err := setCurrentDeployment(cfg, n); err != nil {
+		return 0, err
+	}
+	pruneGenerations(cfg, n)
+
+	serverChanged := 0
+	for _, s := range swaps {
+		if strings.HasPrefix(s.label, "server ") {
+			serverChanged++
+		}
+	}
+	return serverChanged, nil
+}
+
+// planSwaps lists the file changes that turn what is deployed into state.
+// Files that already have the right bytes are left alone.
+func planSwaps(cfg *Config, prev *deployment, state *deployState) []fileSwap {
+	var swaps []fileSwap
+	for _, rel := range sortedKeys(state.client) {
+		target := filepath.Join(cfg.ClientDir, filepath.FromSlash(rel))
+		if !filesEqual(state.client[rel], target) {
+			swaps = append(swaps, fileSwap{target: target, source: state.client[rel], label: "client " + rel})
+		}
+	}
+	for _, rel := range listDeployedPatches(cfg) {
+		if _, ok := state.client[rel]; !ok {
+			swaps = append(swaps, fileSwap{target: filepath.Join(cfg.ClientDir, filepath.FromSlash(rel)), label: "client " + rel, note: "removed"})
+		}
+	}
+
+	for _, name := range sortedKeys(state.server) {
+		target := filepath.Join(cfg.ServerDbcDir, name)
+		if !filesEqual(state.server[name], target) {
+			swaps = append(swaps, fileSwap{target: target, source: state.server[name], label: "server " + name})
+		}
+	}
+	if prev != nil {
+		for _, name := range prev.Server {
+			if _, ok := state.server[name]; ok {
+				continue
+			}
+			swaps = append(swaps, originalSwap(cfg, name))
+		}
+	}
+	return swaps
+}
+
+// originalSwap puts a server DBC back the way it was before mithril.
+func originalSwap(cfg *Config, name string) fileSwap {
+	swap := fileSwap{target: filepath.Join(cfg.ServerDbcDir, name), label: "server " + name, note: "removed"}
+	if original := filepath.Join(originalsDir(cfg), name); fileExists(original) {
+		swap.source = original
+		swap.note = "original restored"
+	}
+	return swap
+}
+
+// listDeployedPatches returns the mithril patches in the client's Data/ and
+// Data/<locale>/, relative to the client directory.
+func listDeployedPatches(cfg *Config) []string {
+	var rels []string
+	for _, rel := range []string{"Data", filepath.Join("Data", detectLocaleFromManifest(cfg))} {
+		for _, name := range listMithrilPatches(filepath.Join(cfg.ClientDir, rel)) {
+			rels = append(rels, filepath.ToSlash(filepath.Join(rel, name)))
+		}
+	}
+	return rels
+}
+
+// writeGeneration copies the files of state into generation n and records it.
+func writeGeneration(cfg *Config, n int, mods []string, state *deployState) (*deployment, error) {
+	dir := generationDir(cfg, n)
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	d := &deployment{Generation: n, DeployedAt: timeNow(), Mods: mods}
+	for _, rel := range sortedKeys(state.client) {
+		if err := copyInto(state.client[rel], filepath.Join(dir, "client", filepath.FromSlash(rel))); err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("stage %s: %w", rel, err)
+		}
+		d.Client = append(d.Client, rel)
+	}
+	for _, name := range sortedKeys(state.server) {
+		if err := copyInto(state.server[name], filepath.Join(dir, "server", name)); err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("stage %s: %w", name, err)
+		}
+		d.Server = append(d.Server, name)
+	}
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "deployment.json"), append(data, '\n'), 0644); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return d, nil
+}
+
+// generationSource returns the copy a generation keeps of a deployed file.
+func generationSource(cfg *Config, d *deployment, target string) string {
+	dir := generationDir(cfg, d.Generation)
+	if rel, err := filepath.Rel(cfg.ClientDir, target); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.Join(dir, "client", rel)
+	}
+	return filepath.Join(dir, "server", filepath.Base(target))
+}
+
+// saveOriginals keeps the server DBCs that are about to be replaced for the
+// first time.
+func saveOriginals(cfg *Config, prev *deployment, swaps []fileSwap) error {
+	managed := make(map[string]bool)
+	if prev != nil {
+		for _, name := range prev.Server {
+			managed[name] = true
+		}
+	}
+	for _, s := range swaps {
+		name := filepath.Base(s.target)
+		if !strings.HasPrefix(s.label, "server ") || managed[name] || !fileExists(s.target) {
+			continue
+		}
+		original := filepath.Join(originalsDir(cfg), name)
+		if fileExists(original) {
+			continue
+		}
+		if err := copyInto(s.target, original); err != nil {
+			return fmt.Errorf("keep original %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// swapFiles stages every new file next to its target, then renames them into
+// place and removes the files that go away.
+func swapFiles(swaps []fileSwap) error {
+	staged := make([]string, len(swaps))
+	cleanup := func() {
+		for _, tmp := range staged {
+			if tmp != "" {
+				os.Remove(tmp)
+			}
+		}
+	}
+	for i, s := range swaps {
+		if s.source == "" {
+			continue
+		}
+		tmp := filepath.Join(filepath.Dir(s.target), "."+filepath.Base(s.target)+".mithril-tmp")
+		if err := copyInto(s.source, tmp); err != nil {
+			cleanup()
+			return fmt.Errorf("stage %s: %w", s.label, err)
+		}
+		staged[i] = tmp
+	}
+
+	for i, s := range swaps {
+		if s.source == "" {
+			if err := os.Remove(s.target); err != nil && !os.IsNotExist(err) {
+				cleanup()
+				return fmt.Errorf("remove %s: %w", s.label, err)
+			}
+		} else if err := os.Rename(staged[i], s.target); err != nil {
+			cleanup()
+			return fmt.Errorf("replace %s: %w", s.label, err)
+		} else {
+			staged[i] = ""
+		}
+		if s.note != "" {
+			fmt.Printf("  ✓ %s (%s)\n", s.label, s.note)
+		} else {
+			fmt.Printf("  ✓ %s\n", s.label)
+		}
+	}
+	return nil
+}
+
+// restoreDeployment puts the files of generation d back in place. from is
+// the deployment being undone: its server DBCs that d doesn't have go back to
+// their originals, as do any server DBCs among touched.
+func restoreDeployment(cfg *Config, d, from *deployment, touched []fileSwap) error {
+	state := newDeployState()
+	for _, rel := range d.Client {
+		state.client[rel] = filepath.Join(generationDir(cfg, d.Generation), "client", filepath.FromSlash(rel))
+	}
+	for _, name := range d.Server {
+		state.server[name] = filepath.Join(generationDir(cfg, d.Generation), "server", name)
+	}
+	swaps := planSwaps(cfg, from, state)
+	planned := make(map[string]bool)
+	for _, s := range swaps {
+		planned[s.target] = true
+	}
+	for _, s := range touched {
+		name := filepath.Base(s.target)
+		if strings.HasPrefix(s.label, "server ") && state.server[name] == "" && !planned[s.target] {
+			swaps = append(swaps, originalSwap(cfg, name))
+		}
+	}
+	return swapFiles(swaps)
+}
+
+// pruneGenerations removes all but the newest generations, never current.
+func pruneGenerations(cfg *Config, current int) {
+	gens := listGenerations(cfg)
+	for i := 0; i < len(gens)-deployGenerationsKept; i++ {
+		if gens[i] != current {
+			os.RemoveAll(generationDir(cfg, gens[i]))
+		}
+	}
+}
+
+// copyInto copies src to dst, creating dst's directory.
+func copyInto(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	return copyFile(src, dst)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// runModBuildRollback restores the deployment before the current one: the
+// client patches and server DBCs exactly as that build left them.
+func runModBuildRollback(args []string) error {
+	cfg := DefaultConfig()
+	list := false
+	target := 0
+	to, args := parseStringFlag(args, "to")
+	for _, a := range args {
+		if a == "--list" {
+			list = true
+		} else {
+			return fmt.Errorf("usage: mithril mod build rollback [--to <generation>] [--list]")
+		}
+	}
+
+	cur, err := currentDeployment(cfg)
+	if err != nil {
+		return err
+	}
+	gens := listGenerations(cfg)
+	if list {
+		if len(gens) == 0 {
+			fmt.Println("No deployments yet.")
+			return nil
+		}
+		for _, n := range gens {
+			d, err := loadDeployment(cfg, n)
+			if err != nil {
+				printWarning(err.Error())
+				continue
+			}
+			marker := " "
+			if cur != nil && cur.Generation == n {
+				marker = "*"
+			}
+			mods := strings.Join(d.Mods, ", ")
+			if mods == "" {
+				mods = "(found in client)"
+			}
+			fmt.Printf("%s %3d  %s  %d client, %d server file(s)  %s\n", marker, n, d.DeployedAt, len(d.Client), len(d.Server), mods)
+		}
+		return nil
+	}
+	if cur == nil {
+		return fmt.Errorf("nothing to roll back — no deployment recorded yet")
+	}
+
+	if to != "" {
+		if target, err = strconv.Atoi(to); err != nil {
+			return fmt.Errorf("invalid --to %q", to)
+		}
+	} else {
+		for _, n := range gens {
+			if n < cur.Generation {
+				target = n
+			}
+		}
+		if target == 0 {
+			return fmt.Errorf("generation %d is the oldest kept — nothing earlier to roll back to", cur.Generation)
+		}
+	}
+	if target == cur.Generation {
+		fmt.Printf("Generation %d is already deployed.\n", target)
+		return nil
+	}
+	d, err := loadDeployment(cfg, target)
+	if err != nil {
+		return fmt.Errorf("generation %d not found (see --list)", target)
+	}
+
+	fmt.Printf("Rolling back from generation %d to %d...\n", cur.Generation, target)
+	if err := restoreDeployment(cfg, d, cur, nil); err != nil {
+		return err
+	}
+	if err := setCurrentDeployment(cfg, target); err != nil {
+		return err
+	}
+	fmt.Println()
+	printSuccess(fmt.Sprintf("Generation %d deployed (%s)", target, d.DeployedAt))
+	fmt.Println("⚠ Restart the server for changes to take effect:")
+	fmt.Println("  mithril server restart")
+	return nil
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// buildLockVersion changes whenever the lock format does.
+const buildLockVersion = 1
+
+// buildLock is modules/mithril.lock: the inputs of the last 'mod build' and
+// the MPQs it produced. It has no timestamps, so two builds from the same
+// inputs write the same lock, and it is meant to be committed alongside the
+// mods. 'mod build --locked' refuses to build from anything else.
+type buildLock struct {
+	LockVersion int    `json:"lock_version"`
+	Mithril     string `json:"mithril"`
+	// Baseline is the baseline the mods are built against. The hash covers
+	// the extracted files rather than manifest.json, which records where
+	// and when they were extracted.
+	Baseline    lockedBaseline `json:"baseline"`
+	PatchLetter string         `json:"patch_letter"`
+	DBCFidelity bool           `json:"dbc_fidelity,omitempty"`
+	DBCConflict string         `json:"dbc_conflict"`
+	BuildOrder  []string       `json:"build_order"`
+	Mods        []lockedMod    `json:"mods"`
+	// Outputs is the sha256 of each patch deployed to the client, by path
+	// relative to the client directory (e.g. "Data/patch-M.MPQ").
+	Outputs map[string]string `json:"outputs"`
+}
+
+type lockedBaseline struct {
+	Locale string `json:"locale"`
+	Hash   string `json:"hash"`
+}
+
+type lockedMod struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	// Hash covers every file in the mod directory except .git.
+	Hash string `json:"hash"`
+	// Repo and Commit are set for mods that are git checkouts, such as
+	// those installed from the registry.
+	Repo   string `json:"repo,omitempty"`
+	Commit string `json:"commit,omitempty"`
+}
+
+func buildLockPath(cfg *Config) string {
+	return filepath.Join(cfg.ModulesDir, "mithril.lock")
+}
+
+// loadBuildLock reads modules/mithril.lock.
+func loadBuildLock(cfg *Config) (*buildLock, error) {
+	data, err := os.ReadFile(buildLockPath(cfg))
+	if err != nil {
+		return nil, err
+	}
+	var lock buildLock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", buildLockPath(cfg), err)
+	}
+	if lock.LockVersion != buildLockVersion {
+		return nil, fmt.Errorf("%s has lock version %d, this mithril writes %d", buildLockPath(cfg), lock.LockVersion, buildLockVersion)
+	}
+	return &lock, nil
+}
+
+// save writes the lock, replacing the old one atomically.
+func (l *buildLock) save(cfg *Config) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := buildLockPath(cfg)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// currentBuildLock collects the inputs of a build of mods, in build order.
+// Outputs are left for the build to fill in.
+func currentBuildLock(cfg *Config, cache *buildCache, mods []string) (*buildLock, error) {
+	baseline, err := cache.dirSum(cfg.BaselineDir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("hash baseline: %w", err)
+	}
+	lock := &buildLock{
+		LockVersion: buildLockVersion,
+		Mithril:     toolVersion(),
+		Baseline:    lockedBaseline{Locale: detectLocaleFromManifest(cfg), Hash: baseline},
+		PatchLetter: cfg.PatchLetter,
+		DBCFidelity: cfg.DBCFidelity,
+		DBCConflict: cfg.DBCConflict,
+		BuildOrder:  append([]string{}, mods...),
+		Outputs:     make(map[string]string),
+	}
+	for _, mod := range mods {
+		dir := cfg.ModDir(mod)
+		hash, err := cache.dirSum(dir, func(rel string) bool {
+			return rel != ".git" && !strings.HasPrefix(rel, ".git/")
+		})
+		if err != nil {
+			return nil, fmt.Errorf("hash mod '%s': %w", mod, err)
+		}
+		lm := lockedMod{Name: mod, Hash: hash}
+		if meta, err := loadModMeta(cfg, mod); err == nil {
+			lm.Version = meta.Version
+		}
+		if fileExists(filepath.Join(dir, ".git")) {
+			lm.Repo = gitOutput(dir, "remote", "get-url", "origin")
+			lm.Commit = gitOutput(dir, "rev-parse", "HEAD")
+		}
+		lock.Mods = append(lock.Mods, lm)
+	}
+	return lock, nil
+}
+
+// gitOutput runs a git command in dir and returns its trimmed output, or ""
+// if it fails.
+func gitOutput(dir string, args ...string) string {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// setOutputs records the hash of every client patch a deployment leaves in
+// place.
+func (l *buildLock) setOutputs(cache *buildCache, state *deployState) error {
+	l.Outputs = make(map[string]string, len(state.client))
+	for rel, path := range state.client {
+		sum, err := cache.fileSum(path)
+		if err != nil {
+			return fmt.Errorf("hash %s: %w", rel, err)
+		}
+		l.Outputs[rel] = sum
+	}
+	return nil
+}
+
+// inputDiffs lists how the inputs of cur differ from the lock's.
+func (l *buildLock) inputDiffs(cur *buildLock) []string {
+	var diffs []string
+	changed := func(what, locked, now string) {
+		if locked != now {
+			diffs = append(diffs, fmt.Sprintf("%s: %s in the lock, %s now", what, orNone(locked), orNone(now)))
+		}
+	}
+	changed("mithril version", l.Mithril, cur.Mithril)
+	changed("baseline locale", l.Baseline.Locale, cur.Baseline.Locale)
+	if l.Baseline.Hash != cur.Baseline.Hash {
+		diffs = append(diffs, "baseline files differ")
+	}
+	changed("patch letter", l.PatchLetter, cur.PatchLetter)
+	changed("DBC fidelity", fmt.Sprint(l.DBCFidelity), fmt.Sprint(cur.DBCFidelity))
+	changed("DBC conflict policy", l.DBCConflict, cur.DBCConflict)
+	changed("build order", strings.Join(l.BuildOrder, ", "), strings.Join(cur.BuildOrder, ", "))
+
+	locked := make(map[string]lockedMod, len(l.Mods))
+	for _, m := range l.Mods {
+		locked[m.Name] = m
+	}
+	for _, m := range cur.Mods {
+		lm, ok := locked[m.Name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("mod '%s' is not in the lock", m.Name))
+			continue
+		}
+		delete(locked, m.Name)
+		changed(fmt.Sprintf("mod '%s' version", m.Name), lm.Version, m.Version)
+		changed(fmt.Sprintf("mod '%s' commit", m.Name), lm.Commit, m.Commit)
+		if lm.Hash != m.Hash {
+			diffs = append(diffs, fmt.Sprintf("mod '%s' files differ", m.Name))
+		}
+	}
+	var missing []string
+	for name := range locked {
+		missing = append(missing, name)
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		diffs = append(diffs, fmt.Sprintf("mod '%s' is in the lock but not installed", name))
+	}
+	return diffs
+}
+
+// outputDiffs lists how the outputs of cur differ from the lock's.
+func (l *buildLock) outputDiffs(cur *buildLock) []string {
+	var diffs []string
+	for _, rel := range sortedKeys(cur.Outputs) {
+		sum, ok := l.Outputs[rel]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("%s is not in the lock", rel))
+		} else if sum != cur.Outputs[rel] {
+			diffs = append(diffs, fmt.Sprintf("%s differs", rel))
+		}
+	}
+	for _, rel := range sortedKeys(l.Outputs) {
+		if _, ok := cur.Outputs[rel]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s is in the lock but was not built", rel))
+		}
+	}
+	return diffs
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+// lockMismatch formats the differences found by --locked.
+func lockMismatch(cfg *Config, what string, diffs []string, hint string) error {
+	return fmt.Errorf("%s differ from %s:\n  - %s\n%s",
+		what, buildLockPath(cfg), strings.Join(diffs, "\n  - "), hint)
+}
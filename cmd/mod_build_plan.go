@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// sqlTableRe finds the tables a SQL script writes to.
+var sqlTableRe = regexp.MustCompile("(?i)\\b(?:INSERT(?:\\s+IGNORE)?\\s+INTO|REPLACE\\s+INTO|UPDATE|DELETE\\s+FROM)\\s+`?(\\w+)`?")
+
+// printBuildPlan prints what 'mod build' would do for the same mods and
+// phases without doing any of it: nothing is applied to MySQL, and the
+// client, the server and the container are left alone.
+//
+// The DBC tables a mod's SQL migrations and YAML edits export are read from
+// the migrations themselves and from the mod's last build, since only the
+// database knows for sure.
+func printBuildPlan(cfg *Config, allMods, modsToBuild []string, phases buildPhases) error {
+	fmt.Println("=== Mithril Mod Build Plan ===")
+	if !phases.all() {
+		fmt.Printf("  Phases: %s\n", phases)
+	}
+	selected := make(map[string]bool, len(modsToBuild))
+	for _, mod := range modsToBuild {
+		selected[mod] = true
+	}
+
+	sqlTracker, err := loadSQLTracker(cfg)
+	if err != nil {
+		return fmt.Errorf("load SQL tracker: %w", err)
+	}
+	clientDataDir := filepath.Join(cfg.ClientDir, "Data")
+	locale := detectLocaleFromManifest(cfg)
+	clientLocaleDir := filepath.Join(clientDataDir, locale)
+	restartReasons := []string{}
+
+	// DBCs
+	dbcTables := make(map[string]bool) // MPQ file names packed into the DBC patch
+	if phases["dbc"] {
+		fmt.Println("\nDBCs:")
+		sharedDBTables := make(map[string]bool)
+		metasByTable := make(map[string]*dbc.MetaFile)
+		if metas, err := dbc.AllMetas(); err == nil {
+			for _, meta := range metas {
+				metasByTable[strings.ToLower(dbc.TableName(meta))] = meta
+			}
+		}
+
+		cache := loadBuildCache(cfg)
+		dbcKeys, err := cache.dbcInputKeys(cfg, allMods)
+		if err != nil {
+			return err
+		}
+
+		for _, mod := range modsToBuild {
+			fmt.Printf("  Mod '%s':\n", mod)
+			if cached, ok := cache.cachedDBCs(cfg, mod, dbcKeys[mod]); ok {
+				names := make(map[string]bool)
+				for _, bf := range cached {
+					names[filepath.Base(bf.diskPath)] = true
+					dbcTables[strings.ToLower(filepath.Base(bf.diskPath))] = true
+				}
+				if len(names) > 0 {
+					fmt.Printf("    unchanged since the last build (%s)\n", joinSorted(names))
+				} else {
+					fmt.Println("    (no DBC changes)")
+				}
+				continue
+			}
+			tables := make(map[string]bool)
+			migrations := findDBCMigrations(cfg, mod)
+			editFiles := findModDBCEditFiles(cfg, mod)
+			usesDB := len(migrations) > 0 || len(editFiles) > 0 || hasDBCEditState(cfg, mod)
+
+			for _, m := range migrations {
+				if sqlTracker.IsApplied(m.mod, m.filename) {
+					continue
+				}
+				fmt.Printf("    apply    sql/dbc/%s\n", m.filename)
+				data, err := os.ReadFile(m.path)
+				if err != nil {
+					return fmt.Errorf("read migration %s: %w", m.filename, err)
+				}
+				for _, match := range sqlTableRe.FindAllStringSubmatch(string(data), -1) {
+					if meta := metasByTable[strings.ToLower(match[1])]; meta != nil {
+						tables[meta.File] = true
+					}
+				}
+			}
+			for _, path := range editFiles {
+				status := dbcEditStatus(cfg, mod, path)
+				if status != "applied" {
+					fmt.Printf("    apply    dbc/%s (%s)\n", filepath.Base(path), status)
+				}
+				if edits, err := dbc.LoadEditFile(path); err == nil {
+					for _, te := range edits {
+						tables[te.Meta.File] = true
+					}
+				}
+			}
+			current := make(map[string]bool)
+			for _, path := range editFiles {
+				current[filepath.Base(path)] = true
+			}
+			for _, name := range appliedDBCEditFiles(cfg, mod) {
+				if !current[name] {
+					fmt.Printf("    rollback dbc/%s (removed)\n", name)
+				}
+			}
+			if usesDB {
+				// The shared database still holds what earlier builds applied
+				last, err := lastBuiltDBCs(cfg, mod)
+				if err != nil {
+					return fmt.Errorf("read last build of mod '%s': %w", mod, err)
+				}
+				for _, bf := range last {
+					tables[filepath.Base(bf.diskPath)] = true
+				}
+				for file := range tables {
+					sharedDBTables[strings.ToLower("DBFilesClient\\"+file)] = true
+				}
+			}
+			for _, path := range findModDBCTextFiles(cfg, mod) {
+				fmt.Printf("    merge    dbc/%s\n", filepath.Base(path))
+				if meta, err := dbc.GetMetaForDBC(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))); err == nil {
+					tables[meta.File] = true
+				}
+			}
+
+			if len(tables) == 0 {
+				fmt.Println("    (no DBC changes)")
+				continue
+			}
+			fmt.Printf("    export   %s\n", joinSorted(tables))
+			for file := range tables {
+				dbcTables[strings.ToLower(file)] = true
+			}
+		}
+
+		for _, mod := range allMods {
+			if selected[mod] {
+				continue
+			}
+			files, err := reusedModDBCs(cfg, mod, sharedDBTables)
+			if err != nil {
+				return fmt.Errorf("read last build of mod '%s': %w", mod, err)
+			}
+			if len(files) == 0 {
+				continue
+			}
+			names := make(map[string]bool)
+			for _, bf := range files {
+				names[filepath.Base(bf.diskPath)] = true
+				dbcTables[strings.ToLower(filepath.Base(bf.diskPath))] = true
+			}
+			fmt.Printf("  Mod '%s': last build reused (%s)\n", mod, joinSorted(names))
+		}
+
+		printPatchPlan(clientDataDir, "patch-"+cfg.PatchLetter+".MPQ", len(dbcTables), "DBC")
+		if _, err := os.Stat(cfg.ServerDbcDir); err == nil && len(dbcTables) > 0 {
+			fmt.Printf("  Server: copy those of %d DBC(s) that differ → %s\n", len(dbcTables), cfg.ServerDbcDir)
+			restartReasons = append(restartReasons, "if server DBCs change")
+		}
+	}
+
+	// Addons
+	if phases["addons"] {
+		fmt.Println("\nAddons:")
+		seen := make(map[string]bool)
+		for _, mod := range allMods {
+			files := modAddons(cfg, mod)
+			if len(files) == 0 {
+				continue
+			}
+			for _, bf := range files {
+				seen[strings.ToLower(bf.mpqPath)] = true
+			}
+			note := ""
+			if !selected[mod] {
+				note = ", not selected"
+			}
+			fmt.Printf("  Mod '%s': %d file(s)%s\n", mod, len(files), note)
+		}
+		printPatchPlan(clientLocaleDir, "patch-"+locale+"-"+cfg.PatchLetter+".MPQ", len(seen), "addon")
+	}
+
+	// Scripts
+	rebuildReasons := []string{}
+	if phases["scripts"] && modsHaveScripts(cfg, modsToBuild) {
+		fmt.Println("\nScripts:")
+		tracker, err := loadScriptTracker(cfg)
+		if err != nil {
+			return fmt.Errorf("load script tracker: %w", err)
+		}
+		_, toSync, toRemove := diffScripts(cfg, tracker)
+		for _, w := range toSync {
+			fmt.Printf("  sync     %s/%s\n", w.mod, w.file)
+		}
+		for _, s := range toRemove {
+			fmt.Printf("  remove   %s/%s\n", s.Mod, s.File)
+		}
+		if len(toSync) == 0 && len(toRemove) == 0 {
+			fmt.Println("  (up to date in container)")
+		} else {
+			rebuildReasons = append(rebuildReasons, "scripts changed")
+		}
+	}
+
+	// Core patches
+	if phases["core"] {
+		coreTracker, err := loadCoreTracker(cfg)
+		if err != nil {
+			return fmt.Errorf("load core tracker: %w", err)
+		}
+		var pending []corePatchInfo
+		for _, mod := range modsToBuild {
+			for _, p := range findCorePatches(cfg, mod) {
+				if !coreTracker.IsApplied(p.mod, p.filename) {
+					pending = append(pending, p)
+				}
+			}
+		}
+		if len(pending) > 0 {
+			fmt.Println("\nCore patches:")
+			for _, p := range pending {
+				fmt.Printf("  apply    %s/%s\n", p.mod, p.filename)
+			}
+			rebuildReasons = append(rebuildReasons, fmt.Sprintf("%d core patch(es)", len(pending)))
+		}
+	}
+
+	// Server SQL
+	if phases["sql"] {
+		var pending []migrationInfo
+		for _, mod := range modsToBuild {
+			for _, m := range findMigrations(cfg, mod) {
+				if m.database != "dbc" && !sqlTracker.IsApplied(m.mod, m.filename) {
+					pending = append(pending, m)
+				}
+			}
+		}
+		if len(pending) > 0 {
+			fmt.Println("\nSQL migrations:")
+			for _, m := range pending {
+				fmt.Printf("  apply    %s/%s → %s\n", m.mod, m.filename, m.database)
+			}
+			restartReasons = append(restartReasons, fmt.Sprintf("%d SQL migration(s)", len(pending)))
+		}
+	}
+
+	fmt.Println()
+	if len(rebuildReasons) > 0 {
+		fmt.Printf("TrinityCore rebuild: yes (%s)\n", strings.Join(rebuildReasons, ", "))
+		restartReasons = append(rebuildReasons, restartReasons...)
+	} else {
+		fmt.Println("TrinityCore rebuild: no")
+	}
+	if len(restartReasons) > 0 {
+		fmt.Printf("Server restart:      yes (%s)\n", strings.Join(restartReasons, ", "))
+	} else {
+		fmt.Println("Server restart:      no")
+	}
+	fmt.Println("\nNothing was changed. Run the same command without --plan to build.")
+	return nil
+}
+
+// printPatchPlan prints the MPQ a build would write to dir and the mithril
+// patches it would replace there.
+func printPatchPlan(dir, name string, files int, kind string) {
+	old := listMithrilPatches(dir)
+	if files == 0 {
+		fmt.Printf("  Client: no %s patch", kind)
+	} else {
+		fmt.Printf("  Client: write %s (%d %s file(s)) → %s", name, files, kind, dir)
+	}
+	if len(old) > 0 && files == 0 {
+		fmt.Printf(", removing %s", strings.Join(old, ", "))
+	} else if len(old) > 0 {
+		fmt.Printf(", replacing %s", strings.Join(old, ", "))
+	}
+	fmt.Println()
+}
+
+// joinSorted returns the keys of a set sorted and comma-separated.
+func joinSorted(set map[string]bool) string {
+	var keys []string
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
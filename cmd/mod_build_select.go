@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// buildPhaseNames lists the phases of 'mod build' in the order they run.
+var buildPhaseNames = []string{"dbc", "addons", "scripts", "core", "sql"}
+
+// buildPhases is the set of phases a build runs.
+type buildPhases map[string]bool
+
+// all reports whether every phase runs.
+func (p buildPhases) all() bool {
+	return len(p) == len(buildPhaseNames)
+}
+
+func (p buildPhases) String() string {
+	var names []string
+	for _, name := range buildPhaseNames {
+		if p[name] {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// parseBuildPhases turns --only and --skip (comma-separated phase names) into
+// the phases to run. Neither flag runs every phase.
+func parseBuildPhases(only, skip string) (buildPhases, error) {
+	if only != "" && skip != "" {
+		return nil, fmt.Errorf("use either --only or --skip, not both")
+	}
+	phases := make(buildPhases)
+	if only == "" {
+		for _, name := range buildPhaseNames {
+			phases[name] = true
+		}
+	}
+	list := only
+	if skip != "" {
+		list = skip
+	}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !isBuildPhase(name) {
+			return nil, fmt.Errorf("unknown build phase %q (use %s)", name, strings.Join(buildPhaseNames, ", "))
+		}
+		if only != "" {
+			phases[name] = true
+		} else {
+			delete(phases, name)
+		}
+	}
+	if len(phases) == 0 {
+		return nil, fmt.Errorf("no build phases left to run")
+	}
+	return phases, nil
+}
+
+func isBuildPhase(name string) bool {
+	for _, p := range buildPhaseNames {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// selectBuildMods checks the mods named with --mod (each may be a
+// comma-separated list) and returns them in build order. No names selects
+// every mod.
+func selectBuildMods(cfg *Config, names []string) ([]string, error) {
+	all := getAllMods(cfg)
+	if len(names) == 0 {
+		return all, nil
+	}
+	want := make(map[string]bool)
+	for _, n := range names {
+		for _, mod := range strings.Split(n, ",") {
+			mod = strings.TrimSpace(mod)
+			if mod == "" {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(cfg.ModDir(mod), "mod.json")); os.IsNotExist(err) {
+				return nil, fmt.Errorf("mod not found: %s", mod)
+			}
+			want[mod] = true
+		}
+	}
+	var selected []string
+	for _, mod := range all {
+		if want[mod] {
+			selected = append(selected, mod)
+		}
+	}
+	return selected, nil
+}
+
+// lastBuiltDBCs returns the DBCs a mod's previous build left in
+// modules/build/<mod>/DBFilesClient, so a build of other mods can pack them
+// without applying anything of this one.
+func lastBuiltDBCs(cfg *Config, mod string) ([]builtFile, error) {
+	paths, err := findRawDBCFiles(filepath.Join(cfg.ModulesBuildDir, mod, "DBFilesClient"))
+	if err != nil {
+		return nil, err
+	}
+	var files []builtFile
+	for _, path := range paths {
+		files = append(files, builtFile{diskPath: path, mpqPath: "DBFilesClient\\" + filepath.Base(path)})
+	}
+	return files, nil
+}
+
+// reusedModDBCs returns the DBCs of a mod's last build that a build of other
+// mods packs for it. A table just exported from the shared dbc database
+// (sharedDBTables, by lower-case MPQ path) already carries every mod's
+// applied migrations and YAML edits, so the older copy is dropped rather than
+// bringing back stale rows — unless the mod builds that table from a CSV/JSON
+// file, which only its own copy has.
+func reusedModDBCs(cfg *Config, mod string, sharedDBTables map[string]bool) ([]builtFile, error) {
+	files, err := lastBuiltDBCs(cfg, mod)
+	if err != nil {
+		return nil, err
+	}
+	textTables := make(map[string]bool)
+	for _, path := range findModDBCTextFiles(cfg, mod) {
+		if meta, err := dbc.GetMetaForDBC(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))); err == nil {
+			textTables[strings.ToLower("DBFilesClient\\"+meta.File)] = true
+		}
+	}
+	var reused []builtFile
+	for _, bf := range files {
+		key := strings.ToLower(bf.mpqPath)
+		if sharedDBTables[key] && !textTables[key] {
+			continue
+		}
+		reused = append(reused, bf)
+	}
+	return reused, nil
+}
+
+// modsHaveScripts reports whether any of the mods has scripts, or had scripts
+// synced to the container that may need removing.
+func modsHaveScripts(cfg *Config, mods []string) bool {
+	tracked := make(map[string]bool)
+	if tracker, err := loadScriptTracker(cfg); err == nil {
+		for _, s := range tracker.Scripts {
+			tracked[s.Mod] = true
+		}
+	}
+	for _, mod := range mods {
+		if tracked[mod] || len(findModScripts(cfg, mod)) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
 )
 
 func runModDBC(subcmd string, args []string) error {
@@ -15,10 +17,36 @@ func runModDBC(subcmd string, args []string) error {
 		return runModDBCImport(args)
 	case "query":
 		return runModDBCQuery(args)
+	case "shell":
+		return runModDBCShell(args)
 	case "export":
 		return runModDBCExport(args)
 	case "remove":
 		return runModDBCRemove(args)
+	case "dump":
+		return runModDBCDump(args)
+	case "load":
+		return runModDBCLoad(args)
+	case "diff":
+		return runModDBCDiff(args)
+	case "capture":
+		return runModDBCCapture(args)
+	case "infer":
+		return runModDBCInfer(args)
+	case "validate":
+		return runModDBCValidate(args)
+	case "verify-roundtrip":
+		return runModDBCVerifyRoundtrip(args)
+	case "reserve":
+		return runModDBCReserve(args)
+	case "l10n":
+		return runModDBCL10n(args)
+	case "show":
+		return runModDBCShow(args)
+	case "coverage":
+		return runModDBCCoverage(args)
+	case "compile":
+		return runModDBCCompile(args)
 	case "-h", "--help", "help":
 		fmt.Print(modUsage)
 		return nil
@@ -27,6 +55,18 @@ func runModDBC(subcmd string, args []string) error {
 	}
 }
 
+// configureMetaDirs registers the meta override directories, highest precedence
+// first: mithril-data/meta/, then each mod's meta/ directory with later mods in
+// the build order winning over earlier ones. Embedded metas are searched last.
+func configureMetaDirs(cfg *Config) {
+	dirs := []string{cfg.MetaDir}
+	mods := getAllMods(cfg)
+	for i := len(mods) - 1; i >= 0; i-- {
+		dirs = append(dirs, filepath.Join(cfg.ModDir(mods[i]), "meta"))
+	}
+	dbc.SetMetaDirs(dirs...)
+}
+
 // runModDBCRemove removes a DBC SQL migration. Shorthand for `mithril mod sql remove --db dbc`.
 func runModDBCRemove(args []string) error {
 	return runModSQLRemove(args)
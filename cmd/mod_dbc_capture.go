@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCCapture turns the current state of the dbc database into a
+// migration pair. Every difference from what the baseline and the applied DBC
+// migrations and YAML edits account for becomes an INSERT / UPDATE / DELETE
+// statement, with the reverse in the rollback file. The migration is marked
+// applied since the changes are already in the database.
+func runModDBCCapture(args []string) error {
+	modName, remaining := parseModFlag(args)
+	tableFilter, remaining := parseStringFlag(remaining, "table")
+	if modName == "" || len(remaining) < 1 {
+		return fmt.Errorf("usage: mithril mod dbc capture <name> --mod <mod_name> [--table <table>]")
+	}
+
+	cfg := DefaultConfig()
+	name := remaining[0]
+
+	if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+		return fmt.Errorf("mod not found: %s (run 'mithril mod create %s' first)", modName, modName)
+	}
+	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+
+	db, err := openDBCDB(cfg)
+	if err != nil {
+		return fmt.Errorf("connect to dbc database: %w", err)
+	}
+	defer db.Close()
+
+	tracker, err := loadSQLTracker(cfg)
+	if err != nil {
+		return fmt.Errorf("load migration tracker: %w", err)
+	}
+
+	// Rows that applied migrations and YAML edits put in the database are
+	// already in a migration, so the capture compares against a replay of
+	// them rather than the bare baseline
+	var expected *sql.DB
+	if hasAppliedDBCChanges(cfg, tracker) {
+		fmt.Println("Replaying applied DBC migrations and YAML edits...")
+		scratch, drop, err := openDBCScratch(cfg, modName)
+		if err != nil {
+			return err
+		}
+		defer drop()
+		if err := replayAppliedDBCChanges(cfg, tracker, scratch); err != nil {
+			return err
+		}
+		expected = scratch
+	}
+
+	var metas []*dbc.MetaFile
+	if tableFilter != "" {
+		meta, err := dbc.GetMetaForDBC(tableFilter)
+		if err != nil {
+			return err
+		}
+		metas = append(metas, meta)
+	} else {
+		all, err := dbc.AllMetas()
+		if err != nil {
+			return fmt.Errorf("get meta files: %w", err)
+		}
+		for _, meta := range all {
+			table := dbc.TableName(meta)
+			if tableModified(db, table) || (expected != nil && tableModified(expected, table)) {
+				metas = append(metas, meta)
+			}
+		}
+	}
+
+	// Every UPDATE and DELETE matches one record by its key, so a table
+	// whose records can't be told apart can't be captured
+	for _, meta := range metas {
+		if _, err := dbc.KeyColumns(meta); err != nil {
+			return fmt.Errorf("cannot capture %s: %w", dbc.TableName(meta), err)
+		}
+	}
+
+	var forward, rollback strings.Builder
+	var captured []string
+	for _, meta := range metas {
+		dbcFile, err := dbc.ExportTable(db, meta)
+		if err != nil {
+			return err
+		}
+		var base []dbc.Row
+		if expected != nil {
+			expectedFile, err := dbc.ExportTable(expected, meta)
+			if err != nil {
+				return err
+			}
+			base = dbc.DecodeRows(expectedFile, meta)
+		} else if base, err = loadBaselineRows(cfg, meta); err != nil {
+			return err
+		}
+		d, err := dbc.DiffRows(base, dbc.DecodeRows(dbcFile, meta), meta)
+		if err != nil {
+			return err
+		}
+		if d.Empty() {
+			continue
+		}
+
+		fw, rb := dbc.DiffSQL(d, meta)
+		tableName := dbc.TableName(meta)
+		fmt.Fprintf(&forward, "-- %s: %d added, %d removed, %d changed\n%s\n",
+			tableName, len(d.Added), len(d.Removed), len(d.Changed), fw)
+		fmt.Fprintf(&rollback, "-- %s\n%s\n", tableName, rb)
+		captured = append(captured, fmt.Sprintf("%s (+%d -%d ~%d)", tableName, len(d.Added), len(d.Removed), len(d.Changed)))
+	}
+
+	if len(captured) == 0 {
+		fmt.Println("No changes beyond the baseline and applied migrations — nothing to capture.")
+		return nil
+	}
+
+	sqlDir := filepath.Join(cfg.ModDir(modName), "sql", "dbc")
+	if err := os.MkdirAll(sqlDir, 0755); err != nil {
+		return fmt.Errorf("create sql directory: %w", err)
+	}
+
+	forwardFilename, rollbackFilename := nextMigrationFilenames(cfg, modName, "dbc", name)
+	forwardPath := filepath.Join(sqlDir, forwardFilename)
+	rollbackPath := filepath.Join(sqlDir, rollbackFilename)
+
+	forwardContent := fmt.Sprintf(`-- Migration: %s
+-- Database: dbc
+-- Mod: %s
+--
+-- Captured from the dbc database by 'mithril mod dbc capture'
+--
+
+%s`, name, modName, forward.String())
+
+	rollbackContent := fmt.Sprintf(`-- Rollback: %s
+-- Database: dbc
+-- Mod: %s
+--
+-- Undoes the changes made by %s
+--
+
+%s`, name, modName, forwardFilename, rollback.String())
+
+	if err := os.WriteFile(forwardPath, []byte(forwardContent), 0644); err != nil {
+		return fmt.Errorf("create migration file: %w", err)
+	}
+	if err := os.WriteFile(rollbackPath, []byte(rollbackContent), 0644); err != nil {
+		return fmt.Errorf("create rollback file: %w", err)
+	}
+
+	tracker.Applied = append(tracker.Applied, AppliedMigration{
+		Mod:       modName,
+		File:      forwardFilename,
+		Database:  "dbc",
+		AppliedAt: timeNow(),
+	})
+	if err := saveSQLTracker(cfg, tracker); err != nil {
+		return fmt.Errorf("save migration tracker: %w", err)
+	}
+
+	fmt.Printf("✓ Captured %d table(s):\n", len(captured))
+	for _, c := range captured {
+		fmt.Printf("  %s\n", c)
+	}
+	fmt.Printf("  Forward:  %s\n", forwardPath)
+	fmt.Printf("  Rollback: %s\n", rollbackPath)
+	fmt.Println("  Marked as applied (the changes are already in the database).")
+
+	return nil
+}
+
+// hasAppliedDBCChanges reports whether any DBC migration or YAML edit file is
+// applied to the shared dbc database.
+func hasAppliedDBCChanges(cfg *Config, tracker *SQLTracker) bool {
+	for _, a := range tracker.Applied {
+		if a.Database == "dbc" {
+			return true
+		}
+	}
+	for _, mod := range getAllMods(cfg) {
+		if len(appliedDBCEditFiles(cfg, mod)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// replayAppliedDBCChanges applies to db what the shared dbc database has been
+// given, the way mod build gives it: mod by mod in build order, each mod's
+// applied sql/dbc/ migrations followed by its applied YAML edits.
+func replayAppliedDBCChanges(cfg *Config, tracker *SQLTracker, db *sql.DB) error {
+	if err := ensureCustomDBCTables(cfg, db); err != nil {
+		return err
+	}
+	mods := getAllMods(cfg)
+	onDisk := make(map[string]bool, len(mods))
+	for _, mod := range mods {
+		onDisk[mod] = true
+	}
+	for _, a := range tracker.Applied {
+		if a.Database == "dbc" && !onDisk[a.Mod] {
+			return fmt.Errorf("DBC migration %s of mod '%s' is applied but the mod is gone, so capture can't replay it", a.File, a.Mod)
+		}
+	}
+
+	for _, mod := range mods {
+		replayed := make(map[string]bool)
+		for _, m := range findDBCMigrations(cfg, mod) {
+			if !tracker.IsApplied(mod, m.filename) {
+				continue
+			}
+			sqlContent, err := readMigrationSQL(cfg, m.mod, m.database, m.path)
+			if err != nil {
+				return fmt.Errorf("read migration %s: %w", m.filename, err)
+			}
+			if _, err := db.Exec(sqlContent); err != nil {
+				return fmt.Errorf("replay migration %s: %w", m.filename, err)
+			}
+			replayed[m.filename] = true
+		}
+		for _, a := range tracker.Applied {
+			if a.Database == "dbc" && a.Mod == mod && !replayed[a.File] {
+				return fmt.Errorf("DBC migration %s of mod '%s' is applied but its file is gone, so capture can't replay it", a.File, mod)
+			}
+		}
+
+		stateDir := dbcEditStateDir(cfg, mod)
+		for _, name := range appliedDBCEditFiles(cfg, mod) {
+			forward, err := os.ReadFile(filepath.Join(stateDir, name+".sql"))
+			if err != nil {
+				return err
+			}
+			if _, err := db.Exec(string(forward)); err != nil {
+				return fmt.Errorf("replay DBC edits %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
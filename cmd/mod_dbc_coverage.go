@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCCoverage checks every baseline DBC against its meta: that one
+// exists, that its record size and field count match the file header, and
+// that no string offset points outside the string block. Catches schema
+// mistakes before they turn into garbage exports. No MySQL needed.
+func runModDBCCoverage(args []string) error {
+	verbose := false
+	var remaining []string
+	for _, a := range args {
+		if a == "-v" || a == "--verbose" {
+			verbose = true
+		} else {
+			remaining = append(remaining, a)
+		}
+	}
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+
+	var paths []string
+	if len(remaining) > 0 {
+		for _, name := range remaining {
+			if !strings.HasSuffix(strings.ToLower(name), ".dbc") {
+				name += ".dbc"
+			}
+			path := dbc.FindDBCFile(cfg.BaselineDbcDir, name)
+			if path == "" {
+				return fmt.Errorf("DBC not found in baseline: %s", name)
+			}
+			paths = append(paths, path)
+		}
+	} else {
+		all, err := findRawDBCFiles(cfg.BaselineDbcDir)
+		if err != nil {
+			return fmt.Errorf("list baseline DBCs: %w", err)
+		}
+		paths = all
+	}
+
+	fmt.Printf("Checking %d baseline DBC(s) against their schemas...\n", len(paths))
+	ok, mismatched, badStrings := 0, 0, 0
+	var noMeta []string
+	for _, path := range paths {
+		fileName := filepath.Base(path)
+		meta, err := dbc.GetMetaForDBC(fileName)
+		if err != nil {
+			noMeta = append(noMeta, fileName)
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", path, err)
+		}
+
+		check := dbc.CheckSchema(data, meta)
+		source := ""
+		if src := dbc.MetaSource(fileName); src != "embedded" {
+			source = fmt.Sprintf(" (meta: %s)", src)
+		}
+		switch {
+		case check.OK():
+			ok++
+			if verbose {
+				fmt.Printf("  ✓ %s: %d records, %d bytes each\n", fileName, check.Header.RecordCount, check.Header.RecordSize)
+			}
+		case !check.RecordSizeOK() || !check.FieldCountOK() || check.Err != nil:
+			mismatched++
+			var problems []string
+			if string(check.Header.Magic[:]) != "WDBC" { // header unreadable
+				problems = append(problems, check.Err.Error())
+			} else {
+				if !check.RecordSizeOK() {
+					problems = append(problems, fmt.Sprintf("record size %d, meta expects %d", check.Header.RecordSize, check.MetaRecordSize))
+				}
+				if !check.FieldCountOK() {
+					problems = append(problems, fmt.Sprintf("field count %d, meta expects %d", check.Header.FieldCount, check.MetaFieldCount))
+				}
+				if check.Err != nil {
+					problems = append(problems, check.Err.Error())
+				}
+			}
+			fmt.Printf("  ✗ %s: %s%s\n", fileName, strings.Join(problems, "; "), source)
+		default:
+			badStrings++
+			first := check.BadStrings[0]
+			fmt.Printf("  ⚠ %s: %d string offset(s) outside the %d-byte string block, first in record %d column %s (offset %d)%s\n",
+				fileName, len(check.BadStrings), check.Header.StringBlockSize, first.Record, first.Column, first.Offset, source)
+			if verbose {
+				for _, bad := range check.BadStrings[1:] {
+					fmt.Printf("      record %d column %s (offset %d)\n", bad.Record, bad.Column, bad.Offset)
+				}
+			}
+		}
+	}
+
+	if len(noMeta) > 0 {
+		if verbose || len(noMeta) <= 10 {
+			for _, name := range noMeta {
+				fmt.Printf("  - %s: no meta\n", name)
+			}
+		} else {
+			fmt.Printf("  - %d DBC(s) without a meta (show them with -v)\n", len(noMeta))
+		}
+	}
+
+	total := len(paths)
+	covered := total - len(noMeta)
+	fmt.Printf("\n%d DBC(s): %d OK, %d mismatched, %d with suspicious strings, %d without a meta", total, ok, mismatched, badStrings, len(noMeta))
+	if total > 0 {
+		fmt.Printf(" (%.1f%% have a meta)", 100*float64(covered)/float64(total))
+	}
+	fmt.Println()
+	if len(noMeta) > 0 {
+		fmt.Println("  Draft a schema for a DBC without one with: mithril mod dbc infer <File.dbc>")
+	}
+	if mismatched+badStrings > 0 {
+		return fmt.Errorf("%d DBC schema(s) don't match the baseline files — fix the meta (see 'Schema Overrides' in docs/dbc-workflow.md)", mismatched+badStrings)
+	}
+	fmt.Println("✓ Every DBC with a meta matches its schema")
+	return nil
+}
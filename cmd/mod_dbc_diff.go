@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCDiff shows record-level differences against the baseline, either
+// for a mod's built DBCs (--mod) or for the live dbc database.
+func runModDBCDiff(args []string) error {
+	modName, remaining := parseModFlag(args)
+	cfg := DefaultConfig()
+
+	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+
+	var table string
+	if len(remaining) > 0 {
+		table = remaining[0]
+	}
+
+	var diffs int
+	var err error
+	if modName != "" {
+		diffs, err = diffModBuild(cfg, modName, table)
+	} else {
+		diffs, err = diffLiveDB(cfg, table)
+	}
+	if err != nil {
+		return err
+	}
+
+	if diffs == 0 {
+		fmt.Println("No differences from baseline.")
+	}
+	return nil
+}
+
+// diffModBuild diffs the .dbc files in modules/build/<mod>/DBFilesClient.
+func diffModBuild(cfg *Config, modName, table string) (int, error) {
+	if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+		return 0, fmt.Errorf("mod not found: %s", modName)
+	}
+	buildDbcDir := filepath.Join(cfg.ModulesBuildDir, modName, "DBFilesClient")
+
+	var paths []string
+	if table != "" {
+		meta, err := dbc.GetMetaForDBC(table)
+		if err != nil {
+			return 0, err
+		}
+		path := dbc.FindDBCFile(buildDbcDir, meta.File)
+		if path == "" {
+			return 0, fmt.Errorf("%s has not been built for mod '%s' — run 'mithril mod build' first", meta.File, modName)
+		}
+		paths = []string{path}
+	} else {
+		var err error
+		paths, err = findRawDBCFiles(buildDbcDir)
+		if err != nil {
+			return 0, fmt.Errorf("read build dir: %w", err)
+		}
+		if len(paths) == 0 {
+			fmt.Printf("No built DBCs for mod '%s' — run 'mithril mod build' first.\n", modName)
+			return 0, nil
+		}
+		sort.Strings(paths)
+	}
+
+	diffs := 0
+	for _, path := range paths {
+		meta, err := dbc.GetMetaForDBC(filepath.Base(path))
+		if err != nil {
+			printWarning(fmt.Sprintf("%s: %v", filepath.Base(path), err))
+			continue
+		}
+		current, err := loadDBCRows(path, meta)
+		if err != nil {
+			return diffs, err
+		}
+		if printTableDiff(cfg, meta, current) {
+			diffs++
+		}
+	}
+	return diffs, nil
+}
+
+// diffLiveDB diffs tables in the MySQL dbc database. Without a table name,
+// only tables whose checksum differs from the import checksum are compared.
+func diffLiveDB(cfg *Config, table string) (int, error) {
+	db, err := openDBCDB(cfg)
+	if err != nil {
+		return 0, fmt.Errorf("connect to dbc database: %w", err)
+	}
+	defer db.Close()
+
+	var metas []*dbc.MetaFile
+	if table != "" {
+		meta, err := dbc.GetMetaForDBC(table)
+		if err != nil {
+			return 0, err
+		}
+		metas = append(metas, meta)
+	} else {
+		all, err := dbc.AllMetas()
+		if err != nil {
+			return 0, fmt.Errorf("get meta files: %w", err)
+		}
+		for _, meta := range all {
+			if tableModified(db, dbc.TableName(meta)) {
+				metas = append(metas, meta)
+			}
+		}
+	}
+
+	diffs := 0
+	for _, meta := range metas {
+		dbcFile, err := dbc.ExportTable(db, meta)
+		if err != nil {
+			return diffs, err
+		}
+		if printTableDiff(cfg, meta, dbc.DecodeRows(dbcFile, meta)) {
+			diffs++
+		}
+	}
+	return diffs, nil
+}
+
+// tableModified reports whether a dbc table's checksum differs from the one
+// stored at import time. Missing tables are treated as unmodified.
+func tableModified(db *sql.DB, tableName string) bool {
+	current, err := dbc.GetTableChecksum(db, tableName)
+	if err != nil {
+		return false
+	}
+	stored, err := dbc.GetStoredChecksum(db, tableName)
+	if err != nil {
+		return false
+	}
+	return current != stored
+}
+
+// printTableDiff prints the record-level diff of one table against the
+// baseline. Returns false if there is nothing to report.
+func printTableDiff(cfg *Config, meta *dbc.MetaFile, current []dbc.Row) bool {
+	base, err := loadBaselineRows(cfg, meta)
+	if err != nil {
+		printWarning(fmt.Sprintf("%s: %v", meta.File, err))
+		return false
+	}
+
+	d, err := dbc.DiffRows(base, current, meta)
+	if err != nil {
+		printWarning(err.Error())
+		return false
+	}
+	if d.Empty() {
+		return false
+	}
+
+	fmt.Printf("=== %s (%s) ===\n", dbc.TableName(meta), meta.File)
+	for _, row := range d.Added {
+		fmt.Printf("  + %s\n", d.KeyString(row))
+		for i, col := range d.Columns {
+			if isKeyColumn(d.KeyCols, i) || isZeroValue(row[i]) {
+				continue
+			}
+			fmt.Printf("      %s: %s\n", col.Name, formatDiffValue(row[i]))
+		}
+	}
+	for _, row := range d.Removed {
+		fmt.Printf("  - %s\n", d.KeyString(row))
+	}
+	for _, rc := range d.Changed {
+		fmt.Printf("  ~ %s\n", rc.Key)
+		for _, fc := range rc.Changes {
+			fmt.Printf("      %s: %s → %s\n", fc.Column, formatDiffValue(fc.Old), formatDiffValue(fc.New))
+		}
+	}
+	fmt.Printf("  %d added, %d removed, %d changed\n\n", len(d.Added), len(d.Removed), len(d.Changed))
+	return true
+}
+
+func isKeyColumn(keyCols []int, i int) bool {
+	for _, k := range keyCols {
+		if k == i {
+			return true
+		}
+	}
+	return false
+}
+
+func isZeroValue(v interface{}) bool {
+	s := dbc.FormatValue(v)
+	return s == "" || s == "0"
+}
+
+// formatDiffValue quotes strings so empty and whitespace values stay visible.
+func formatDiffValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return dbc.FormatValue(v)
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCInfer writes a draft meta.json for a DBC that has no embedded schema.
+// Written to mithril-data/meta/ (or a mod's meta/ with --mod) so it's picked up
+// as an override right away.
+func runModDBCInfer(args []string) error {
+	modName, remaining := parseModFlag(args)
+	outPath, remaining := parseStringFlag(remaining, "out")
+	if outPath == "" {
+		outPath, remaining = parseShortFlag(remaining, "-o")
+	}
+	if len(remaining) < 1 {
+		return fmt.Errorf("usage: mithril mod dbc infer <File.dbc> [--mod <mod>] [-o <file.meta.json>]")
+	}
+
+	cfg := DefaultConfig()
+	target := remaining[0]
+
+	// Accept a path on disk or a name from the baseline
+	dbcPath := target
+	if !fileExists(dbcPath) {
+		name := target
+		if !strings.HasSuffix(strings.ToLower(name), ".dbc") {
+			name += ".dbc"
+		}
+		dbcPath = dbc.FindDBCFile(cfg.BaselineDbcDir, name)
+		if dbcPath == "" {
+			return fmt.Errorf("DBC not found: %s (looked in %s)", target, cfg.BaselineDbcDir)
+		}
+	}
+	fileName := filepath.Base(dbcPath)
+
+	// A draft in a meta dir would replace the existing schema, so only write
+	// one for a file that already has a schema when asked to explicitly.
+	if _, err := dbc.GetMetaForDBC(fileName); err == nil && outPath == "" {
+		return fmt.Errorf("%s already has a schema (%s) — pass -o <file> to write a draft anyway", fileName, dbc.MetaSource(fileName))
+	}
+
+	data, err := os.ReadFile(dbcPath)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", dbcPath, err)
+	}
+	meta, err := dbc.InferMeta(data, fileName)
+	if err != nil {
+		return fmt.Errorf("infer %s: %w", fileName, err)
+	}
+
+	// Make sure the draft actually parses the file it came from
+	if _, err := dbc.LoadDBCFromBytes(data, *meta); err != nil {
+		return fmt.Errorf("inferred schema does not parse %s: %w", fileName, err)
+	}
+
+	out, err := dbc.MarshalMeta(meta)
+	if err != nil {
+		return fmt.Errorf("encode meta: %w", err)
+	}
+	if outPath == "" {
+		metaDir := cfg.MetaDir
+		if modName != "" {
+			if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+				return fmt.Errorf("mod not found: %s", modName)
+			}
+			metaDir = filepath.Join(cfg.ModDir(modName), "meta")
+		}
+		outPath = filepath.Join(metaDir, strings.ToLower(strings.TrimSuffix(fileName, filepath.Ext(fileName)))+".meta.json")
+		if fileExists(outPath) {
+			return fmt.Errorf("%s already exists — remove it or pass -o <file>", outPath)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+		return fmt.Errorf("create output dir: %w", err)
+	}
+	if err := os.WriteFile(outPath, out, 0644); err != nil {
+		return fmt.Errorf("write %s: %w", outPath, err)
+	}
+
+	counts := make(map[string]int)
+	for _, f := range meta.Fields {
+		counts[f.Type]++
+	}
+	fmt.Printf("✓ Inferred %d fields for %s → %s\n", len(meta.Fields), fileName, outPath)
+	for _, typ := range []string{"uint32", "int32", "float", "string", "Loc", "uint8"} {
+		if counts[typ] > 0 {
+			fmt.Printf("  %-7s %d\n", typ, counts[typ])
+		}
+	}
+	fmt.Println("\nThis is a draft: field names are placeholders, and flag or ID columns")
+	fmt.Println("can be mistaken for floats or strings. Review it before relying on it.")
+	return nil
+}
@@ -0,0 +1,399 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+func runModDBCL10n(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: mithril mod dbc l10n <export|import> ...")
+	}
+	switch args[0] {
+	case "export":
+		return runModDBCL10nExport(args[1:])
+	case "import":
+		return runModDBCL10nImport(args[1:])
+	default:
+		return fmt.Errorf("unknown mod dbc l10n command: %s (expected export or import)", args[0])
+	}
+}
+
+// runModDBCL10nExport writes a PO or XLIFF file with every Loc string a mod
+// adds or changes, compared against the baseline in the mod's built DBCs.
+// Translations already in the output file or in the built DBCs are kept.
+func runModDBCL10nExport(args []string) error {
+	modName, remaining := parseModFlag(args)
+	langFlag, remaining := parseStringFlag(remaining, "lang")
+	format, remaining := parseStringFlag(remaining, "format")
+	outPath, remaining := parseShortFlag(remaining, "-o")
+	if modName == "" || langFlag == "" {
+		return fmt.Errorf("usage: mithril mod dbc l10n export --mod <mod> --lang <lang> [--format po|xliff] [-o <file>] [<table>]")
+	}
+	lang, err := dbc.LocLangIndex(langFlag)
+	if err != nil {
+		return err
+	}
+	if lang == 0 {
+		return fmt.Errorf("enUS is the source language — pick a language to translate into")
+	}
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+		return fmt.Errorf("mod not found: %s", modName)
+	}
+
+	if format == "" && outPath != "" {
+		format = dbc.L10nFormatFromPath(outPath)
+	}
+	if format == "" {
+		format = dbc.FormatPO
+	}
+	format = strings.ToLower(format)
+	if format != dbc.FormatPO && format != dbc.FormatXLIFF {
+		return fmt.Errorf("unknown format: %s (expected po or xliff)", format)
+	}
+	if outPath == "" {
+		outPath = filepath.Join(cfg.ModDir(modName), "l10n", dbc.LocLangCode(lang, "")+"."+format)
+	}
+
+	var table string
+	if len(remaining) > 0 {
+		table = remaining[0]
+	}
+	paths, err := modBuiltDBCs(cfg, modName, table)
+	if err != nil {
+		return err
+	}
+
+	var strs []dbc.LocString
+	for _, path := range paths {
+		meta, err := dbc.GetMetaForDBC(filepath.Base(path))
+		if err != nil {
+			printWarning(fmt.Sprintf("%s: %v", filepath.Base(path), err))
+			continue
+		}
+		if !hasLocFields(meta) {
+			continue
+		}
+		current, err := loadDBCRows(path, meta)
+		if err != nil {
+			return err
+		}
+		base, err := loadBaselineRows(cfg, meta)
+		if err != nil {
+			return err
+		}
+		d, err := dbc.DiffRows(base, current, meta)
+		if err != nil {
+			return err
+		}
+		strs = append(strs, dbc.CollectLocStrings(d, meta, lang)...)
+	}
+	if len(strs) == 0 {
+		fmt.Printf("Mod '%s' adds or changes no localized strings.\n", modName)
+		return nil
+	}
+	if err := checkLocStringIDs(strs); err != nil {
+		return err
+	}
+
+	// Keep work from a previous export that hasn't been imported yet
+	if previous, err := readLocStringsFile(outPath); err == nil {
+		kept := make(map[string]dbc.LocString, len(previous))
+		for _, s := range previous {
+			kept[s.ID()] = s
+		}
+		for i, s := range strs {
+			if p, ok := kept[s.ID()]; ok && p.Source == s.Source && p.Translation != "" {
+				strs[i].Translation = p.Translation
+			}
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+		return fmt.Errorf("create output dir: %w", err)
+	}
+	f, err := os.Create(outPath)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", outPath, err)
+	}
+	if err := dbc.WriteLocStrings(f, strs, lang, modName, format); err != nil {
+		f.Close()
+		return fmt.Errorf("write %s: %w", outPath, err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	translated := 0
+	for _, s := range strs {
+		if s.Translation != "" {
+			translated++
+		}
+	}
+	fmt.Printf("✓ Exported %d string(s) for %s (%d already translated) to %s\n", len(strs), dbc.LocLangCode(lang, ""), translated, outPath)
+	fmt.Println("  When translated, turn it into a DBC migration with:")
+	fmt.Printf("  mithril mod dbc l10n import %s --mod %s\n", outPath, modName)
+	return nil
+}
+
+// runModDBCL10nImport turns a translated PO or XLIFF file into a DBC SQL
+// migration pair that sets the target language's columns.
+func runModDBCL10nImport(args []string) error {
+	modName, remaining := parseModFlag(args)
+	name, remaining := parseStringFlag(remaining, "name")
+	if modName == "" || len(remaining) < 1 {
+		return fmt.Errorf("usage: mithril mod dbc l10n import <file> --mod <mod> [--name <migration>]")
+	}
+	inPath := remaining[0]
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+		return fmt.Errorf("mod not found: %s", modName)
+	}
+
+	format := dbc.L10nFormatFromPath(inPath)
+	if format == "" {
+		return fmt.Errorf("unsupported file type: %s (expected .po or .xliff)", inPath)
+	}
+	f, err := os.Open(inPath)
+	if err != nil {
+		return err
+	}
+	strs, lang, err := dbc.ReadLocStrings(f, format)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(inPath), err)
+	}
+	if err := checkLocStringIDs(strs); err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(inPath), err)
+	}
+	if name == "" {
+		name = "l10n_" + strings.ToLower(dbc.LocLangCode(lang, ""))
+	}
+
+	byTable := make(map[string][]dbc.LocString)
+	var tables []string
+	for _, s := range strs {
+		if _, ok := byTable[s.Table]; !ok {
+			tables = append(tables, s.Table)
+		}
+		byTable[s.Table] = append(byTable[s.Table], s)
+	}
+	sort.Strings(tables)
+
+	var forward, rollback strings.Builder
+	var missing, fromFiles []dbc.LocString
+	updated := 0
+	for _, table := range tables {
+		meta, err := metaForTable(table)
+		if err != nil {
+			return err
+		}
+		// Compare against what the mod builds today, so the rollback restores it
+		rows, err := loadModDBCRows(cfg, modName, meta)
+		if err != nil {
+			return err
+		}
+
+		// Records that only exist in the mod's dbc/ files aren't in MySQL
+		textKeys, err := modTextOnlyKeys(cfg, modName, meta)
+		if err != nil {
+			return err
+		}
+		var sqlStrs []dbc.LocString
+		for _, s := range byTable[table] {
+			if !textKeys[s.Key] {
+				sqlStrs = append(sqlStrs, s)
+				continue
+			}
+			fw, _, _, err := dbc.TranslationSQL([]dbc.LocString{s}, meta, rows, lang)
+			if err != nil {
+				return err
+			}
+			if fw != "" {
+				fromFiles = append(fromFiles, s)
+			}
+		}
+
+		fw, rb, miss, err := dbc.TranslationSQL(sqlStrs, meta, rows, lang)
+		if err != nil {
+			return err
+		}
+		missing = append(missing, miss...)
+		if fw == "" {
+			continue
+		}
+		n := strings.Count(fw, "\n")
+		updated += n
+		fmt.Fprintf(&forward, "-- %s: %d string(s)\n%s\n", table, n, fw)
+		fmt.Fprintf(&rollback, "-- %s\n%s\n", table, rb)
+	}
+
+	for _, s := range missing {
+		printWarning(fmt.Sprintf("%s: record or field not found — skipped", s.ID()))
+	}
+	for _, s := range fromFiles {
+		printWarning(fmt.Sprintf("%s: record comes from the mod's dbc/ files — add the %s_%s column there instead",
+			s.ID(), s.Field, strings.ToLower(dbc.LocLangs[lang])))
+	}
+	if updated == 0 {
+		fmt.Println("No new translations to import.")
+		return nil
+	}
+
+	sqlDir := filepath.Join(cfg.ModDir(modName), "sql", "dbc")
+	if err := os.MkdirAll(sqlDir, 0755); err != nil {
+		return fmt.Errorf("create sql directory: %w", err)
+	}
+	forwardFilename, rollbackFilename := nextMigrationFilenames(cfg, modName, "dbc", name)
+	forwardPath := filepath.Join(sqlDir, forwardFilename)
+	rollbackPath := filepath.Join(sqlDir, rollbackFilename)
+
+	forwardContent := fmt.Sprintf(`-- Migration: %s
+-- Database: dbc
+-- Mod: %s
+--
+-- %s translations imported from %s by 'mithril mod dbc l10n import'
+--
+
+%s`, name, modName, dbc.LocLangCode(lang, ""), filepath.Base(inPath), forward.String())
+
+	rollbackContent := fmt.Sprintf(`-- Rollback: %s
+-- Database: dbc
+-- Mod: %s
+--
+-- Undoes the changes made by %s
+--
+
+%s`, name, modName, forwardFilename, rollback.String())
+
+	if err := os.WriteFile(forwardPath, []byte(forwardContent), 0644); err != nil {
+		return fmt.Errorf("create migration file: %w", err)
+	}
+	if err := os.WriteFile(rollbackPath, []byte(rollbackContent), 0644); err != nil {
+		return fmt.Errorf("create rollback file: %w", err)
+	}
+
+	fmt.Printf("✓ Imported %d %s translation(s):\n", updated, dbc.LocLangCode(lang, ""))
+	fmt.Printf("  Forward:  %s\n", forwardPath)
+	fmt.Printf("  Rollback: %s\n", rollbackPath)
+	fmt.Println("  Run 'mithril mod build' to apply it.")
+	return nil
+}
+
+// modBuiltDBCs returns the .dbc files in modules/build/<mod>/DBFilesClient,
+// or just the one for table.
+func modBuiltDBCs(cfg *Config, modName, table string) ([]string, error) {
+	buildDbcDir := filepath.Join(cfg.ModulesBuildDir, modName, "DBFilesClient")
+	if table != "" {
+		meta, err := dbc.GetMetaForDBC(table)
+		if err != nil {
+			return nil, err
+		}
+		path := dbc.FindDBCFile(buildDbcDir, meta.File)
+		if path == "" {
+			return nil, fmt.Errorf("%s has not been built for mod '%s' — run 'mithril mod build' first", meta.File, modName)
+		}
+		return []string{path}, nil
+	}
+	paths, err := findRawDBCFiles(buildDbcDir)
+	if err != nil || len(paths) == 0 {
+		return nil, fmt.Errorf("no built DBCs for mod '%s' — run 'mithril mod build' first", modName)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func hasLocFields(meta *dbc.MetaFile) bool {
+	for _, field := range meta.Fields {
+		if field.Type == "Loc" {
+			return true
+		}
+	}
+	return false
+}
+
+// metaForTable finds the meta of a SQL table name, which may differ from the
+// DBC file name when a meta sets table_name.
+func metaForTable(table string) (*dbc.MetaFile, error) {
+	if metas, err := dbc.AllMetas(); err == nil {
+		for _, meta := range metas {
+			if dbc.TableName(meta) == table {
+				return meta, nil
+			}
+		}
+	}
+	return dbc.GetMetaForDBC(table)
+}
+
+// checkLocStringIDs rejects strings that share an ID: a translation must
+// name exactly one record and field.
+func checkLocStringIDs(strs []dbc.LocString) error {
+	seen := make(map[string]bool, len(strs))
+	for _, s := range strs {
+		if seen[s.ID()] {
+			return fmt.Errorf("string %s appears more than once", s.ID())
+		}
+		seen[s.ID()] = true
+	}
+	return nil
+}
+
+// modTextOnlyKeys returns the keys of records a mod's dbc/ files add to a
+// table, i.e. records that no migration can UPDATE.
+func modTextOnlyKeys(cfg *Config, modName string, meta *dbc.MetaFile) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	var inBase map[string]bool
+	keyCols, err := dbc.KeyColumns(meta)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range findModDBCTextFiles(cfg, modName) {
+		base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if !strings.EqualFold(base+".dbc", meta.File) {
+			continue
+		}
+		if inBase == nil {
+			baseRows, err := loadBaselineRows(cfg, meta)
+			if err != nil {
+				return nil, err
+			}
+			inBase = make(map[string]bool, len(baseRows))
+			for _, row := range baseRows {
+				inBase[dbc.RowKey(row, keyCols)] = true
+			}
+		}
+		rows, err := dbc.LoadTextRows(path, meta)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if key := dbc.RowKey(row, keyCols); !inBase[key] {
+				keys[key] = true
+			}
+		}
+	}
+	return keys, nil
+}
+
+// readLocStringsFile reads a PO or XLIFF file, picking the format from its extension.
+func readLocStringsFile(path string) ([]dbc.LocString, error) {
+	format := dbc.L10nFormatFromPath(path)
+	if format == "" {
+		return nil, fmt.Errorf("unsupported file type: %s", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	strs, _, err := dbc.ReadLocStrings(f, format)
+	return strs, err
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// Policies for fields that two mods change differently (dbc_conflict in
+// mithril.json, or mod build --conflict).
+const (
+	dbcConflictLast  = "last"  // the later mod in the build order wins (default)
+	dbcConflictFirst = "first" // the earlier mod wins
+	dbcConflictError = "error" // report the conflicts and fail the build
+)
+
+func validDBCConflictPolicy(policy string) bool {
+	switch policy {
+	case dbcConflictLast, dbcConflictFirst, dbcConflictError:
+		return true
+	}
+	return false
+}
+
+// modDBC is one mod's built copy of a DBC file.
+type modDBC struct {
+	mod  string
+	file builtFile
+}
+
+// mergeModDBCs three-way merges every DBC that more than one mod built, using
+// the baseline as the common ancestor, and points files at the merged copies
+// in modules/build/merged/DBFilesClient. sources lists each file's copies by
+// lowercase MPQ path, in build order.
+func mergeModDBCs(cfg *Config, files []builtFile, sources map[string][]modDBC) ([]builtFile, error) {
+	return mergeModDBCsInto(cfg, filepath.Join(cfg.ModulesBuildDir, "merged"), files, sources)
+}
+
+// mergeModDBCsInto is mergeModDBCs with the merged copies written under
+// mergedRoot, which is emptied first.
+func mergeModDBCsInto(cfg *Config, mergedRoot string, files []builtFile, sources map[string][]modDBC) ([]builtFile, error) {
+	os.RemoveAll(mergedRoot)
+	mergedDir := filepath.Join(mergedRoot, "DBFilesClient")
+
+	printedHeader := false
+	totalConflicts := 0
+	for i, bf := range files {
+		copies := sources[strings.ToLower(bf.mpqPath)]
+		if len(copies) < 2 || allFilesEqual(copies) {
+			continue
+		}
+		if !printedHeader {
+			fmt.Println("\nMerging DBCs built by more than one mod:")
+			printedHeader = true
+		}
+
+		meta, err := dbc.GetMetaForDBC(filepath.Base(bf.diskPath))
+		if err != nil {
+			return nil, err
+		}
+		base, err := loadBaselineRows(cfg, meta)
+		if err != nil {
+			return nil, err
+		}
+		var mergeSources []dbc.MergeSource
+		var modNames []string
+		for _, c := range copies {
+			rows, err := loadDBCRows(c.file.diskPath, meta)
+			if err != nil {
+				return nil, err
+			}
+			mergeSources = append(mergeSources, dbc.MergeSource{Name: c.mod, Rows: rows})
+			modNames = append(modNames, c.mod)
+		}
+
+		rows, conflicts, err := dbc.MergeRowsThreeWay(base, mergeSources, meta, cfg.DBCConflict != dbcConflictFirst)
+		if err != nil {
+			return nil, err
+		}
+		dbcFile, err := preserveBaseline(cfg, dbc.BuildDBC(rows, meta), meta)
+		if err != nil {
+			return nil, err
+		}
+		outPath := filepath.Join(mergedDir, meta.File)
+		if err := dbc.WriteDBC(dbcFile, meta, outPath); err != nil {
+			return nil, fmt.Errorf("write merged %s: %w", meta.File, err)
+		}
+		files[i].diskPath = outPath
+
+		if len(conflicts) == 0 {
+			fmt.Printf("  ✓ %s (%s)\n", meta.File, strings.Join(modNames, ", "))
+			continue
+		}
+		fmt.Printf("  ⚠ %s (%s): %d conflict(s)\n", meta.File, strings.Join(modNames, ", "), len(conflicts))
+		for _, c := range conflicts {
+			fmt.Printf("      %s\n", c)
+		}
+		totalConflicts += len(conflicts)
+	}
+
+	if totalConflicts > 0 && cfg.DBCConflict == dbcConflictError {
+		return nil, fmt.Errorf("%d DBC merge conflict(s) — resolve them, or set \"dbc_conflict\" to \"last\" or \"first\"", totalConflicts)
+	}
+	return files, nil
+}
+
+// allFilesEqual reports whether every copy has the same contents, in which
+// case there is nothing to merge.
+func allFilesEqual(copies []modDBC) bool {
+	for _, c := range copies[1:] {
+		if !filesEqual(copies[0].file.diskPath, c.file.diskPath) {
+			return false
+		}
+	}
+	return true
+}
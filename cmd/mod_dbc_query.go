@@ -0,0 +1,326 @@
+package cmd
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// queryFormats are the output formats of `mod dbc query` and `mod dbc shell`.
+var queryFormats = []string{"table", "tsv", "csv", "json", "markdown"}
+
+// maxTableCellWidth caps a column in table output; longer values are cut
+// with "…". The other formats always print values in full.
+const maxTableCellWidth = 80
+
+// queryResult is one result set of a statement. Statements without result
+// columns (UPDATE, INSERT, ...) only set affected.
+type queryResult struct {
+	cols     []string
+	numeric  []bool // printed right-aligned in tables and as numbers in JSON
+	rows     [][]sql.NullString
+	affected int64
+}
+
+// parseQueryFormat pulls --format from args. The default is table on a
+// terminal and tsv otherwise, so piped output keeps its tab-separated form.
+func parseQueryFormat(args []string) (string, []string, error) {
+	format, remaining := parseStringFlag(args, "format")
+	if format == "" {
+		if term.IsTerminal(int(os.Stdout.Fd())) {
+			return "table", remaining, nil
+		}
+		return "tsv", remaining, nil
+	}
+	if format == "md" {
+		format = "markdown"
+	}
+	for _, f := range queryFormats {
+		if f == format {
+			return format, remaining, nil
+		}
+	}
+	return "", nil, fmt.Errorf("unknown format %q (use %s)", format, strings.Join(queryFormats, ", "))
+}
+
+// returnsRows reports whether a statement produces a result set, so it is
+// run with Query rather than Exec.
+func returnsRows(stmt string) bool {
+	fields := strings.Fields(strings.TrimLeft(stmt, "( \t\r\n"))
+	if len(fields) == 0 {
+		return false
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "SELECT", "SHOW", "DESCRIBE", "DESC", "EXPLAIN", "WITH", "TABLE", "VALUES", "CALL":
+		return true
+	}
+	return false
+}
+
+// runDBCStatement runs one SQL statement (or several separated by ";")
+// against the dbc database. With decode, enum and flag columns of the tables
+// the statement names are returned by name.
+func runDBCStatement(db *sql.DB, stmt string, decode bool) ([]*queryResult, error) {
+	if !returnsRows(stmt) {
+		res, err := db.Exec(stmt)
+		if err != nil {
+			return nil, err
+		}
+		n, _ := res.RowsAffected()
+		return []*queryResult{{affected: n}}, nil
+	}
+
+	rows, err := db.Query(stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var symbols []*dbc.Symbols
+	if decode {
+		symbols = querySymbols(stmt)
+	}
+
+	var results []*queryResult
+	for {
+		res, err := scanQueryResult(rows, symbols)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	return results, rows.Err()
+}
+
+// scanQueryResult reads the current result set of rows as text.
+func scanQueryResult(rows *sql.Rows, symbols []*dbc.Symbols) (*queryResult, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("get columns: %w", err)
+	}
+	res := &queryResult{cols: cols, numeric: make([]bool, len(cols))}
+	if types, err := rows.ColumnTypes(); err == nil {
+		for i, t := range types {
+			name := strings.ToUpper(t.DatabaseTypeName())
+			res.numeric[i] = strings.Contains(name, "INT") || strings.Contains(name, "FLOAT") ||
+				strings.Contains(name, "DOUBLE") || strings.Contains(name, "DECIMAL")
+		}
+	}
+
+	// Column index → symbols of the first queried table that names it
+	decoders := make(map[int]*dbc.Symbols)
+	for i, col := range cols {
+		for _, s := range symbols {
+			if s.Has(col) {
+				decoders[i] = s
+				res.numeric[i] = false
+				break
+			}
+		}
+	}
+
+	vals := make([]interface{}, len(cols))
+	ptrs := make([]interface{}, len(cols))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		row := make([]sql.NullString, len(cols))
+		for i, v := range vals {
+			if v == nil {
+				continue
+			}
+			row[i].Valid = true
+			if s, ok := decoders[i]; ok {
+				if name, ok := s.Format(cols[i], v); ok {
+					row[i].String = name
+					continue
+				}
+			}
+			switch val := v.(type) {
+			case []byte:
+				row[i].String = string(val)
+			default:
+				row[i].String = fmt.Sprintf("%v", val)
+			}
+		}
+		res.rows = append(res.rows, row)
+	}
+	return res, rows.Err()
+}
+
+// writeQueryResult prints a result set in one of the queryFormats.
+func writeQueryResult(w io.Writer, res *queryResult, format string) error {
+	if res.cols == nil {
+		fmt.Fprintf(w, "%d row(s) affected\n", res.affected)
+		return nil
+	}
+	switch format {
+	case "tsv":
+		fmt.Fprintln(w, strings.Join(res.cols, "\t"))
+		for _, row := range res.rows {
+			parts := make([]string, len(row))
+			for i, v := range row {
+				parts[i] = nullText(v, "NULL")
+			}
+			fmt.Fprintln(w, strings.Join(parts, "\t"))
+		}
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write(res.cols)
+		for _, row := range res.rows {
+			parts := make([]string, len(row))
+			for i, v := range row {
+				parts[i] = nullText(v, "")
+			}
+			cw.Write(parts)
+		}
+		cw.Flush()
+		return cw.Error()
+	case "json":
+		return writeQueryJSON(w, res)
+	case "markdown":
+		writeQueryMarkdown(w, res)
+	default:
+		writeQueryTable(w, res)
+	}
+	return nil
+}
+
+// writeQueryTable prints aligned columns under a header, numbers
+// right-aligned, followed by the row count.
+func writeQueryTable(w io.Writer, res *queryResult) {
+	cells := make([][]string, len(res.rows))
+	widths := make([]int, len(res.cols))
+	for i, col := range res.cols {
+		widths[i] = utf8.RuneCountInString(col)
+	}
+	for r, row := range res.rows {
+		cells[r] = make([]string, len(row))
+		for i, v := range row {
+			text := strings.NewReplacer("\r", "", "\n", `\n`, "\t", " ").Replace(nullText(v, "NULL"))
+			if utf8.RuneCountInString(text) > maxTableCellWidth {
+				text = string([]rune(text)[:maxTableCellWidth-1]) + "…"
+			}
+			cells[r][i] = text
+			if n := utf8.RuneCountInString(text); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	line := func(values []string, rightAlign func(int) bool) {
+		parts := make([]string, len(values))
+		for i, v := range values {
+			pad := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(v))
+			if rightAlign(i) {
+				parts[i] = pad + v
+			} else {
+				parts[i] = v + pad
+			}
+		}
+		fmt.Fprintln(w, strings.TrimRight(strings.Join(parts, "  "), " "))
+	}
+	line(res.cols, func(int) bool { return false })
+	dashes := make([]string, len(widths))
+	for i, n := range widths {
+		dashes[i] = strings.Repeat("-", n)
+	}
+	line(dashes, func(int) bool { return false })
+	for _, row := range cells {
+		line(row, func(i int) bool { return res.numeric[i] })
+	}
+	if len(res.rows) == 1 {
+		fmt.Fprintln(w, "(1 row)")
+	} else {
+		fmt.Fprintf(w, "(%d rows)\n", len(res.rows))
+	}
+}
+
+// writeQueryMarkdown prints a GitHub-flavored Markdown table.
+func writeQueryMarkdown(w io.Writer, res *queryResult) {
+	escape := strings.NewReplacer("|", `\|`, "\r", "", "\n", "<br>")
+	header := make([]string, len(res.cols))
+	align := make([]string, len(res.cols))
+	for i, col := range res.cols {
+		header[i] = escape.Replace(col)
+		align[i] = "---"
+		if res.numeric[i] {
+			align[i] = "---:"
+		}
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(header, " | "))
+	fmt.Fprintf(w, "| %s |\n", strings.Join(align, " | "))
+	for _, row := range res.rows {
+		parts := make([]string, len(row))
+		for i, v := range row {
+			parts[i] = escape.Replace(nullText(v, "NULL"))
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(parts, " | "))
+	}
+}
+
+// writeQueryJSON prints an array with one object per row, keys in column
+// order. Numeric columns are JSON numbers and NULL is null.
+func writeQueryJSON(w io.Writer, res *queryResult) error {
+	if len(res.rows) == 0 {
+		fmt.Fprintln(w, "[]")
+		return nil
+	}
+	fmt.Fprintln(w, "[")
+	for r, row := range res.rows {
+		var b strings.Builder
+		b.WriteString("  {")
+		for i, v := range row {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			key, _ := json.Marshal(res.cols[i])
+			b.Write(key)
+			b.WriteString(": ")
+			var value []byte
+			var err error
+			switch {
+			case !v.Valid:
+				value = []byte("null")
+			case res.numeric[i] && json.Valid([]byte(v.String)):
+				value = []byte(v.String)
+			default:
+				value, err = json.Marshal(v.String)
+			}
+			if err != nil {
+				return err
+			}
+			b.Write(value)
+		}
+		b.WriteString("}")
+		if r < len(res.rows)-1 {
+			b.WriteString(",")
+		}
+		fmt.Fprintln(w, b.String())
+	}
+	fmt.Fprintln(w, "]")
+	return nil
+}
+
+func nullText(v sql.NullString, null string) string {
+	if !v.Valid {
+		return null
+	}
+	return v.String
+}
@@ -0,0 +1,366 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// IDReservation is a range of primary key IDs in one DBC table that belongs to
+// a mod. First and Last are inclusive.
+type IDReservation struct {
+	Mod   string `json:"mod"`
+	Table string `json:"table"` // SQL table name, e.g. "spell"
+	First int64  `json:"first"`
+	Last  int64  `json:"last"`
+}
+
+func (r IDReservation) String() string {
+	return fmt.Sprintf("%d-%d", r.First, r.Last)
+}
+
+func (r IDReservation) contains(id int64) bool {
+	return id >= r.First && id <= r.Last
+}
+
+// runModDBCReserve allocates a range of new IDs in a DBC table for a mod, above
+// the baseline's highest ID and every range reserved so far.
+func runModDBCReserve(args []string) error {
+	modName, remaining := parseModFlag(args)
+	cfg := DefaultConfig()
+
+	manifest, err := loadManifest(cfg.ModulesDir)
+	if err != nil {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+
+	if len(remaining) == 0 {
+		printReservations(manifest.Reservations, modName)
+		return nil
+	}
+	if modName == "" || len(remaining) < 2 {
+		return fmt.Errorf("usage: mithril mod dbc reserve <table> <count> --mod <mod>")
+	}
+	if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+		return fmt.Errorf("mod not found: %s", modName)
+	}
+	count, err := strconv.ParseInt(remaining[1], 10, 64)
+	if err != nil || count < 1 {
+		return fmt.Errorf("invalid count: %s", remaining[1])
+	}
+
+	meta, err := dbc.GetMetaForDBC(remaining[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := idColumn(meta); !ok {
+		return fmt.Errorf("%s has no single integer primary key to reserve IDs in", meta.File)
+	}
+	table := dbc.TableName(meta)
+
+	baseRows, err := loadBaselineRows(cfg, meta)
+	if err != nil {
+		return err
+	}
+	highest := maxID(baseRows, meta)
+	for _, r := range manifest.Reservations {
+		if r.Table == table && r.Last > highest {
+			highest = r.Last
+		}
+	}
+	first, last := highest+1, highest+count
+	if last > 1<<31-1 {
+		return fmt.Errorf("not enough IDs left in %s above %d", meta.File, highest)
+	}
+
+	// Grow the mod's last range when nothing was reserved after it
+	extended := false
+	for i := range manifest.Reservations {
+		r := &manifest.Reservations[i]
+		if r.Mod == modName && r.Table == table && r.Last == highest {
+			r.Last = last
+			extended = true
+			break
+		}
+	}
+	if !extended {
+		manifest.Reservations = append(manifest.Reservations, IDReservation{Mod: modName, Table: table, First: first, Last: last})
+	}
+	if err := saveManifest(cfg.ModulesDir, manifest); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Reserved %s IDs %d-%d for %s\n", meta.File, first, last, modName)
+	fmt.Println("  Migrations of this mod can use:")
+	for _, line := range reservationVariables(manifest.Reservations, modName) {
+		if strings.HasPrefix(line, "@"+table+"_") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+	return nil
+}
+
+// printReservations lists reservations, optionally only those of one mod.
+func printReservations(reservations []IDReservation, modName string) {
+	var shown []IDReservation
+	for _, r := range reservations {
+		if modName == "" || r.Mod == modName {
+			shown = append(shown, r)
+		}
+	}
+	if len(shown) == 0 {
+		fmt.Println("No DBC ID reservations. Reserve a range with:")
+		fmt.Println("  mithril mod dbc reserve <table> <count> --mod <mod>")
+		return
+	}
+	sort.SliceStable(shown, func(i, j int) bool {
+		if shown[i].Table != shown[j].Table {
+			return shown[i].Table < shown[j].Table
+		}
+		return shown[i].First < shown[j].First
+	})
+	fmt.Printf("%-24s %-20s %s\n", "TABLE", "IDS", "MOD")
+	for _, r := range shown {
+		fmt.Printf("%-24s %-20s %s\n", r.Table, r, r.Mod)
+	}
+}
+
+// reservationVariables returns "@<table>_first = N" / "@<table>_last = N"
+// assignments for a mod's reservations. A second range in the same table gets
+// _first_2 / _last_2, and so on.
+func reservationVariables(reservations []IDReservation, modName string) []string {
+	var vars []string
+	seen := make(map[string]int)
+	for _, r := range reservations {
+		if r.Mod != modName {
+			continue
+		}
+		seen[r.Table]++
+		suffix := ""
+		if n := seen[r.Table]; n > 1 {
+			suffix = fmt.Sprintf("_%d", n)
+		}
+		vars = append(vars,
+			fmt.Sprintf("@%s_first%s = %d", r.Table, suffix, r.First),
+			fmt.Sprintf("@%s_last%s = %d", r.Table, suffix, r.Last))
+	}
+	return vars
+}
+
+// reservationPreamble returns a SET statement defining a mod's reserved ID
+// ranges as user variables, or "" if it has none.
+func reservationPreamble(cfg *Config, mod string) string {
+	manifest, err := loadManifest(cfg.ModulesDir)
+	if err != nil {
+		return ""
+	}
+	vars := reservationVariables(manifest.Reservations, mod)
+	if len(vars) == 0 {
+		return ""
+	}
+	return "SET " + strings.Join(vars, ", ") + ";\n"
+}
+
+// releaseModReservations drops a removed mod's reservations from the manifest.
+func releaseModReservations(cfg *Config, modName string) error {
+	manifest, err := loadManifest(cfg.ModulesDir)
+	if err != nil {
+		return nil
+	}
+	var kept []IDReservation
+	for _, r := range manifest.Reservations {
+		if r.Mod != modName {
+			kept = append(kept, r)
+		}
+	}
+	if len(kept) == len(manifest.Reservations) {
+		return nil
+	}
+	manifest.Reservations = kept
+	return saveManifest(cfg.ModulesDir, manifest)
+}
+
+// checkDBCReservations checks the records each mod adds to the baseline. A
+// mod may only add IDs inside its own reservations in tables that have any,
+// and no two mods may add different records with the same key. sources lists
+// each file's per-mod copies in build order (see mergeModDBCs).
+//
+// Records a mod's copy shares unchanged with an earlier mod's copy belong to
+// the earlier mod: SQL-built DBCs are exported from a shared database, so they
+// include the changes of every mod built before them.
+func checkDBCReservations(cfg *Config, sources map[string][]modDBC) error {
+	var reservations []IDReservation
+	if manifest, err := loadManifest(cfg.ModulesDir); err == nil {
+		reservations = manifest.Reservations
+	}
+	reservedTables := make(map[string]bool)
+	for _, r := range reservations {
+		reservedTables[r.Table] = true
+	}
+
+	keys := make([]string, 0, len(sources))
+	for key := range sources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var problems []string
+	for _, key := range keys {
+		copies := sources[key]
+		meta, err := dbc.GetMetaForDBC(filepath.Base(copies[0].file.diskPath))
+		if err != nil {
+			return err
+		}
+		table := dbc.TableName(meta)
+		if len(copies) < 2 && !reservedTables[table] {
+			continue
+		}
+		base, err := loadBaselineRows(cfg, meta)
+		if err != nil {
+			return err
+		}
+
+		cols := dbc.Columns(meta)
+		keyCols, err := dbc.KeyColumns(meta)
+		if err != nil {
+			return err
+		}
+		names := &dbc.TableDiff{Columns: cols, KeyCols: keyCols}
+		idCol, hasID := idColumn(meta)
+		inBase := make(map[string]bool, len(base))
+		for _, row := range base {
+			inBase[dbc.RowKey(row, keyCols)] = true
+		}
+
+		type addedRow struct {
+			mod string
+			row dbc.Row
+		}
+		added := make(map[string]addedRow)
+		for _, c := range copies {
+			rows, err := loadDBCRows(c.file.diskPath, meta)
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				rowKey := dbc.RowKey(row, keyCols)
+				if inBase[rowKey] {
+					continue
+				}
+				if prev, ok := added[rowKey]; ok {
+					if prev.mod != c.mod && !rowsEqual(cols, prev.row, row) {
+						problems = append(problems, fmt.Sprintf("%s %s: added by both %s and %s", meta.File, names.KeyString(row), prev.mod, c.mod))
+					}
+					continue
+				}
+				added[rowKey] = addedRow{mod: c.mod, row: row}
+
+				if !reservedTables[table] || !hasID {
+					continue
+				}
+				id, _ := rowID(row, idCol)
+				if msg := checkReservedID(reservations, table, c.mod, id); msg != "" {
+					problems = append(problems, fmt.Sprintf("%s %s (%s): %s", meta.File, names.KeyString(row), c.mod, msg))
+				}
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	fmt.Printf("\n✗ %d DBC ID problem(s):\n", len(problems))
+	for _, p := range problems {
+		fmt.Printf("  %s\n", p)
+	}
+	return fmt.Errorf("DBC ID check failed — give each mod its own IDs (see 'mithril mod dbc reserve')")
+}
+
+// checkReservedID explains why a new ID is not allowed for mod, or returns "".
+func checkReservedID(reservations []IDReservation, table, mod string, id int64) string {
+	var own []string
+	for _, r := range reservations {
+		if r.Table != table {
+			continue
+		}
+		if r.contains(id) {
+			if r.Mod == mod {
+				return ""
+			}
+			return fmt.Sprintf("inside %s's reservation %s", r.Mod, r)
+		}
+		if r.Mod == mod {
+			own = append(own, r.String())
+		}
+	}
+	if len(own) == 0 {
+		return "no IDs reserved for this mod in this table"
+	}
+	return "outside the mod's reservation " + strings.Join(own, ", ")
+}
+
+// idColumn returns the column index of a table's primary key when it is a
+// single integer column. Tables keyed on MySQL's synthetic auto_id have no
+// ID column, even when a unique key tells their records apart.
+func idColumn(meta *dbc.MetaFile) (int, bool) {
+	if len(meta.PrimaryKeys) != 1 {
+		return 0, false
+	}
+	for i, col := range dbc.Columns(meta) {
+		if !strings.EqualFold(col.Name, meta.PrimaryKeys[0]) {
+			continue
+		}
+		switch col.Type {
+		case "int32", "uint32", "uint8":
+			return i, true
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+// maxID returns the highest primary key ID in rows, or 0 for an empty table.
+func maxID(rows []dbc.Row, meta *dbc.MetaFile) int64 {
+	col, ok := idColumn(meta)
+	if !ok {
+		return 0
+	}
+	var highest int64
+	for _, row := range rows {
+		if id, ok := rowID(row, col); ok && id > highest {
+			highest = id
+		}
+	}
+	return highest
+}
+
+func rowID(row dbc.Row, col int) (int64, bool) {
+	return intValue(row[col])
+}
+
+// intValue converts a decoded integer column value to int64.
+func intValue(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int32:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint8:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+func rowsEqual(cols []dbc.Column, a, b dbc.Row) bool {
+	for i, col := range cols {
+		if !dbc.ValuesEqual(col, a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// dbcBaselineSchema holds a pristine import of the baseline DBCs. Scratch
+// schemas are cloned from it, so isolated builds never reset or touch the
+// shared dbc database.
+const dbcBaselineSchema = "dbc_baseline"
+
+// dbcScratchSchema returns the scratch schema used to build one mod's DBCs.
+func dbcScratchSchema(mod string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(mod) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	name := "dbc_scratch_" + sb.String()
+	if len(name) > 64 { // MySQL's limit for schema names
+		name = name[:64]
+	}
+	return name
+}
+
+// rootDBConfig returns root credentials for schema management.
+func rootDBConfig(cfg *Config) dbc.DBConfig {
+	return dbc.DBConfig{
+		User:     "root",
+		Password: cfg.MySQLRootPassword,
+		Host:     cfg.MySQLHost(),
+		Port:     cfg.MySQLPort(),
+	}
+}
+
+// openDBCSchema creates a schema if needed and connects to it as the dbc user.
+func openDBCSchema(cfg *Config, name string) (*sql.DB, error) {
+	if err := dbc.EnsureSchema(rootDBConfig(cfg), name, cfg.MySQLUser); err != nil {
+		return nil, fmt.Errorf("ensure schema %s: %w", name, err)
+	}
+	return dbc.OpenDB(dbc.DBConfig{
+		User:     cfg.MySQLUser,
+		Password: cfg.MySQLPassword,
+		Host:     cfg.MySQLHost(),
+		Port:     cfg.MySQLPort(),
+		Name:     name,
+	})
+}
+
+// ensureDBCBaselineSchema imports any baseline tables missing from dbc_baseline.
+// After the first run this is only a quick existence check per table.
+func ensureDBCBaselineSchema(cfg *Config) error {
+	db, err := openDBCSchema(cfg, dbcBaselineSchema)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	imported, _, err := dbc.ImportAllDBCs(db, cfg.BaselineDbcDir, false, 0)
+	if err != nil {
+		return fmt.Errorf("import baseline schema: %w", err)
+	}
+	if imported > 0 {
+		fmt.Printf("    ✓ %s: imported %d table(s)\n", dbcBaselineSchema, imported)
+	}
+	return nil
+}
+
+// dropDBCBaselineSchema discards dbc_baseline so the next isolated build
+// re-imports it from the current baseline files and metas.
+func dropDBCBaselineSchema(cfg *Config) error {
+	return dbc.DropSchema(rootDBConfig(cfg), dbcBaselineSchema)
+}
+
+// buildModDBCsIsolated builds one mod's DBCs into outDir without touching the
+// shared dbc database. The mod's sql/dbc/ migrations are applied to a scratch
+// schema cloned from dbc_baseline along with its dbc/*.yaml edits, the tables
+// they change are exported, and the mod's dbc/ CSV/JSON files are merged on
+// top. The scratch schema is dropped afterwards, even if the build fails.
+func buildModDBCsIsolated(cfg *Config, mod, outDir string) ([]builtFile, error) {
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return nil, fmt.Errorf("create build dir: %w", err)
+	}
+
+	var files []builtFile
+	if migrations := findDBCMigrations(cfg, mod); len(migrations) > 0 || len(findModDBCEditFiles(cfg, mod)) > 0 {
+		sqlFiles, err := buildModDBCsInScratch(cfg, mod, migrations, outDir)
+		if err != nil {
+			return nil, err
+		}
+		files = sqlFiles
+	}
+
+	textFiles, err := buildModDBCsFromFiles(cfg, mod, outDir, files)
+	if err != nil {
+		return nil, err
+	}
+	return append(files, textFiles...), nil
+}
+
+// buildModDBCsInScratch applies migrations and YAML edits to a fresh scratch
+// schema and exports the tables that differ from the baseline.
+func buildModDBCsInScratch(cfg *Config, mod string, migrations []migrationInfo, outDir string) ([]builtFile, error) {
+	db, drop, err := openDBCScratch(cfg, mod)
+	if err != nil {
+		return nil, err
+	}
+	defer drop()
+
+	for _, m := range migrations {
+		sqlContent, err := readMigrationSQL(cfg, m.mod, m.database, m.path)
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", m.filename, err)
+		}
+		if _, err := db.Exec(sqlContent); err != nil {
+			return nil, fmt.Errorf("apply migration %s: %w", m.filename, err)
+		}
+		fmt.Printf("    ✓ %s\n", m.filename)
+	}
+	if err := applyModDBCEditsTo(cfg, mod, db); err != nil {
+		return nil, err
+	}
+
+	metas, err := dbc.AllMetas()
+	if err != nil {
+		return nil, fmt.Errorf("get meta files: %w", err)
+	}
+	exported, err := dbc.ExportModifiedDBCs(db, metas, cfg.FidelityBaselineDir(), outDir)
+	if err != nil {
+		return nil, fmt.Errorf("export modified DBCs: %w", err)
+	}
+
+	var files []builtFile
+	for _, tableName := range exported {
+		for _, meta := range metas {
+			if dbc.TableName(meta) == tableName {
+				files = append(files, builtFile{
+					diskPath: filepath.Join(outDir, meta.File),
+					mpqPath:  "DBFilesClient\\" + meta.File,
+				})
+				break
+			}
+		}
+	}
+	return files, nil
+}
+
+// openDBCScratch connects to a mod's scratch schema, freshly cloned from
+// dbc_baseline. The returned drop closes the connection and drops the schema.
+func openDBCScratch(cfg *Config, mod string) (*sql.DB, func(), error) {
+	if err := ensureDBCBaselineSchema(cfg); err != nil {
+		return nil, nil, err
+	}
+
+	scratch := dbcScratchSchema(mod)
+	rootCfg := rootDBConfig(cfg)
+	if err := dbc.DropSchema(rootCfg, scratch); err != nil {
+		return nil, nil, err
+	}
+	db, err := openDBCSchema(cfg, scratch)
+	if err != nil {
+		return nil, nil, err
+	}
+	drop := func() {
+		db.Close()
+		if err := dbc.DropSchema(rootCfg, scratch); err != nil {
+			fmt.Printf("    ⚠ Failed to drop %s: %v\n", scratch, err)
+		}
+	}
+
+	tables, err := dbc.CloneSchema(db, dbcBaselineSchema)
+	if err != nil {
+		drop()
+		return nil, nil, err
+	}
+	fmt.Printf("    ✓ %s cloned from %s (%d tables)\n", scratch, dbcBaselineSchema, tables)
+	return db, drop, nil
+}
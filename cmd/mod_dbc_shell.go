@@ -0,0 +1,428 @@
+package cmd
+
+import (
+	"bufio"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+const dbcShellHelp = `Statements end with ";" and may span several lines.
+
+  \d              List tables
+  \d <table>      Describe a table from its DBC schema (keys, references, enums, flags)
+  \f <format>     Set the output format: table, tsv, csv, json, markdown
+  \decode         Toggle printing enum and flag columns by name
+  \?              Show this help
+  \q              Quit (or Ctrl+D)
+
+Tab completes SQL keywords and table and column names. Up/Down recall
+earlier statements.
+`
+
+// dbcShellKeywords are completed alongside table and column names.
+var dbcShellKeywords = []string{
+	"SELECT", "FROM", "WHERE", "AND", "OR", "NOT", "IN", "LIKE", "BETWEEN", "IS", "NULL",
+	"ORDER", "GROUP", "BY", "HAVING", "LIMIT", "OFFSET", "ASC", "DESC", "DISTINCT", "AS",
+	"JOIN", "LEFT", "INNER", "ON", "UPDATE", "SET", "INSERT", "INTO", "VALUES", "DELETE",
+	"COUNT", "SUM", "MIN", "MAX", "SHOW", "TABLES", "DESCRIBE",
+}
+
+// dbcShell is an interactive SQL session on the dbc database.
+type dbcShell struct {
+	db     *sql.DB
+	out    io.Writer
+	format string
+	decode bool
+	metas  map[string]*dbc.MetaFile // by table name
+	tables []string                 // sorted
+}
+
+// runModDBCShell starts an interactive SQL prompt on the dbc database. When
+// stdin isn't a terminal, statements are read from it without a prompt, so
+// `mithril mod dbc shell < script.sql` works too.
+func runModDBCShell(args []string) error {
+	format, args, err := parseQueryFormat(args)
+	if err != nil {
+		return err
+	}
+	decode := false
+	for _, a := range args {
+		if a == "--decode" {
+			decode = true
+		}
+	}
+
+	cfg := DefaultConfig()
+	db, err := openDBCDB(cfg)
+	if err != nil {
+		return fmt.Errorf("connect to dbc database: %w", err)
+	}
+	defer db.Close()
+
+	sh := &dbcShell{db: db, out: os.Stdout, format: format, decode: decode, metas: make(map[string]*dbc.MetaFile)}
+	metas, err := dbc.AllMetas()
+	if err != nil {
+		return fmt.Errorf("get meta files: %w", err)
+	}
+	for _, meta := range metas {
+		name := dbc.TableName(meta)
+		sh.metas[name] = meta
+		sh.tables = append(sh.tables, name)
+	}
+	sort.Strings(sh.tables)
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return sh.run(nil)
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("set up terminal: %w", err)
+	}
+	defer term.Restore(fd, state)
+
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, "dbc> ")
+	if width, height, err := term.GetSize(fd); err == nil {
+		t.SetSize(width, height)
+	}
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		return sh.complete(line, pos)
+	}
+	sh.out = t
+	fmt.Fprintf(t, "Connected to the dbc database. Type \\? for help, \\q to quit.\n")
+	return sh.run(t)
+}
+
+// run reads statements until EOF or \q, from the terminal when t is set and
+// from stdin otherwise.
+func (sh *dbcShell) run(t *term.Terminal) error {
+	var scanner *bufio.Scanner
+	if t == nil {
+		scanner = bufio.NewScanner(os.Stdin)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	}
+	var stmt strings.Builder
+	for {
+		var line string
+		if t != nil {
+			if stmt.Len() == 0 {
+				t.SetPrompt("dbc> ")
+			} else {
+				t.SetPrompt("  -> ")
+			}
+			l, err := t.ReadLine()
+			if err == io.EOF {
+				fmt.Fprintln(sh.out)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			line = l
+		} else {
+			if !scanner.Scan() {
+				if stmt.Len() > 0 {
+					sh.execute(stmt.String())
+				}
+				return scanner.Err()
+			}
+			line = scanner.Text()
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if stmt.Len() == 0 && strings.HasPrefix(trimmed, `\`) {
+			if sh.command(trimmed) {
+				return nil
+			}
+			continue
+		}
+		if stmt.Len() == 0 && trimmed == "" {
+			continue
+		}
+		stmt.WriteString(line)
+		stmt.WriteString("\n")
+		if statementComplete(stmt.String()) {
+			sh.execute(stmt.String())
+			stmt.Reset()
+		}
+	}
+}
+
+// command runs a backslash command. It returns true for \q.
+func (sh *dbcShell) command(line string) bool {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case `\q`, `\quit`:
+		return true
+	case `\?`, `\h`, `\help`:
+		fmt.Fprint(sh.out, dbcShellHelp)
+	case `\d`:
+		if len(fields) == 1 {
+			sh.execute("SHOW TABLES")
+		} else {
+			sh.describe(strings.Trim(fields[1], "`;"))
+		}
+	case `\f`:
+		if len(fields) < 2 {
+			fmt.Fprintf(sh.out, "Output format: %s (one of %s)\n", sh.format, strings.Join(queryFormats, ", "))
+			break
+		}
+		format, _, err := parseQueryFormat([]string{"--format", fields[1]})
+		if err != nil {
+			fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+			break
+		}
+		sh.format = format
+		fmt.Fprintf(sh.out, "Output format: %s\n", format)
+	case `\decode`:
+		sh.decode = !sh.decode
+		if sh.decode {
+			fmt.Fprintln(sh.out, "Enum and flag columns are printed by name")
+		} else {
+			fmt.Fprintln(sh.out, "Enum and flag columns are printed as numbers")
+		}
+	default:
+		fmt.Fprintf(sh.out, "Unknown command %s — type \\? for help\n", fields[0])
+	}
+	return false
+}
+
+// execute runs a statement and prints its results; errors are printed, not
+// returned, so the session goes on.
+func (sh *dbcShell) execute(stmt string) {
+	stmt = strings.TrimRight(strings.TrimSpace(stmt), "; \t\r\n")
+	if stmt == "" {
+		return
+	}
+	results, err := runDBCStatement(sh.db, stmt, sh.decode)
+	if err != nil {
+		fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+		return
+	}
+	for i, res := range results {
+		if i > 0 {
+			fmt.Fprintln(sh.out)
+		}
+		if err := writeQueryResult(sh.out, res, sh.format); err != nil {
+			fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+		}
+	}
+}
+
+// describe prints a table's columns from its DBC schema, with the primary
+// key, references and enum/flag names it declares. Tables without a schema
+// (Mithril's own bookkeeping tables) fall back to DESCRIBE.
+func (sh *dbcShell) describe(table string) {
+	meta, ok := sh.metas[strings.ToLower(table)]
+	if !ok {
+		sh.execute("DESCRIBE `" + table + "`")
+		return
+	}
+	symbols, err := dbc.NewSymbols(meta)
+	if err != nil {
+		fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+		return
+	}
+
+	keyCols, _ := dbc.KeyColumns(meta)
+	res := &queryResult{
+		cols:    []string{"column", "type", "key", "notes"},
+		numeric: make([]bool, 4),
+	}
+	for i, col := range dbc.Columns(meta) {
+		typ := col.Type
+		if col.Loc >= 0 {
+			typ = "Loc " + col.Type
+		}
+		key := ""
+		if isKeyColumn(keyCols, i) {
+			key = "PRI"
+		}
+		var notes []string
+		if ref := dbc.ReferenceFor(meta, col.Name); ref != nil {
+			target := ref.Table
+			if ref.Column != "" {
+				target += "." + ref.Column
+			}
+			notes = append(notes, "→ "+target)
+		}
+		if symbols.IsFlags(col.Name) {
+			notes = append(notes, "flags")
+		} else if symbols.Has(col.Name) {
+			notes = append(notes, "enum")
+		}
+		res.rows = append(res.rows, []sql.NullString{
+			{String: col.Name, Valid: true},
+			{String: typ, Valid: true},
+			{String: key, Valid: true},
+			{String: strings.Join(notes, ", "), Valid: true},
+		})
+	}
+	fmt.Fprintf(sh.out, "%s (%s)\n", dbc.TableName(meta), meta.File)
+	if err := writeQueryResult(sh.out, res, sh.format); err != nil {
+		fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+	}
+}
+
+// complete handles Tab: it completes the word before the cursor from the
+// SQL keywords, table names, and the columns of the tables the line names
+// (all columns if it names none). After FROM, JOIN, \d etc. only table
+// names are offered. Several matches are extended to their
+// common prefix, or listed when that doesn't add anything.
+func (sh *dbcShell) complete(line string, pos int) (string, int, bool) {
+	start := pos
+	for start > 0 && isCompletionChar(line[start-1]) {
+		start--
+	}
+	word := line[start:pos]
+	if word == "" {
+		return "", 0, false
+	}
+
+	// Only tables make sense after \d, FROM, JOIN and the like
+	before := strings.Fields(strings.ToLower(line[:start]))
+	tableOnly := false
+	if len(before) > 0 {
+		switch before[len(before)-1] {
+		case `\d`, "from", "join", "update", "into", "describe", "desc":
+			tableOnly = true
+		}
+	}
+	var candidates []string
+	if tableOnly {
+		candidates = sh.tables
+	} else {
+		candidates = append(candidates, sh.tables...)
+		candidates = append(candidates, sh.completionColumns(line)...)
+		lowerWord := strings.ToLower(word) == word
+		for _, kw := range dbcShellKeywords {
+			if lowerWord {
+				kw = strings.ToLower(kw)
+			}
+			candidates = append(candidates, kw)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.ToLower(c), strings.ToLower(word)) && !seen[c] {
+			seen[c] = true
+			matches = append(matches, c)
+		}
+	}
+	if len(matches) == 0 {
+		return "", 0, false
+	}
+	sort.Strings(matches)
+
+	completion := matches[0]
+	if len(matches) > 1 {
+		completion = commonPrefix(matches)
+		if len(completion) <= len(word) {
+			shown := matches
+			if len(shown) > 50 {
+				shown = shown[:50]
+			}
+			list := strings.Join(shown, "  ")
+			if len(matches) > len(shown) {
+				list += fmt.Sprintf("  ... (%d more)", len(matches)-len(shown))
+			}
+			fmt.Fprintln(sh.out, list)
+			return "", 0, false
+		}
+	} else {
+		completion += " "
+	}
+	newLine := line[:start] + completion + line[pos:]
+	return newLine, start + len(completion), true
+}
+
+// completionColumns returns the columns of the tables a line names, or of
+// every table when it names none.
+func (sh *dbcShell) completionColumns(line string) []string {
+	var metas []*dbc.MetaFile
+	for _, m := range queryTableRe.FindAllStringSubmatch(line, -1) {
+		if meta, ok := sh.metas[strings.ToLower(m[1])]; ok {
+			metas = append(metas, meta)
+		}
+	}
+	if len(metas) == 0 {
+		for _, meta := range sh.metas {
+			metas = append(metas, meta)
+		}
+	}
+	var cols []string
+	for _, meta := range metas {
+		cols = append(cols, dbc.ColumnNames(meta)...)
+	}
+	return cols
+}
+
+func isCompletionChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// commonPrefix returns the longest case-insensitive common prefix of words,
+// in the case of the first.
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		n := 0
+		for n < len(prefix) && n < len(w) && strings.EqualFold(prefix[n:n+1], w[n:n+1]) {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	return prefix
+}
+
+// statementComplete reports whether text ends with a ";" that is outside
+// string literals, quoted identifiers and comments.
+func statementComplete(text string) bool {
+	var quote byte
+	last := byte(0)
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			last = c
+		case c == '#' || (c == '-' && strings.HasPrefix(text[i:], "-- ")):
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+		case c == '/' && strings.HasPrefix(text[i:], "/*"):
+			end := strings.Index(text[i+2:], "*/")
+			if end < 0 {
+				return false
+			}
+			i += end + 3
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		default:
+			last = c
+		}
+	}
+	return quote == 0 && last == ';'
+}
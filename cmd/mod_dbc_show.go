@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCShow prints one record of a DBC table, one column per line, with
+// enum and flag values rendered by name. Reads the baseline, or a mod's built
+// DBC with --mod. No MySQL needed.
+func runModDBCShow(args []string) error {
+	modName, remaining := parseModFlag(args)
+	all := false
+	var positional []string
+	for _, a := range remaining {
+		if a == "--all" {
+			all = true
+		} else {
+			positional = append(positional, a)
+		}
+	}
+	if len(positional) < 2 {
+		return fmt.Errorf("usage: mithril mod dbc show <table> <id> [--mod <mod>] [--all]")
+	}
+
+	cfg := DefaultConfig()
+	meta, err := dbc.GetMetaForDBC(positional[0])
+	if err != nil {
+		return err
+	}
+	symbols, err := dbc.NewSymbols(meta)
+	if err != nil {
+		return err
+	}
+
+	var rows []dbc.Row
+	source := "baseline"
+	if modName != "" {
+		path := dbc.FindDBCFile(filepath.Join(cfg.ModulesBuildDir, modName, "DBFilesClient"), meta.File)
+		if path == "" {
+			return fmt.Errorf("%s has not been built for mod '%s' — run 'mithril mod build' first", meta.File, modName)
+		}
+		rows, err = loadDBCRows(path, meta)
+		source = "mod " + modName
+	} else {
+		if _, statErr := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(statErr) {
+			return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+		}
+		rows, err = loadBaselineRows(cfg, meta)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Composite keys are given comma-separated, as in "1,2"
+	key := strings.Join(positional[1:], ",")
+	keyCols, err := dbc.KeyColumns(meta)
+	if err != nil {
+		return err
+	}
+	var found dbc.Row
+	for _, row := range rows {
+		if dbc.RowKey(row, keyCols) == key {
+			found = row
+			break
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("%s has no record %s (%s)", meta.File, key, source)
+	}
+
+	cols := dbc.Columns(meta)
+	width := 0
+	for _, col := range cols {
+		if len(col.Name) > width {
+			width = len(col.Name)
+		}
+	}
+
+	fmt.Printf("=== %s %s (%s, %s) ===\n", dbc.TableName(meta), key, meta.File, source)
+	hidden := 0
+	for i, col := range cols {
+		v := found[i]
+		if !all && !isKeyColumn(keyCols, i) && isZeroValue(v) {
+			hidden++
+			continue
+		}
+		fmt.Printf("  %-*s  %s\n", width, col.Name, formatShowValue(symbols, col.Name, v))
+	}
+	if hidden > 0 {
+		fmt.Printf("  (%d empty column(s) hidden, show them with --all)\n", hidden)
+	}
+	return nil
+}
+
+// formatShowValue renders a value with its symbolic name when it has one:
+// "SPELL_ATTR0_PASSIVE|SPELL_ATTR0_HIDDEN_CLIENTSIDE (0xC0)", "DISPEL_MAGIC (1)".
+func formatShowValue(symbols *dbc.Symbols, column string, v interface{}) string {
+	name, ok := symbols.Format(column, v)
+	if !ok {
+		return formatDiffValue(v)
+	}
+	if symbols.IsFlags(column) {
+		n, _ := intValue(v)
+		return fmt.Sprintf("%s (0x%X)", name, uint32(n))
+	}
+	return fmt.Sprintf("%s (%s)", name, dbc.FormatValue(v))
+}
+
+// queryTableRe finds the tables a query reads or writes.
+var queryTableRe = regexp.MustCompile("(?i)\\b(?:from|join|update|into)\\s+`?([a-z0-9_]+)`?")
+
+// querySymbols returns the enum/flag symbols of the tables named in a query.
+// Result columns are matched by name, so aliased columns are not decoded.
+func querySymbols(query string) []*dbc.Symbols {
+	var out []*dbc.Symbols
+	seen := make(map[string]bool)
+	for _, m := range queryTableRe.FindAllStringSubmatch(query, -1) {
+		table := strings.ToLower(m[1])
+		if seen[table] {
+			continue
+		}
+		seen[table] = true
+		meta, err := metaForTable(table)
+		if err != nil {
+			continue
+		}
+		symbols, err := dbc.NewSymbols(meta)
+		if err != nil {
+			printWarning(err.Error())
+			continue
+		}
+		out = append(out, symbols)
+	}
+	return out
+}
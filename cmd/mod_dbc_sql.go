@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
+	"strconv"
 
 	"github.com/suprsokr/mithril/internal/dbc"
 )
@@ -26,6 +26,23 @@ func openDBCDB(cfg *Config) (*sql.DB, error) {
 func runModDBCImport(args []string) error {
 	cfg := DefaultConfig()
 
+	// Parse --force and --jobs flags
+	jobsFlag, args := parseStringFlag(args, "jobs")
+	jobs := dbc.DefaultImportJobs
+	if jobsFlag != "" {
+		n, err := strconv.Atoi(jobsFlag)
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid --jobs value: %s", jobsFlag)
+		}
+		jobs = n
+	}
+	force := false
+	for _, a := range args {
+		if a == "--force" || a == "-f" {
+			force = true
+		}
+	}
+
 	// Check baseline exists
 	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
 		return fmt.Errorf("baseline DBC directory not found at %s — run 'mithril mod init' first", cfg.BaselineDbcDir)
@@ -49,21 +66,21 @@ func runModDBCImport(args []string) error {
 	}
 	defer db.Close()
 
-	// Parse --force flag
-	force := false
-	for _, a := range args {
-		if a == "--force" || a == "-f" {
-			force = true
-		}
-	}
-
 	fmt.Printf("Importing DBC files from %s into MySQL...\n", cfg.BaselineDbcDir)
 
-	imported, skipped, err := dbc.ImportAllDBCs(db, cfg.BaselineDbcDir, force)
+	imported, skipped, err := dbc.ImportAllDBCs(db, cfg.BaselineDbcDir, force, jobs)
 	if err != nil {
 		return fmt.Errorf("import DBCs: %w", err)
 	}
 
+	// Isolated builds clone dbc_baseline; drop it so it's re-imported from
+	// the same baseline files and metas on the next one.
+	if force {
+		if err := dropDBCBaselineSchema(cfg); err != nil {
+			printWarning(fmt.Sprintf("Could not reset %s: %v", dbcBaselineSchema, err))
+		}
+	}
+
 	fmt.Printf("\n✓ Imported %d DBC tables (%d skipped)\n", imported, skipped)
 	fmt.Println("\nYou can now query DBC data with SQL:")
 	fmt.Println("  mithril mod dbc query \"SELECT id, name_enus, flags FROM areatable WHERE map_id = 0 LIMIT 5\"")
@@ -74,15 +91,35 @@ func runModDBCImport(args []string) error {
 }
 
 // runModDBCQuery runs an ad-hoc SQL query against the dbc database.
+// With --decode, enum and flag columns are printed by name.
 func runModDBCQuery(args []string) error {
+	format, args, err := parseQueryFormat(args)
+	if err != nil {
+		return err
+	}
+	decode := false
+	var remaining []string
+	for _, a := range args {
+		if a == "--decode" {
+			decode = true
+		} else {
+			remaining = append(remaining, a)
+		}
+	}
+	args = remaining
+
 	if len(args) < 1 {
-		fmt.Println(`Usage: mithril mod dbc query "<SQL>"
+		fmt.Println(`Usage: mithril mod dbc query "<SQL>" [--decode] [--format table|tsv|csv|json|markdown]
 
 Examples:
   mithril mod dbc query "SELECT id, name_enus, flags FROM areatable WHERE map_id IN (0,1) LIMIT 10"
   mithril mod dbc query "SHOW TABLES"
   mithril mod dbc query "DESCRIBE areatable"
-  mithril mod dbc query "SELECT COUNT(*) FROM areatable WHERE flags & 1024"`)
+  mithril mod dbc query "SELECT COUNT(*) FROM areatable WHERE flags & 1024"
+  mithril mod dbc query "SELECT id, name_enus, attributes FROM spell WHERE id = 133" --decode
+  mithril mod dbc query "SELECT id, spell_name_enus FROM spell LIMIT 5" --format json
+
+For an interactive session, use: mithril mod dbc shell`)
 		return fmt.Errorf("SQL query required")
 	}
 
@@ -94,53 +131,29 @@ Examples:
 	}
 	defer db.Close()
 
-	sqlQuery := args[0]
-
-	rows, err := db.Query(sqlQuery)
+	results, err := runDBCStatement(db, args[0], decode)
 	if err != nil {
 		return fmt.Errorf("query failed: %w", err)
 	}
-	defer rows.Close()
-
-	cols, err := rows.Columns()
-	if err != nil {
-		return fmt.Errorf("get columns: %w", err)
-	}
-
-	// Print header
-	fmt.Println(strings.Join(cols, "\t"))
-
-	// Print rows
-	vals := make([]interface{}, len(cols))
-	ptrs := make([]interface{}, len(cols))
-	for i := range vals {
-		ptrs[i] = &vals[i]
-	}
-
-	for rows.Next() {
-		if err := rows.Scan(ptrs...); err != nil {
-			return fmt.Errorf("scan row: %w", err)
+	for i, res := range results {
+		if i > 0 && format == "table" {
+			fmt.Println()
 		}
-		var parts []string
-		for _, v := range vals {
-			switch val := v.(type) {
-			case nil:
-				parts = append(parts, "NULL")
-			case []byte:
-				parts = append(parts, string(val))
-			default:
-				parts = append(parts, fmt.Sprintf("%v", val))
-			}
+		if err := writeQueryResult(os.Stdout, res, format); err != nil {
+			return err
 		}
-		fmt.Println(strings.Join(parts, "\t"))
 	}
-
-	return rows.Err()
+	return nil
 }
 
 // runModDBCExport exports modified DBC tables from MySQL back to .dbc binary files.
 func runModDBCExport(args []string) error {
 	cfg := DefaultConfig()
+	for _, a := range args {
+		if a == "--fidelity" {
+			cfg.DBCFidelity = true
+		}
+	}
 
 	db, err := openDBCDB(cfg)
 	if err != nil {
@@ -148,7 +161,7 @@ func runModDBCExport(args []string) error {
 	}
 	defer db.Close()
 
-	metaFiles, err := dbc.GetEmbeddedMetaFiles()
+	metas, err := dbc.AllMetas()
 	if err != nil {
 		return fmt.Errorf("get meta files: %w", err)
 	}
@@ -157,7 +170,7 @@ func runModDBCExport(args []string) error {
 
 	fmt.Println("Exporting modified DBC tables from MySQL...")
 
-	exported, err := dbc.ExportModifiedDBCs(db, metaFiles, cfg.BaselineDbcDir, exportDir)
+	exported, err := dbc.ExportModifiedDBCs(db, metas, cfg.FidelityBaselineDir(), exportDir)
 	if err != nil {
 		return fmt.Errorf("export DBCs: %w", err)
 	}
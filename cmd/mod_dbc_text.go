@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCDump writes a baseline DBC table as CSV or JSON. No database needed.
+func runModDBCDump(args []string) error {
+	modName, remaining := parseModFlag(args)
+	format, remaining := parseStringFlag(remaining, "format")
+	outPath, remaining := parseStringFlag(remaining, "out")
+	if outPath == "" {
+		outPath, remaining = parseShortFlag(remaining, "-o")
+	}
+	if len(remaining) < 1 {
+		return fmt.Errorf("usage: mithril mod dbc dump <table> [--format csv|json] [--mod <mod>] [-o <file>]")
+	}
+
+	cfg := DefaultConfig()
+	meta, err := dbc.GetMetaForDBC(remaining[0])
+	if err != nil {
+		return err
+	}
+
+	if format == "" {
+		format = dbc.FormatFromPath(outPath)
+	}
+	if format == "" {
+		format = dbc.FormatCSV
+	}
+
+	if modName != "" {
+		if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+			return fmt.Errorf("mod not found: %s", modName)
+		}
+		if outPath == "" {
+			base := strings.TrimSuffix(meta.File, filepath.Ext(meta.File))
+			outPath = filepath.Join(cfg.ModDir(modName), "dbc", base+"."+format)
+		}
+		if fileExists(outPath) {
+			return fmt.Errorf("%s already exists", outPath)
+		}
+	}
+
+	rows, err := loadBaselineRows(cfg, meta)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if outPath != "" {
+		if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+			return fmt.Errorf("create output dir: %w", err)
+		}
+		f, err := os.Create(outPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := dbc.DumpRows(w, rows, meta, format); err != nil {
+		return fmt.Errorf("dump %s: %w", meta.File, err)
+	}
+
+	if outPath != "" {
+		fmt.Printf("✓ Dumped %s (%d records) to %s\n", meta.File, len(rows), outPath)
+		if modName != "" {
+			fmt.Println("  Trim it to the rows you change — rows are merged onto the baseline by primary key.")
+			fmt.Println("  Build with: mithril mod build")
+		}
+	}
+	return nil
+}
+
+// runModDBCLoad converts a CSV/JSON table file into a .dbc binary, merging its
+// rows onto the baseline the same way mod build does.
+func runModDBCLoad(args []string) error {
+	tableName, remaining := parseStringFlag(args, "table")
+	outPath, remaining := parseStringFlag(remaining, "out")
+	if outPath == "" {
+		outPath, remaining = parseShortFlag(remaining, "-o")
+	}
+	fidelity := false
+	var rest []string
+	for _, a := range remaining {
+		if a == "--fidelity" {
+			fidelity = true
+		} else {
+			rest = append(rest, a)
+		}
+	}
+	remaining = rest
+	if len(remaining) < 1 {
+		return fmt.Errorf("usage: mithril mod dbc load <file.csv|file.json> [--table <table>] [-o <out.dbc>] [--fidelity]")
+	}
+
+	cfg := DefaultConfig()
+	if fidelity {
+		cfg.DBCFidelity = true
+	}
+	inPath := remaining[0]
+	if tableName == "" {
+		tableName = strings.TrimSuffix(filepath.Base(inPath), filepath.Ext(inPath))
+	}
+	meta, err := dbc.GetMetaForDBC(tableName)
+	if err != nil {
+		return err
+	}
+
+	dbcFile, count, err := buildDBCFromText(cfg, inPath, meta)
+	if err != nil {
+		return err
+	}
+
+	if outPath == "" {
+		outPath = filepath.Join(cfg.ModulesBuildDir, "dbc_export", meta.File)
+	}
+	if err := dbc.WriteDBC(dbcFile, meta, outPath); err != nil {
+		return fmt.Errorf("write %s: %w", meta.File, err)
+	}
+
+	fmt.Printf("✓ %s: %d row(s) from %s → %s (%d records)\n",
+		meta.File, count, filepath.Base(inPath), outPath, dbcFile.Header.RecordCount)
+	return nil
+}
+
+// buildModDBCsFromFiles converts a mod's dbc/*.csv and dbc/*.json files into
+// .dbc binaries. Each file's rows are merged onto the table by primary key —
+// onto the mod's SQL-exported DBC in buildDbcDir if one was built, otherwise
+// the baseline.
+func buildModDBCsFromFiles(cfg *Config, mod, buildDbcDir string, sqlBuilt []builtFile) ([]builtFile, error) {
+	textFiles := findModDBCTextFiles(cfg, mod)
+	if len(textFiles) == 0 {
+		return nil, nil
+	}
+
+	if err := os.MkdirAll(buildDbcDir, 0755); err != nil {
+		return nil, fmt.Errorf("create build dir: %w", err)
+	}
+
+	sqlExported := make(map[string]bool)
+	for _, bf := range sqlBuilt {
+		sqlExported[strings.ToLower(filepath.Base(bf.diskPath))] = true
+	}
+
+	var files []builtFile
+	for _, path := range textFiles {
+		base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		meta, err := dbc.GetMetaForDBC(base)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+
+		outPath := filepath.Join(buildDbcDir, meta.File)
+		var baseRows []dbc.Row
+		if sqlExported[strings.ToLower(meta.File)] {
+			baseRows, err = loadDBCRows(outPath, meta)
+		} else {
+			baseRows, err = loadBaselineRows(cfg, meta)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		patch, err := dbc.LoadTextRows(path, meta)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := dbc.MergeRows(baseRows, patch, meta)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		dbcFile, err := preserveBaseline(cfg, dbc.BuildDBC(rows, meta), meta)
+		if err != nil {
+			return nil, err
+		}
+		if err := dbc.WriteDBC(dbcFile, meta, outPath); err != nil {
+			return nil, fmt.Errorf("write %s: %w", meta.File, err)
+		}
+		fmt.Printf("    ✓ %s (from %s, %d records)\n", dbc.TableName(meta), filepath.Base(path), dbcFile.Header.RecordCount)
+
+		if !sqlExported[strings.ToLower(meta.File)] {
+			files = append(files, builtFile{diskPath: outPath, mpqPath: "DBFilesClient\\" + meta.File})
+			sqlExported[strings.ToLower(meta.File)] = true
+		}
+	}
+	return files, nil
+}
+
+// buildDBCFromText merges a text file's rows onto the baseline table.
+// Returns the built file and the number of rows read from the text file.
+func buildDBCFromText(cfg *Config, path string, meta *dbc.MetaFile) (*dbc.DBCFile, int, error) {
+	baseRows, err := loadBaselineRows(cfg, meta)
+	if err != nil {
+		return nil, 0, err
+	}
+	patch, err := dbc.LoadTextRows(path, meta)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := dbc.MergeRows(baseRows, patch, meta)
+	if err != nil {
+		return nil, 0, err
+	}
+	dbcFile, err := preserveBaseline(cfg, dbc.BuildDBC(rows, meta), meta)
+	if err != nil {
+		return nil, 0, err
+	}
+	return dbcFile, len(patch), nil
+}
+
+// preserveBaseline re-encodes a built table against its baseline file when
+// fidelity mode is on, so unchanged records keep their original bytes.
+func preserveBaseline(cfg *Config, file *dbc.DBCFile, meta *dbc.MetaFile) (*dbc.DBCFile, error) {
+	if !cfg.DBCFidelity {
+		return file, nil
+	}
+	path := dbc.FindDBCFile(cfg.BaselineDbcDir, meta.File)
+	if path == "" {
+		return file, nil // custom table, nothing to preserve
+	}
+	baseline, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read baseline %s: %w", meta.File, err)
+	}
+	return dbc.PreserveBaseline(file, meta, baseline)
+}
+
+// loadBaselineRows decodes a table from the baseline DBC directory.
+// Custom tables with no baseline file start empty.
+func loadBaselineRows(cfg *Config, meta *dbc.MetaFile) ([]dbc.Row, error) {
+	path := dbc.FindDBCFile(cfg.BaselineDbcDir, meta.File)
+	if path == "" {
+		if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+			return nil, fmt.Errorf("baseline not found — run 'mithril mod init' first")
+		}
+		return nil, nil
+	}
+	return loadDBCRows(path, meta)
+}
+
+// loadModDBCRows decodes a table as a mod last built it, or from the baseline
+// if the mod doesn't change it.
+func loadModDBCRows(cfg *Config, mod string, meta *dbc.MetaFile) ([]dbc.Row, error) {
+	if path := dbc.FindDBCFile(filepath.Join(cfg.ModulesBuildDir, mod, "DBFilesClient"), meta.File); path != "" {
+		return loadDBCRows(path, meta)
+	}
+	return loadBaselineRows(cfg, meta)
+}
+
+// loadDBCRows decodes every record of a .dbc file.
+func loadDBCRows(path string, meta *dbc.MetaFile) ([]dbc.Row, error) {
+	dbcFile, err := dbc.LoadDBC(path, *meta)
+	if err != nil {
+		return nil, err
+	}
+	return dbc.DecodeRows(&dbcFile, meta), nil
+}
+
+// findModDBCTextFiles returns a mod's dbc/*.csv and dbc/*.json files, sorted.
+func findModDBCTextFiles(cfg *Config, mod string) []string {
+	entries, err := os.ReadDir(filepath.Join(cfg.ModDir(mod), "dbc"))
+	if err != nil {
+		return nil
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || dbc.FormatFromPath(entry.Name()) == "" {
+			continue
+		}
+		files = append(files, filepath.Join(cfg.ModDir(mod), "dbc", entry.Name()))
+	}
+	sort.Strings(files)
+	return files
+}
+
+// parseShortFlag extracts a single-dash flag with a value (e.g., -o <file>).
+func parseShortFlag(args []string, flag string) (string, []string) {
+	var remaining []string
+	var value string
+	for i := 0; i < len(args); i++ {
+		if args[i] == flag && i+1 < len(args) {
+			value = args[i+1]
+			i++
+		} else {
+			remaining = append(remaining, args[i])
+		}
+	}
+	return value, remaining
+}
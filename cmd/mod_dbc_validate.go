@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCValidate checks the built DBCs for dangling cross-table references.
+// Without --mod, every mod's last build is merged the way mod build merges
+// it, and the merged files — the ones that ship — are checked together.
+func runModDBCValidate(args []string) error {
+	modName, remaining := parseModFlag(args)
+	cfg := DefaultConfig()
+
+	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+
+	mods := getAllMods(cfg)
+	if modName != "" {
+		if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+			return fmt.Errorf("mod not found: %s", modName)
+		}
+		mods = []string{modName}
+	}
+
+	var files []builtFile
+	sources := make(map[string][]modDBC)
+	for _, mod := range mods {
+		built, err := lastBuiltDBCs(cfg, mod)
+		if err != nil {
+			return fmt.Errorf("read build dir for %s: %w", mod, err)
+		}
+		for _, bf := range built {
+			key := strings.ToLower(bf.mpqPath)
+			if len(sources[key]) == 0 {
+				files = append(files, bf)
+			}
+			sources[key] = append(sources[key], modDBC{mod: mod, file: bf})
+		}
+	}
+	mergedRoot, err := os.MkdirTemp("", "mithril-validate-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(mergedRoot)
+	if files, err = mergeModDBCsInto(cfg, mergedRoot, files, sources); err != nil {
+		return err
+	}
+
+	if len(remaining) > 0 {
+		meta, err := dbc.GetMetaForDBC(remaining[0])
+		if err != nil {
+			return err
+		}
+		var only []builtFile
+		for _, bf := range files {
+			if strings.EqualFold(filepath.Base(bf.diskPath), meta.File) {
+				only = append(only, bf)
+			}
+		}
+		if len(only) == 0 {
+			return fmt.Errorf("%s has not been built — run 'mithril mod build' first", meta.File)
+		}
+		// Referenced tables still resolve against every built file
+		dangling, err := validateDBCReferences(cfg, only, files)
+		if err != nil {
+			return err
+		}
+		return reportDanglingRefs(dangling, 1)
+	}
+
+	if len(files) == 0 {
+		fmt.Println("No built DBCs to validate — run 'mithril mod build' first.")
+		return nil
+	}
+
+	dangling, err := validateDBCReferences(cfg, files, files)
+	if err != nil {
+		return err
+	}
+	return reportDanglingRefs(dangling, len(files))
+}
+
+// reportDanglingRefs prints validation results and returns an error if any
+// reference is dangling.
+func reportDanglingRefs(dangling []dbc.DanglingRef, checked int) error {
+	if len(dangling) == 0 {
+		fmt.Printf("✓ %d DBC file(s) validated, no dangling references\n", checked)
+		return nil
+	}
+	printDanglingRefs(dangling)
+	return fmt.Errorf("%d dangling reference(s)", len(dangling))
+}
+
+func printDanglingRefs(dangling []dbc.DanglingRef) {
+	fmt.Printf("\n✗ %d dangling reference(s):\n", len(dangling))
+	for _, r := range dangling {
+		fmt.Printf("  %s\n", r)
+	}
+}
+
+// validateDBCReferences checks the references of each file in check against
+// the baseline, for records the file adds or changes. Referenced tables are
+// read from available when present there, otherwise from the baseline.
+func validateDBCReferences(cfg *Config, check, available []builtFile) ([]dbc.DanglingRef, error) {
+	builtPaths := make(map[string]string, len(available))
+	for _, bf := range available {
+		builtPaths[strings.ToLower(filepath.Base(bf.diskPath))] = bf.diskPath
+	}
+
+	type tableRows struct {
+		meta *dbc.MetaFile
+		rows []dbc.Row
+	}
+	cache := make(map[string]tableRows)
+	source := func(dbcName string) (*dbc.MetaFile, []dbc.Row, error) {
+		meta, err := dbc.GetMetaForDBC(dbcName)
+		if err != nil {
+			return nil, nil, err
+		}
+		key := strings.ToLower(meta.File)
+		if t, ok := cache[key]; ok {
+			return t.meta, t.rows, nil
+		}
+		var rows []dbc.Row
+		if path, ok := builtPaths[key]; ok {
+			rows, err = loadDBCRows(path, meta)
+		} else {
+			rows, err = loadBaselineRows(cfg, meta)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		cache[key] = tableRows{meta: meta, rows: rows}
+		return meta, rows, nil
+	}
+
+	var dangling []dbc.DanglingRef
+	for _, bf := range check {
+		meta, err := dbc.GetMetaForDBC(filepath.Base(bf.diskPath))
+		if err != nil {
+			continue
+		}
+		if len(meta.References) == 0 {
+			continue
+		}
+		_, current, err := source(meta.File)
+		if err != nil {
+			return nil, err
+		}
+		base, err := loadBaselineRows(cfg, meta)
+		if err != nil {
+			return nil, err
+		}
+		d, err := dbc.DiffRows(base, current, meta)
+		if err != nil {
+			return nil, err
+		}
+		found, err := dbc.CheckReferences(meta, d, source)
+		if err != nil {
+			return nil, err
+		}
+		dangling = append(dangling, found...)
+	}
+
+	sort.SliceStable(dangling, func(i, j int) bool {
+		return dangling[i].File < dangling[j].File
+	})
+	return dangling, nil
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// runModDBCVerifyRoundtrip re-encodes every baseline DBC in fidelity mode and
+// checks that the result is byte-for-byte identical to the original file.
+// By default each table is read back from MySQL; --offline goes through the
+// CSV path instead and needs no database.
+func runModDBCVerifyRoundtrip(args []string) error {
+	offline := false
+	var remaining []string
+	for _, a := range args {
+		if a == "--offline" {
+			offline = true
+		} else {
+			remaining = append(remaining, a)
+		}
+	}
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+
+	var metas []*dbc.MetaFile
+	if len(remaining) > 0 {
+		meta, err := dbc.GetMetaForDBC(remaining[0])
+		if err != nil {
+			return err
+		}
+		metas = []*dbc.MetaFile{meta}
+	} else {
+		all, err := dbc.AllMetas()
+		if err != nil {
+			return fmt.Errorf("get meta files: %w", err)
+		}
+		metas = all
+	}
+
+	var db *sql.DB
+	if !offline {
+		var err error
+		db, err = openDBCDB(cfg)
+		if err != nil {
+			return fmt.Errorf("connect to dbc database: %w (use --offline to verify without MySQL)", err)
+		}
+		defer db.Close()
+		fmt.Println("Verifying baseline DBCs round-trip through MySQL...")
+	} else {
+		fmt.Println("Verifying baseline DBCs round-trip through CSV...")
+	}
+
+	identical, failed, skipped := 0, 0, 0
+	for _, meta := range metas {
+		path := dbc.FindDBCFile(cfg.BaselineDbcDir, meta.File)
+		if path == "" {
+			continue // custom table or not extracted
+		}
+		baseline, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", path, err)
+		}
+
+		var file *dbc.DBCFile
+		if offline {
+			file, err = roundtripViaCSV(baseline, meta)
+		} else {
+			tableName := dbc.TableName(meta)
+			if !dbc.TableExists(db, tableName) {
+				fmt.Printf("  - %s: not imported, skipped\n", meta.File)
+				skipped++
+				continue
+			}
+			if tableModified(db, tableName) {
+				fmt.Printf("  - %s: modified in the database, skipped\n", meta.File)
+				skipped++
+				continue
+			}
+			file, err = dbc.ExportTable(db, meta)
+		}
+		if err == nil {
+			file, err = dbc.PreserveBaseline(file, meta, baseline)
+		}
+		var out []byte
+		if err == nil {
+			out, err = dbc.EncodeDBC(file, meta)
+		}
+		if err != nil {
+			fmt.Printf("  ✗ %s: %v\n", meta.File, err)
+			failed++
+			continue
+		}
+
+		if bytes.Equal(out, baseline) {
+			identical++
+			continue
+		}
+		fmt.Printf("  ✗ %s: %s\n", meta.File, describeMismatch(out, baseline))
+		failed++
+	}
+
+	fmt.Printf("\n%d identical, %d different, %d skipped\n", identical, failed, skipped)
+	if failed > 0 {
+		return fmt.Errorf("%d DBC file(s) did not round-trip", failed)
+	}
+	fmt.Println("✓ Every checked DBC round-trips byte-for-byte")
+	return nil
+}
+
+// roundtripViaCSV decodes a DBC, writes it as CSV, and builds it back.
+func roundtripViaCSV(data []byte, meta *dbc.MetaFile) (*dbc.DBCFile, error) {
+	file, err := dbc.LoadDBCFromBytes(data, *meta)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := dbc.DumpRows(&buf, dbc.DecodeRows(&file, meta), meta, dbc.FormatCSV); err != nil {
+		return nil, err
+	}
+	rows, err := dbc.LoadRows(&buf, meta, dbc.FormatCSV)
+	if err != nil {
+		return nil, err
+	}
+	return dbc.BuildDBC(rows, meta), nil
+}
+
+// describeMismatch locates the first differing byte: header, record, or string block.
+func describeMismatch(got, want []byte) string {
+	n := len(got)
+	if len(want) < n {
+		n = len(want)
+	}
+	at := n
+	for i := 0; i < n; i++ {
+		if got[i] != want[i] {
+			at = i
+			break
+		}
+	}
+	where := "in the header"
+	if at >= 20 {
+		header, _ := dbc.ParseHeader(want)
+		recordsEnd := 20 + int(header.RecordCount*header.RecordSize)
+		if at < recordsEnd {
+			where = fmt.Sprintf("in record %d", (at-20)/int(header.RecordSize))
+		} else {
+			where = "in the string block"
+		}
+	}
+	return fmt.Sprintf("%d bytes vs %d in baseline, first difference at byte %d (%s)", len(got), len(want), at, where)
+}
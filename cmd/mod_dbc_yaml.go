@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+// findModDBCEditFiles returns a mod's dbc/*.yaml and dbc/*.yml files, sorted.
+func findModDBCEditFiles(cfg *Config, mod string) []string {
+	entries, err := os.ReadDir(filepath.Join(cfg.ModDir(mod), "dbc"))
+	if err != nil {
+		return nil
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || !dbc.IsEditFile(entry.Name()) {
+			continue
+		}
+		files = append(files, filepath.Join(cfg.ModDir(mod), "dbc", entry.Name()))
+	}
+	sort.Strings(files)
+	return files
+}
+
+// dbcEditStateDir holds the compiled scripts of the YAML edits last applied
+// to the shared dbc database, one <file>.sql / <file>.rollback.sql pair per
+// edit file. The rollback is what undoes an edit file once it changes or is
+// removed.
+func dbcEditStateDir(cfg *Config, mod string) string {
+	return filepath.Join(cfg.ModulesBuildDir, mod, "dbc_yaml")
+}
+
+// compileDBCEditFile compiles a YAML edit file into forward and rollback SQL
+// against the baseline. With a database, the rollback restores the rows the
+// database has now rather than the baseline's, so it only deletes records the
+// file itself adds.
+func compileDBCEditFile(cfg *Config, path string, db *sql.DB) (forward, rollback string, err error) {
+	tables, err := dbc.LoadEditFile(path)
+	if err != nil {
+		return "", "", err
+	}
+	var fw, rb strings.Builder
+	for _, te := range tables {
+		base, err := loadBaselineRows(cfg, te.Meta)
+		if err != nil {
+			return "", "", err
+		}
+		var before []dbc.Row
+		if db != nil {
+			live, err := dbc.ExportTable(db, te.Meta)
+			if err != nil {
+				return "", "", fmt.Errorf("read %s: %w", dbc.TableName(te.Meta), err)
+			}
+			before = dbc.DecodeRows(live, te.Meta)
+		}
+		f, r, err := dbc.EditSQL(te, base, before)
+		if err != nil {
+			return "", "", fmt.Errorf("%s: %s: %w", filepath.Base(path), te.Name, err)
+		}
+		table := dbc.TableName(te.Meta)
+		fmt.Fprintf(&fw, "-- %s: %d record(s)\n%s\n", table, len(te.Edits), f)
+		fmt.Fprintf(&rb, "-- %s\n%s\n", table, r)
+	}
+	header := fmt.Sprintf("-- Compiled from dbc/%s by 'mithril mod build'\n\n", filepath.Base(path))
+	return header + fw.String(), header + rb.String(), nil
+}
+
+// applyModDBCEdits brings the shared dbc database in line with a mod's YAML
+// edit files. A file whose compiled script changed since it was last applied
+// is rolled back first and then applied again; a file that was removed is
+// rolled back. Returns the number of files applied or rolled back.
+func applyModDBCEdits(cfg *Config, mod string, db *sql.DB) (int, error) {
+	stateDir := dbcEditStateDir(cfg, mod)
+	current := make(map[string]bool)
+	changed := 0
+
+	for _, path := range findModDBCEditFiles(cfg, mod) {
+		name := filepath.Base(path)
+		current[name] = true
+		forward, _, err := compileDBCEditFile(cfg, path, nil)
+		if err != nil {
+			return changed, err
+		}
+
+		appliedPath := filepath.Join(stateDir, name+".sql")
+		applied, err := os.ReadFile(appliedPath)
+		if err == nil && string(applied) == forward {
+			continue
+		}
+		if err == nil {
+			if err := undoDBCEditFile(cfg, mod, db, name); err != nil {
+				return changed, err
+			}
+		}
+
+		// The rollback is taken from the rows as they are now, after this
+		// mod's migrations and the undo of the file's previous version
+		_, rollback, err := compileDBCEditFile(cfg, path, db)
+		if err != nil {
+			return changed, err
+		}
+
+		fmt.Printf("    Applying DBC edits: %s ...\n", name)
+		if _, err := db.Exec(forward); err != nil {
+			return changed, fmt.Errorf("apply %s: %w", name, err)
+		}
+		if err := os.MkdirAll(stateDir, 0755); err != nil {
+			return changed, fmt.Errorf("create %s: %w", stateDir, err)
+		}
+		if err := os.WriteFile(filepath.Join(stateDir, name+".rollback.sql"), []byte(rollback), 0644); err != nil {
+			return changed, err
+		}
+		if err := os.WriteFile(appliedPath, []byte(forward), 0644); err != nil {
+			return changed, err
+		}
+		fmt.Printf("    ✓ %s\n", name)
+		changed++
+	}
+
+	// Edit files removed from the mod since they were applied
+	for _, name := range appliedDBCEditFiles(cfg, mod) {
+		if current[name] {
+			continue
+		}
+		if err := undoDBCEditFile(cfg, mod, db, name); err != nil {
+			return changed, err
+		}
+		fmt.Printf("    ✓ Rolled back %s (removed)\n", name)
+		changed++
+	}
+	return changed, nil
+}
+
+// appliedDBCEditFiles returns the names of the edit files whose compiled
+// scripts are applied to the shared dbc database.
+func appliedDBCEditFiles(cfg *Config, mod string) []string {
+	entries, _ := os.ReadDir(dbcEditStateDir(cfg, mod))
+	var names []string
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".sql")
+		if entry.IsDir() || name == entry.Name() || strings.HasSuffix(name, ".rollback") {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// dbcEditStatus reports whether an edit file is "pending" (never applied),
+// "applied" (its compiled script is what the dbc database has) or "changed".
+func dbcEditStatus(cfg *Config, mod, path string) string {
+	applied, err := os.ReadFile(filepath.Join(dbcEditStateDir(cfg, mod), filepath.Base(path)+".sql"))
+	if err != nil {
+		return "pending"
+	}
+	if forward, _, err := compileDBCEditFile(cfg, path, nil); err == nil && forward == string(applied) {
+		return "applied"
+	}
+	return "changed"
+}
+
+// undoDBCEditFile runs the stored rollback of an applied edit file and
+// forgets it.
+func undoDBCEditFile(cfg *Config, mod string, db *sql.DB, name string) error {
+	stateDir := dbcEditStateDir(cfg, mod)
+	rollbackPath := filepath.Join(stateDir, name+".rollback.sql")
+	rollback, err := os.ReadFile(rollbackPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(rollback) > 0 {
+		if _, err := db.Exec(string(rollback)); err != nil {
+			return fmt.Errorf("roll back %s: %w", name, err)
+		}
+	}
+	os.Remove(rollbackPath)
+	return os.Remove(filepath.Join(stateDir, name+".sql"))
+}
+
+// rollbackModDBCEdits undoes every YAML edit file of a mod applied to the
+// shared dbc database. Returns the number of files rolled back.
+func rollbackModDBCEdits(cfg *Config, mod string, db *sql.DB) (int, error) {
+	entries, err := os.ReadDir(dbcEditStateDir(cfg, mod))
+	if err != nil {
+		return 0, nil
+	}
+	n := 0
+	for i := len(entries) - 1; i >= 0; i-- {
+		name := strings.TrimSuffix(entries[i].Name(), ".sql")
+		if entries[i].IsDir() || name == entries[i].Name() || strings.HasSuffix(name, ".rollback") {
+			continue
+		}
+		if err := undoDBCEditFile(cfg, mod, db, name); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// hasDBCEditState reports whether a mod has YAML edits applied to the shared
+// dbc database, even if the files themselves are gone.
+func hasDBCEditState(cfg *Config, mod string) bool {
+	entries, err := os.ReadDir(dbcEditStateDir(cfg, mod))
+	return err == nil && len(entries) > 0
+}
+
+// applyModDBCEditsTo runs the forward scripts of a mod's YAML edit files
+// against a scratch schema, which starts from the baseline every time.
+func applyModDBCEditsTo(cfg *Config, mod string, db *sql.DB) error {
+	for _, path := range findModDBCEditFiles(cfg, mod) {
+		forward, _, err := compileDBCEditFile(cfg, path, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(forward); err != nil {
+			return fmt.Errorf("apply %s: %w", filepath.Base(path), err)
+		}
+		fmt.Printf("    ✓ %s\n", filepath.Base(path))
+	}
+	return nil
+}
+
+// runModDBCCompile prints the SQL a mod's YAML edit files compile to, or
+// their rollback with --rollback. No MySQL needed.
+func runModDBCCompile(args []string) error {
+	modName, remaining := parseModFlag(args)
+	rollback := false
+	var names []string
+	for _, a := range remaining {
+		if a == "--rollback" {
+			rollback = true
+		} else {
+			names = append(names, a)
+		}
+	}
+	if modName == "" {
+		return fmt.Errorf("usage: mithril mod dbc compile --mod <mod> [--rollback] [<file.yaml>...]")
+	}
+
+	cfg := DefaultConfig()
+	if _, err := os.Stat(filepath.Join(cfg.ModDir(modName), "mod.json")); os.IsNotExist(err) {
+		return fmt.Errorf("mod not found: %s", modName)
+	}
+	if _, err := os.Stat(cfg.BaselineDbcDir); os.IsNotExist(err) {
+		return fmt.Errorf("baseline not found — run 'mithril mod init' first")
+	}
+
+	paths := findModDBCEditFiles(cfg, modName)
+	if len(names) > 0 {
+		paths = nil
+		for _, name := range names {
+			path := filepath.Join(cfg.ModDir(modName), "dbc", filepath.Base(name))
+			if !dbc.IsEditFile(path) || !fileExists(path) {
+				return fmt.Errorf("edit file not found in %s/dbc: %s", modName, name)
+			}
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		fmt.Printf("Mod '%s' has no dbc/*.yaml edit files.\n", modName)
+		return nil
+	}
+
+	for _, path := range paths {
+		fw, rb, err := compileDBCEditFile(cfg, path, nil)
+		if err != nil {
+			return err
+		}
+		if rollback {
+			fmt.Print(rb)
+		} else {
+			fmt.Print(fw)
+		}
+	}
+	return nil
+}
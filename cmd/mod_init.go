@@ -24,6 +24,9 @@ type Manifest struct {
 	// Automatically populated when mods are created or installed.
 	// Users can reorder entries in modules/manifest.json to change priority.
 	BuildOrder []string `json:"build_order"`
+	// Reservations are the custom DBC ID ranges claimed by mods with
+	// `mithril mod dbc reserve`. mod build rejects new rows outside them.
+	Reservations []IDReservation `json:"reservations,omitempty"`
 }
 
 func runModInit(args []string) error {
@@ -123,6 +126,7 @@ func runModInit(args []string) error {
 	extracted := 0
 	withMeta := 0
 	withoutMeta := 0
+	mismatched := 0
 
 	dbcNames := make([]string, 0, len(dbcFiles))
 	for name := range dbcFiles {
@@ -160,6 +164,7 @@ func runModInit(args []string) error {
 			if err != nil {
 				fmt.Printf("  ⚠ Failed to parse %s (meta mismatch?): %v\n", dbcName, err)
 				hasMeta = false
+				mismatched++
 			} else {
 				withMeta++
 			}
@@ -240,6 +245,12 @@ func runModInit(args []string) error {
 	fmt.Printf("  Baseline DBCs:      %s\n", cfg.BaselineDbcDir)
 	fmt.Printf("  Baseline addons:    %s\n", cfg.BaselineAddonsDir)
 	fmt.Printf("  Manifest:           %s\n", manifestPath)
+	if mismatched > 0 {
+		fmt.Printf("\n  %d schema(s) don't match their DBC — details: mithril mod dbc coverage\n", mismatched)
+	}
+	if withoutMeta > 0 {
+		fmt.Printf("\n  Draft a schema for a raw-only DBC with: mithril mod dbc infer <File.dbc>\n")
+	}
 
 	// --- Phase 3: Import DBCs into MySQL for SQL-based editing ---
 	fmt.Println("\nImporting DBC data into MySQL...")
@@ -7,8 +7,6 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
-
-	"github.com/suprsokr/mithril/internal/dbc"
 )
 
 func runModPublish(args []string) error {
@@ -78,74 +76,16 @@ func runModPublishExport(args []string) error {
 	locale := detectLocaleFromManifest(cfg)
 	patchLetter := cfg.PatchLetter
 
-	// Isolated DBC build: reset database to baseline, apply only this mod's
-	// migrations, export, then restore all mods' migrations afterward.
-	dbcMigrations := findDBCMigrations(cfg, modName)
-	if len(dbcMigrations) > 0 {
+	// Isolated DBC build: only this mod's migrations, YAML edits and CSV/JSON
+	// files, in a scratch schema, so the shared dbc database is left alone.
+	if len(findDBCMigrations(cfg, modName)) > 0 || len(findModDBCEditFiles(cfg, modName)) > 0 || len(findModDBCTextFiles(cfg, modName)) > 0 {
 		fmt.Println("  Building isolated DBC artifacts...")
-		fmt.Println("    Resetting DBC database to baseline...")
-
-		db, err := openDBCDB(cfg)
-		if err != nil {
-			return fmt.Errorf("connect to dbc database: %w", err)
-		}
-
-		// Step 1: Reset to baseline
-		if _, _, err := dbc.ImportAllDBCs(db, cfg.BaselineDbcDir, true); err != nil {
-			db.Close()
-			return fmt.Errorf("reset DBC database: %w", err)
-		}
-
-		// Step 2: Apply only this mod's DBC migrations
-		fmt.Printf("    Applying %s DBC migrations...\n", modName)
-		for _, m := range dbcMigrations {
-			sqlContent, err := os.ReadFile(m.path)
-			if err != nil {
-				db.Close()
-				return fmt.Errorf("read migration %s: %w", m.filename, err)
-			}
-			if _, err := db.Exec(string(sqlContent)); err != nil {
-				db.Close()
-				return fmt.Errorf("apply migration %s: %w", m.filename, err)
-			}
-			fmt.Printf("    ✓ %s\n", m.filename)
-		}
-
-		// Step 3: Export modified DBC tables
-		metaFiles, err := dbc.GetEmbeddedMetaFiles()
-		if err != nil {
-			db.Close()
-			return fmt.Errorf("get meta files: %w", err)
-		}
 
 		exportDbcDir := filepath.Join(releaseDir, "dbc_export")
 		os.RemoveAll(exportDbcDir)
-		if err := os.MkdirAll(exportDbcDir, 0755); err != nil {
-			db.Close()
-			return fmt.Errorf("create export dir: %w", err)
-		}
-
-		exported, err := dbc.ExportModifiedDBCs(db, metaFiles, cfg.BaselineDbcDir, exportDbcDir)
+		dbcFiles, err := buildModDBCsIsolated(cfg, modName, exportDbcDir)
 		if err != nil {
-			db.Close()
-			return fmt.Errorf("export modified DBCs: %w", err)
-		}
-
-		// Build file list from exported tables
-		var dbcFiles []builtFile
-		for _, tableName := range exported {
-			for _, metaFile := range metaFiles {
-				meta, err := dbc.LoadEmbeddedMeta(metaFile)
-				if err != nil {
-					continue
-				}
-				if dbc.TableName(meta) == tableName {
-					dbcOutPath := filepath.Join(exportDbcDir, meta.File)
-					mpqInternalPath := "DBFilesClient\\" + meta.File
-					dbcFiles = append(dbcFiles, builtFile{diskPath: dbcOutPath, mpqPath: mpqInternalPath})
-					break
-				}
-			}
+			return fmt.Errorf("build DBCs: %w", err)
 		}
 
 		// Create DBC MPQ
@@ -154,7 +94,6 @@ func runModPublishExport(args []string) error {
 			dbcMpqPath := filepath.Join(clientDir, "Data", dbcMpqName)
 			os.MkdirAll(filepath.Dir(dbcMpqPath), 0755)
 			if err := createMPQ(dbcMpqPath, dbcFiles); err != nil {
-				db.Close()
 				return fmt.Errorf("create DBC MPQ: %w", err)
 			}
 			hasClient = true
@@ -169,33 +108,6 @@ func runModPublishExport(args []string) error {
 			}
 			fmt.Printf("  ✓ Server DBC files (%d files)\n", len(dbcFiles))
 		}
-
-		// Step 4: Restore database — re-import baseline and re-apply all mods' migrations
-		fmt.Println("    Restoring DBC database...")
-		if _, _, err := dbc.ImportAllDBCs(db, cfg.BaselineDbcDir, true); err != nil {
-			db.Close()
-			return fmt.Errorf("restore DBC database: %w", err)
-		}
-
-		allMods := getAllMods(cfg)
-		tracker, _ := loadSQLTracker(cfg)
-		for _, mod := range allMods {
-			for _, m := range findDBCMigrations(cfg, mod) {
-				if !tracker.IsApplied(m.mod, m.filename) {
-					continue
-				}
-				sqlContent, err := os.ReadFile(m.path)
-				if err != nil {
-					fmt.Printf("    ⚠ Failed to read %s: %v\n", m.filename, err)
-					continue
-				}
-				if _, err := db.Exec(string(sqlContent)); err != nil {
-					fmt.Printf("    ⚠ Failed to re-apply %s: %v\n", m.filename, err)
-				}
-			}
-		}
-		db.Close()
-		fmt.Println("    ✓ DBC database restored")
 	}
 
 	// Copy addon files
@@ -208,6 +208,7 @@ func installFromGit(cfg *Config, entry RegistryEntry) error {
 	if _, err := os.Stat(modJsonPath); os.IsNotExist(err) {
 		meta := ModMeta{
 			Name:      entry.Name,
+			Version:   entry.Version,
 			CreatedAt: timeNow(),
 		}
 		data, _ := json.MarshalIndent(meta, "", "  ")
@@ -697,51 +697,7 @@ func syncScriptsToContainer(cfg *Config) (changed bool, err error) {
 	if err != nil {
 		return false, fmt.Errorf("load script tracker: %w", err)
 	}
-
-	// Build the desired state: all scripts from all mods
-	var want []scriptDesired
-
-	mods := getAllMods(cfg)
-	for _, mod := range mods {
-		scripts := findModScripts(cfg, mod)
-		srcDir := filepath.Join(cfg.ModDir(mod), "scripts")
-		for _, script := range scripts {
-			srcPath := filepath.Join(srcDir, script)
-			containerFile := mod + "_" + script
-			want = append(want, scriptDesired{
-				mod:           mod,
-				file:          script,
-				containerFile: containerFile,
-				srcPath:       srcPath,
-				checksum:      fileChecksum(srcPath),
-			})
-		}
-	}
-
-	// Index current tracker state by container filename
-	applied := make(map[string]AppliedScript)
-	for _, s := range tracker.Scripts {
-		applied[s.ContainerFile] = s
-	}
-
-	// Determine what to add/update and what to remove
-	var toSync []scriptDesired
-	wantSet := make(map[string]bool)
-
-	for _, w := range want {
-		wantSet[w.containerFile] = true
-		existing, exists := applied[w.containerFile]
-		if !exists || existing.Checksum != w.checksum {
-			toSync = append(toSync, w)
-		}
-	}
-
-	var toRemove []AppliedScript
-	for _, s := range tracker.Scripts {
-		if !wantSet[s.ContainerFile] {
-			toRemove = append(toRemove, s)
-		}
-	}
+	want, toSync, toRemove := diffScripts(cfg, tracker)
 
 	if len(toSync) == 0 && len(toRemove) == 0 {
 		// Even if no script files changed, ensure the loader exists
@@ -792,6 +748,53 @@ func syncScriptsToContainer(cfg *Config) (changed bool, err error) {
 	return true, nil
 }
 
+// diffScripts compares every mod's scripts against the tracker. It returns
+// the desired state, the files to copy into the container (new or changed)
+// and the synced files to remove.
+func diffScripts(cfg *Config, tracker *ScriptTracker) (want, toSync []scriptDesired, toRemove []AppliedScript) {
+	// Build the desired state: all scripts from all mods
+	mods := getAllMods(cfg)
+	for _, mod := range mods {
+		scripts := findModScripts(cfg, mod)
+		srcDir := filepath.Join(cfg.ModDir(mod), "scripts")
+		for _, script := range scripts {
+			srcPath := filepath.Join(srcDir, script)
+			containerFile := mod + "_" + script
+			want = append(want, scriptDesired{
+				mod:           mod,
+				file:          script,
+				containerFile: containerFile,
+				srcPath:       srcPath,
+				checksum:      fileChecksum(srcPath),
+			})
+		}
+	}
+
+	// Index current tracker state by container filename
+	applied := make(map[string]AppliedScript)
+	for _, s := range tracker.Scripts {
+		applied[s.ContainerFile] = s
+	}
+
+	// Determine what to add/update and what to remove
+	wantSet := make(map[string]bool)
+
+	for _, w := range want {
+		wantSet[w.containerFile] = true
+		existing, exists := applied[w.containerFile]
+		if !exists || existing.Checksum != w.checksum {
+			toSync = append(toSync, w)
+		}
+	}
+
+	for _, s := range tracker.Scripts {
+		if !wantSet[s.ContainerFile] {
+			toRemove = append(toRemove, s)
+		}
+	}
+	return want, toSync, toRemove
+}
+
 // generateCustomScriptLoader creates a custom_script_loader.cpp inside the
 // container that declares and calls all AddSC_* functions from the synced scripts.
 // This is required by TrinityCore's build system — it calls AddCustomScripts()
@@ -9,6 +9,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
 )
 
 func runModSQL(subcmd string, args []string) error {
@@ -69,11 +71,11 @@ func runModSQLRemove(args []string) error {
 			fmt.Printf("Migration '%s' is currently applied to '%s'.\n", found.filename, found.database)
 			if promptYesNo("Run the rollback script to undo changes?") {
 				fmt.Printf("Rolling back %s/%s → %s...\n", found.mod, found.filename, found.database)
-				sqlContent, err := os.ReadFile(rollbackPath)
+				sqlContent, err := readMigrationSQL(cfg, found.mod, found.database, rollbackPath)
 				if err != nil {
 					return fmt.Errorf("read rollback file: %w", err)
 				}
-				if err := runSQL(cfg, found.database, string(sqlContent)); err != nil {
+				if err := runSQL(cfg, found.database, sqlContent); err != nil {
 					return fmt.Errorf("execute rollback: %w", err)
 				}
 				fmt.Printf("  ✓ Rolled back %s\n", found.filename)
@@ -302,32 +304,13 @@ func runModSQLCreate(args []string) error {
 		return fmt.Errorf("mod not found: %s (run 'mithril mod create %s' first)", modName, modName)
 	}
 
-	// Find next migration number
-	existing := findMigrations(cfg, modName)
-	nextNum := 1
-	for _, m := range existing {
-		if m.database == database {
-			// Extract number from filename
-			parts := strings.SplitN(m.filename, "_", 2)
-			if len(parts) >= 1 {
-				var n int
-				if _, err := fmt.Sscanf(parts[0], "%d", &n); err == nil && n >= nextNum {
-					nextNum = n + 1
-				}
-			}
-		}
-	}
-
 	// Create the SQL file
 	sqlDir := filepath.Join(cfg.ModDir(modName), "sql", database)
 	if err := os.MkdirAll(sqlDir, 0755); err != nil {
 		return fmt.Errorf("create sql directory: %w", err)
 	}
 
-	// Sanitize name for filename
-	safeName := strings.ReplaceAll(strings.ToLower(name), " ", "_")
-	forwardFilename := fmt.Sprintf("%03d_%s.sql", nextNum, safeName)
-	rollbackFilename := fmt.Sprintf("%03d_%s.rollback.sql", nextNum, safeName)
+	forwardFilename, rollbackFilename := nextMigrationFilenames(cfg, modName, database, name)
 	forwardPath := filepath.Join(sqlDir, forwardFilename)
 	rollbackPath := filepath.Join(sqlDir, rollbackFilename)
 
@@ -364,6 +347,28 @@ func runModSQLCreate(args []string) error {
 	return nil
 }
 
+// nextMigrationFilenames returns the forward and rollback filenames for a new
+// migration in a mod's sql/<database>/ directory (e.g., 003_my_change.sql).
+func nextMigrationFilenames(cfg *Config, modName, database, name string) (string, string) {
+	nextNum := 1
+	for _, m := range findMigrations(cfg, modName) {
+		if m.database == database {
+			// Extract number from filename
+			parts := strings.SplitN(m.filename, "_", 2)
+			if len(parts) >= 1 {
+				var n int
+				if _, err := fmt.Sscanf(parts[0], "%d", &n); err == nil && n >= nextNum {
+					nextNum = n + 1
+				}
+			}
+		}
+	}
+
+	// Sanitize name for filename
+	safeName := strings.ReplaceAll(strings.ToLower(name), " ", "_")
+	return fmt.Sprintf("%03d_%s.sql", nextNum, safeName), fmt.Sprintf("%03d_%s.rollback.sql", nextNum, safeName)
+}
+
 func runModSQLList(args []string) error {
 	modName, _ := parseModFlag(args)
 	cfg := DefaultConfig()
@@ -504,11 +509,11 @@ func runModSQLRollback(args []string) error {
 
 		// Run rollback
 		fmt.Printf("Rolling back %s/%s → %s...\n", target.mod, target.filename, target.database)
-		sqlContent, err := os.ReadFile(rollbackPath)
+		sqlContent, err := readMigrationSQL(cfg, target.mod, target.database, rollbackPath)
 		if err != nil {
 			return fmt.Errorf("read rollback file: %w", err)
 		}
-		if err := runSQL(cfg, target.database, string(sqlContent)); err != nil {
+		if err := runSQL(cfg, target.database, sqlContent); err != nil {
 			return fmt.Errorf("execute rollback of %s: %w", target.filename, err)
 		}
 
@@ -527,12 +532,12 @@ func runModSQLRollback(args []string) error {
 		for i := len(targets) - 1; i >= 0; i-- {
 			target := targets[i]
 			fmt.Printf("Re-applying %s/%s → %s...\n", target.mod, target.filename, target.database)
-			sqlContent, err := os.ReadFile(target.path)
+			sqlContent, err := readMigrationSQL(cfg, target.mod, target.database, target.path)
 			if err != nil {
 				return fmt.Errorf("read migration file: %w", err)
 			}
 
-			if err := runSQL(cfg, target.database, string(sqlContent)); err != nil {
+			if err := runSQL(cfg, target.database, sqlContent); err != nil {
 				return fmt.Errorf("re-apply migration %s: %w", target.filename, err)
 			}
 
@@ -620,13 +625,13 @@ func runModSQLApply(args []string) error {
 
 			fmt.Printf("Applying %s/%s → %s...\n", m.mod, m.filename, m.database)
 
-			sqlContent, err := os.ReadFile(m.path)
+			sqlContent, err := readMigrationSQL(cfg, m.mod, m.database, m.path)
 			if err != nil {
 				fmt.Printf("  ⚠ Failed to read %s: %v\n", m.filename, err)
 				continue
 			}
 
-			if err := runSQL(cfg, m.database, string(sqlContent)); err != nil {
+			if err := runSQL(cfg, m.database, sqlContent); err != nil {
 				fmt.Printf("  ⚠ Failed to apply %s: %v\n", m.filename, err)
 				return fmt.Errorf("migration failed — stopping to prevent out-of-order execution")
 			}
@@ -664,6 +669,27 @@ func runModSQLApply(args []string) error {
 	return nil
 }
 
+// readMigrationSQL reads a migration (or rollback) file of a mod, ready to run:
+// the mod's reserved ID ranges are defined as user variables, and in dbc
+// migrations enum and flag names from the DBC schemas become numbers.
+func readMigrationSQL(cfg *Config, mod, database, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	script := string(data)
+	if database == "dbc" {
+		metas, err := dbc.AllMetas()
+		if err != nil {
+			return "", fmt.Errorf("get meta files: %w", err)
+		}
+		if script, err = dbc.ResolveSymbols(script, metas); err != nil {
+			return "", fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+	}
+	return reservationPreamble(cfg, mod) + script, nil
+}
+
 // runSQL executes a SQL string against the specified database.
 // DBC database uses the native MySQL driver; server databases use docker exec.
 func runSQL(cfg *Config, database, sqlStr string) error {
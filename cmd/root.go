@@ -24,6 +24,8 @@ Commands:
   server account create <user> <pass> [gm_level]
                    Create a game account (gm_level: 0-3, default 3)
   client start     Launch the WoW 3.3.5a client (via Wine on Linux/macOS)
+  lookup <text>    Search the strings of every baseline and mod-built DBC (no MySQL)
+  version          Print the mithril version
 
   mod init         Extract baseline DBCs from client MPQs
   mod create       Create a new named mod
@@ -31,11 +33,27 @@ Commands:
   mod list         List all mods
   mod status       Show which DBCs have been modified
   mod build        Build combined patch MPQ from all mods
+  mod build rollback Restore the previous build deployment
   mod dbc create   Create a DBC SQL migration
   mod dbc remove   Remove a DBC SQL migration
   mod dbc import   Import baseline DBCs into MySQL for SQL editing
   mod dbc query    Run ad-hoc SQL against the DBC database
+  mod dbc shell    Interactive SQL prompt for the DBC database
+  mod dbc show     Print one DBC record with enum and flag names
   mod dbc export   Export modified DBC tables back to .dbc files
+  mod dbc dump     Dump a baseline DBC table as CSV or JSON
+  mod dbc load     Build a .dbc file from a CSV or JSON file
+  mod dbc compile  Print the SQL a mod's YAML DBC edits compile to
+  mod dbc diff     Show record-level DBC changes against the baseline
+  mod dbc capture  Turn ad-hoc DBC edits into a SQL migration pair
+  mod dbc infer    Draft a schema for a DBC without an embedded meta
+  mod dbc coverage Check baseline DBCs against their metas
+  mod dbc validate Check built DBCs for dangling cross-table references
+  mod dbc verify-roundtrip
+                   Check that untouched baseline DBCs re-export byte-for-byte
+  mod dbc reserve  Reserve a range of new DBC IDs for a mod
+  mod dbc l10n     Export Loc strings to PO/XLIFF and import translations
+  mod spell clone  Copy a spell into a new ID as a DBC migration pair
   mod addon create Copy a baseline addon file into a mod for editing
   mod addon remove Remove an addon file override from a mod
   mod addon list   List all baseline addon files
@@ -91,6 +109,10 @@ func Execute(args []string) error {
 		return runClient(args[1], args[2:])
 	case "mod":
 		return runMod(args[1:])
+	case "lookup":
+		return runLookup(args[1:])
+	case "version", "--version":
+		return runVersion()
 	case "-h", "--help", "help":
 		fmt.Print(usage)
 		return nil
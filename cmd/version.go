@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// Version is the mithril release, set at build time with
+//
+//	go build -ldflags "-X github.com/suprsokr/mithril/cmd.Version=v1.2.0"
+//
+// Builds without it report "dev".
+var Version = "dev"
+
+// toolVersion identifies the mithril binary for build caches: the release,
+// plus the VCS revision Go stamped into a dev build, so rebuilding the tool
+// from other sources invalidates what an older binary produced.
+func toolVersion() string {
+	if Version != "dev" {
+		return Version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return Version
+	}
+	v := Version
+	for _, s := range info.Settings {
+		switch {
+		case s.Key == "vcs.revision":
+			v += "+" + s.Value
+		case s.Key == "vcs.modified" && s.Value == "true":
+			v += "-dirty"
+		}
+	}
+	return v
+}
+
+func runVersion() error {
+	fmt.Printf("mithril %s\n", toolVersion())
+	return nil
+}
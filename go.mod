@@ -5,6 +5,11 @@ go 1.22.0
 require (
 	github.com/go-sql-driver/mysql v1.9.3
 	github.com/suprsokr/go-mpq v0.3.1
+	golang.org/x/term v0.29.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
-require filippo.io/edwards25519 v1.1.0 // indirect
+require (
+	filippo.io/edwards25519 v1.1.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
+)
@@ -44,23 +44,22 @@ func OpenDB(c DBConfig) (*sql.DB, error) {
 
 // EnsureDatabase creates the dbc database if it doesn't exist, using root credentials.
 func EnsureDatabase(rootCfg DBConfig, dbcUser string) error {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/?parseTime=true&allowNativePasswords=true",
-		rootCfg.User, rootCfg.Password, rootCfg.Host, rootCfg.Port)
+	return EnsureSchema(rootCfg, "dbc", dbcUser)
+}
 
-	db, err := sql.Open("mysql", dsn)
+// EnsureSchema creates a schema if it doesn't exist and grants dbcUser full
+// access to it, using root credentials.
+func EnsureSchema(rootCfg DBConfig, name, dbcUser string) error {
+	db, err := openRootDB(rootCfg)
 	if err != nil {
-		return fmt.Errorf("open root connection: %w", err)
+		return err
 	}
 	defer db.Close()
 
-	if err := db.Ping(); err != nil {
-		return fmt.Errorf("ping root connection: %w", err)
-	}
-
 	stmts := []string{
-		"CREATE DATABASE IF NOT EXISTS dbc DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci",
-		fmt.Sprintf("GRANT ALL PRIVILEGES ON dbc.* TO '%s'@'%%'", dbcUser),
-		fmt.Sprintf("GRANT ALL PRIVILEGES ON dbc.* TO '%s'@'localhost'", dbcUser),
+		fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s` DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci", name),
+		fmt.Sprintf("GRANT ALL PRIVILEGES ON `%s`.* TO '%s'@'%%'", name, dbcUser),
+		fmt.Sprintf("GRANT ALL PRIVILEGES ON `%s`.* TO '%s'@'localhost'", name, dbcUser),
 		"FLUSH PRIVILEGES",
 	}
 
@@ -73,3 +72,34 @@ func EnsureDatabase(rootCfg DBConfig, dbcUser string) error {
 
 	return nil
 }
+
+// DropSchema drops a schema if it exists, using root credentials.
+func DropSchema(rootCfg DBConfig, name string) error {
+	db, err := openRootDB(rootCfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(fmt.Sprintf("DROP DATABASE IF EXISTS `%s`", name)); err != nil {
+		return fmt.Errorf("drop schema %s: %w", name, err)
+	}
+	return nil
+}
+
+// openRootDB opens a connection without a default schema.
+func openRootDB(rootCfg DBConfig) (*sql.DB, error) {
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/?parseTime=true&allowNativePasswords=true",
+		rootCfg.User, rootCfg.Password, rootCfg.Host, rootCfg.Port)
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open root connection: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ping root connection: %w", err)
+	}
+	return db, nil
+}
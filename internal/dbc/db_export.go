@@ -12,22 +12,19 @@ import (
 
 // ExportModifiedDBCs exports all DBC tables that have changed since import.
 // Uses CHECKSUM TABLE to detect changes. Returns the list of exported table names.
-func ExportModifiedDBCs(db *sql.DB, metaFiles []string, baselineDir, exportDir string) ([]string, error) {
+// If baselineDir is set, each table is re-encoded against its baseline file so
+// unchanged records keep their original bytes (see PreserveBaseline).
+func ExportModifiedDBCs(db *sql.DB, metas []*MetaFile, baselineDir, exportDir string) ([]string, error) {
 	if err := os.MkdirAll(exportDir, 0755); err != nil {
 		return nil, fmt.Errorf("create export dir: %w", err)
 	}
 
 	var exported []string
-	for _, metaFile := range metaFiles {
-		meta, err := LoadEmbeddedMeta(metaFile)
-		if err != nil {
-			continue
-		}
-
+	for _, meta := range metas {
 		tableName := TableName(meta)
 
 		// Check if table exists
-		if !tableExistsCheck(db, tableName) {
+		if !TableExists(db, tableName) {
 			continue
 		}
 
@@ -51,6 +48,19 @@ func ExportModifiedDBCs(db *sql.DB, metaFiles []string, baselineDir, exportDir s
 			continue
 		}
 
+		if baselineDir != "" {
+			if baselinePath := FindDBCFile(baselineDir, meta.File); baselinePath != "" {
+				baseline, err := os.ReadFile(baselinePath)
+				if err == nil {
+					dbcFile, err = PreserveBaseline(dbcFile, meta, baseline)
+				}
+				if err != nil {
+					fmt.Printf("    ⚠ Failed to preserve baseline bytes for %s: %v\n", tableName, err)
+					continue
+				}
+			}
+		}
+
 		outPath := filepath.Join(exportDir, meta.File)
 		if err := WriteDBC(dbcFile, meta, outPath); err != nil {
 			fmt.Printf("    ⚠ Failed to write %s: %v\n", meta.File, err)
@@ -83,11 +93,10 @@ func ExportTable(db *sql.DB, meta *MetaFile) (*DBCFile, error) {
 	}
 
 	dbcFile := &DBCFile{
-		Header:      DBCHeader{Magic: [4]byte{'W', 'D', 'B', 'C'}},
-		Records:     []Record{},
-		StringBlock: []byte{0}, // first byte must be null
+		Header:  DBCHeader{Magic: [4]byte{'W', 'D', 'B', 'C'}},
+		Records: []Record{},
 	}
-	stringOffsets := map[string]uint32{"": 0}
+	sb := NewStringBlock()
 
 	for rows.Next() {
 		raw := make([]interface{}, len(cols))
@@ -123,13 +132,13 @@ func ExportTable(db *sql.DB, meta *MetaFile) (*DBCFile, error) {
 					rec[name] = toFloat32(raw, cols, name)
 				case "string":
 					str := toString(raw, cols, name)
-					rec[name] = getStringOffset(str, &dbcFile.StringBlock, stringOffsets)
+					rec[name] = sb.Add(str)
 				case "Loc":
 					loc := make([]uint32, 17)
 					for i := 0; i < 16; i++ {
 						colName := fmt.Sprintf("%s_%s", name, strings.ToLower(LocLangs[i]))
 						str := toString(raw, cols, colName)
-						loc[i] = getStringOffset(str, &dbcFile.StringBlock, stringOffsets)
+						loc[i] = sb.Add(str)
 					}
 					loc[16] = toUint32(raw, cols, fmt.Sprintf("%s_%s", name, strings.ToLower(LocLangs[16])))
 					rec[name] = loc
@@ -143,6 +152,7 @@ func ExportTable(db *sql.DB, meta *MetaFile) (*DBCFile, error) {
 		return nil, fmt.Errorf("iterate rows for %s: %w", tableName, err)
 	}
 
+	dbcFile.StringBlock = sb.Bytes()
 	dbcFile.Header.RecordCount = uint32(len(dbcFile.Records))
 	dbcFile.Header.FieldCount = calculateFieldCount(meta)
 	dbcFile.Header.RecordSize = calculateRecordSize(meta)
@@ -168,18 +178,8 @@ func buildOrderBy(sort []SortField) string {
 	return " ORDER BY " + strings.Join(parts, ", ")
 }
 
-func getStringOffset(s string, block *[]byte, offsets map[string]uint32) uint32 {
-	if off, ok := offsets[s]; ok {
-		return off
-	}
-	off := uint32(len(*block))
-	*block = append(*block, []byte(s)...)
-	*block = append(*block, 0)
-	offsets[s] = off
-	return off
-}
-
-func tableExistsCheck(db *sql.DB, tableName string) bool {
+// TableExists reports whether a table exists in the connected database.
+func TableExists(db *sql.DB, tableName string) bool {
 	var exists string
 	err := db.QueryRow(
 		"SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?",
@@ -193,6 +193,11 @@ func FieldCount(meta *MetaFile) uint32 {
 	return calculateFieldCount(meta)
 }
 
+// RecordSize returns the byte size of one record for a meta (exported for use by cmd layer).
+func RecordSize(meta *MetaFile) uint32 {
+	return calculateRecordSize(meta)
+}
+
 // calculateFieldCount counts the number of individual fields in the DBC header.
 func calculateFieldCount(meta *MetaFile) uint32 {
 	count := uint32(0)
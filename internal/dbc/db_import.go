@@ -1,89 +1,154 @@
 package dbc
 
 import (
+	"bytes"
 	"database/sql"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
 )
 
+// DefaultImportJobs is how many tables ImportAllDBCs imports at once by default.
+const DefaultImportJobs = 4
+
 // ImportAllDBCs imports all baseline DBC files that have known schemas into MySQL.
-func ImportAllDBCs(db *sql.DB, dbcDir string, force bool) (int, int, error) {
-	metaFiles, err := GetEmbeddedMetaFiles()
+// Up to jobs tables are imported concurrently (DefaultImportJobs if jobs < 1),
+// largest files first, with a progress line printed as each one finishes.
+func ImportAllDBCs(db *sql.DB, dbcDir string, force bool, jobs int) (int, int, error) {
+	metas, err := AllMetas()
 	if err != nil {
-		return 0, 0, fmt.Errorf("get embedded meta files: %w", err)
+		return 0, 0, fmt.Errorf("get meta files: %w", err)
+	}
+	if err := ensureChecksumTable(db); err != nil {
+		return 0, 0, fmt.Errorf("ensure checksum table: %w", err)
 	}
 
-	imported := 0
+	type importJob struct {
+		meta *MetaFile
+		path string
+		size int64
+	}
+	var queue []importJob
 	skipped := 0
-	for _, metaFile := range metaFiles {
-		meta, err := LoadEmbeddedMeta(metaFile)
-		if err != nil {
+	for _, meta := range metas {
+		dbcPath := FindDBCFile(dbcDir, meta.File)
+		if dbcPath == "" && !IsCustomMeta(meta) {
 			skipped++
 			continue
 		}
-
-		dbcPath := findDBCFile(dbcDir, meta.File)
-		if dbcPath == "" {
-			skipped++
-			continue
-		}
-
-		didImport, err := ImportDBC(db, dbcPath, meta, force)
-		if err != nil {
-			fmt.Printf("  ⚠ %s: %v\n", meta.File, err)
-			skipped++
-			continue
-		}
-
-		if didImport {
-			imported++
-		} else {
-			skipped++
+		job := importJob{meta: meta, path: dbcPath}
+		if info, err := os.Stat(dbcPath); err == nil {
+			job.size = info.Size()
 		}
+		queue = append(queue, job)
+	}
+	// Big tables (Spell.dbc) dominate the total, so start them first
+	sort.SliceStable(queue, func(i, j int) bool { return queue[i].size > queue[j].size })
+
+	if jobs < 1 {
+		jobs = DefaultImportJobs
+	}
+	var mu sync.Mutex
+	imported, done := 0, 0
+	work := make(chan importJob)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range work {
+				var out bytes.Buffer
+				start := time.Now()
+				records, didImport, err := importTable(db, job.path, job.meta, force, &out)
+
+				mu.Lock()
+				done++
+				os.Stdout.Write(out.Bytes())
+				switch {
+				case err != nil:
+					fmt.Printf("  ⚠ %s: %v\n", job.meta.File, err)
+					skipped++
+				case didImport:
+					imported++
+					fmt.Printf("  ✓ %-30s → %-28s %7d records %6.1fs  [%d/%d]\n",
+						job.meta.File, TableName(job.meta), records, time.Since(start).Seconds(), done, len(queue))
+				default:
+					skipped++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, job := range queue {
+		work <- job
 	}
+	close(work)
+	wg.Wait()
 
 	return imported, skipped, nil
 }
 
 // ImportDBC imports a single DBC file into the MySQL dbc database.
+// An empty dbcPath creates an empty table (custom DBCs with no baseline file).
 // Returns true if the table was imported, false if skipped.
 func ImportDBC(db *sql.DB, dbcPath string, meta *MetaFile, force bool) (bool, error) {
 	if err := ensureChecksumTable(db); err != nil {
 		return false, fmt.Errorf("ensure checksum table: %w", err)
 	}
 
+	records, didImport, err := importTable(db, dbcPath, meta, force, os.Stdout)
+	if err != nil {
+		return false, err
+	}
+	if didImport {
+		fmt.Printf("  Importing %-30s → %s ... ✓ (%d records)\n", meta.File, TableName(meta), records)
+	}
+	return didImport, nil
+}
+
+// importTable creates and fills the table for one DBC unless it already
+// exists (or force is set). Warnings are written to out. Returns the number
+// of records imported and whether the table was imported at all.
+func importTable(db *sql.DB, dbcPath string, meta *MetaFile, force bool, out io.Writer) (int, bool, error) {
 	tableName := TableName(meta)
 
 	if err := ensureChecksumEntry(db, tableName); err != nil {
-		return false, fmt.Errorf("ensure checksum entry for %s: %w", tableName, err)
+		return 0, false, fmt.Errorf("ensure checksum entry for %s: %w", tableName, err)
 	}
 
 	if tableExists(db, force, tableName) {
-		return false, nil
+		return 0, false, nil
 	}
 
-	fmt.Printf("  Importing %-30s → %s ... ", meta.File, tableName)
-
-	dbcFile, err := LoadDBC(dbcPath, *meta)
-	if err != nil {
-		fmt.Println("⚠")
-		return false, fmt.Errorf("load DBC %s: %w", dbcPath, err)
+	var dbcFile DBCFile
+	if dbcPath != "" {
+		var err error
+		dbcFile, err = LoadDBC(dbcPath, *meta)
+		if err != nil {
+			return 0, false, fmt.Errorf("load DBC %s: %w", dbcPath, err)
+		}
 	}
 
-	checkUniqueKeys(dbcFile.Records, meta, tableName)
+	checkUniqueKeys(out, dbcFile.Records, meta, tableName)
 
 	if err := createTable(db, tableName, meta); err != nil {
-		fmt.Println("⚠")
-		return false, fmt.Errorf("create table %s: %w", tableName, err)
+		return 0, false, fmt.Errorf("create table %s: %w", tableName, err)
 	}
 
 	if err := insertRecords(db, tableName, &dbcFile, meta); err != nil {
-		fmt.Println("⚠")
-		return false, fmt.Errorf("insert records for %s: %w", tableName, err)
+		return 0, false, fmt.Errorf("insert records for %s: %w", tableName, err)
 	}
 
 	// Store the baseline checksum so exports can detect changes.
@@ -93,8 +158,7 @@ func ImportDBC(db *sql.DB, dbcPath string, meta *MetaFile, force bool) (bool, er
 		UpdateChecksum(db, tableName, cs)
 	}
 
-	fmt.Printf("✓ (%d records)\n", len(dbcFile.Records))
-	return true, nil
+	return len(dbcFile.Records), true, nil
 }
 
 // --- Table management ---
@@ -204,20 +268,92 @@ func createTable(db *sql.DB, tableName string, meta *MetaFile) error {
 
 // --- Record insertion ---
 
+// localInfileDisabled is set once the server refuses LOAD DATA LOCAL INFILE,
+// so the remaining tables go straight to batched INSERTs.
+var localInfileDisabled atomic.Bool
+
+// insertRecords fills a freshly created table. Records are streamed as TSV
+// with LOAD DATA LOCAL INFILE when the server allows it (local_infile=ON),
+// and inserted in multi-row batches otherwise.
 func insertRecords(db *sql.DB, tableName string, dbcFile *DBCFile, meta *MetaFile) error {
-	total := len(dbcFile.Records)
-	if total == 0 {
+	if len(dbcFile.Records) == 0 {
 		return nil
 	}
+	if !localInfileDisabled.Load() {
+		err := loadRecords(db, tableName, dbcFile, meta)
+		if err == nil {
+			return nil
+		}
+		var myErr *mysql.MySQLError
+		if errors.As(err, &myErr) && (myErr.Number == 1148 || myErr.Number == 3948 || myErr.Number == 3950) {
+			localInfileDisabled.Store(true)
+		}
+	}
+	return insertRecordBatches(db, tableName, dbcFile, meta)
+}
 
-	tx, err := db.Begin()
-	if err != nil {
-		return err
+// loadRecords bulk-loads records through LOAD DATA LOCAL INFILE.
+func loadRecords(db *sql.DB, tableName string, dbcFile *DBCFile, meta *MetaFile) error {
+	var tsv bytes.Buffer
+	for _, rec := range dbcFile.Records {
+		for i, v := range recordValues(rec, dbcFile, meta) {
+			if i > 0 {
+				tsv.WriteByte('\t')
+			}
+			writeTSVValue(&tsv, v)
+		}
+		tsv.WriteByte('\n')
 	}
-	defer tx.Rollback()
 
-	// Build column list
-	var columnsBase []string
+	handler := "dbc_import_" + tableName
+	mysql.RegisterReaderHandler(handler, func() io.Reader { return &tsv })
+	defer mysql.DeregisterReaderHandler(handler)
+
+	// REPLACE keeps the last of any duplicate keys, like the batched INSERT's
+	// ON DUPLICATE KEY UPDATE.
+	query := fmt.Sprintf("LOAD DATA LOCAL INFILE 'Reader::%s' REPLACE INTO TABLE `%s` CHARACTER SET utf8mb4 (%s)",
+		handler, tableName, strings.Join(insertColumns(meta), ", "))
+	_, err := db.Exec(query)
+	return err
+}
+
+// writeTSVValue writes one value in LOAD DATA's default text format.
+func writeTSVValue(buf *bytes.Buffer, v interface{}) {
+	switch val := v.(type) {
+	case nil:
+		buf.WriteString("\\N")
+	case string:
+		for i := 0; i < len(val); i++ {
+			switch c := val[i]; c {
+			case '\\':
+				buf.WriteString("\\\\")
+			case '\t':
+				buf.WriteString("\\t")
+			case '\n':
+				buf.WriteString("\\n")
+			case '\r':
+				buf.WriteString("\\r")
+			case 0:
+				buf.WriteString("\\0")
+			default:
+				buf.WriteByte(c)
+			}
+		}
+	case float32:
+		// float64 digits, as a float32 INSERT parameter is sent
+		f := float64(val)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			f = 0
+		}
+		buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	default:
+		fmt.Fprint(buf, val)
+	}
+}
+
+// insertColumns returns the quoted column list for a meta, in record order.
+func insertColumns(meta *MetaFile) []string {
+	var columns []string
 	for _, field := range meta.Fields {
 		repeat := int(field.Count)
 		if repeat == 0 {
@@ -230,14 +366,66 @@ func insertRecords(db *sql.DB, tableName string, dbcFile *DBCFile, meta *MetaFil
 			}
 			switch field.Type {
 			case "int32", "uint32", "uint8", "float", "string":
-				columnsBase = append(columnsBase, fmt.Sprintf("`%s`", colName))
+				columns = append(columns, fmt.Sprintf("`%s`", colName))
 			case "Loc":
 				for _, lang := range LocLangs {
-					columnsBase = append(columnsBase, fmt.Sprintf("`%s_%s`", colName, strings.ToLower(lang)))
+					columns = append(columns, fmt.Sprintf("`%s_%s`", colName, strings.ToLower(lang)))
 				}
 			}
 		}
 	}
+	return columns
+}
+
+// recordValues returns a record's column values in insertColumns order, with
+// string offsets resolved.
+func recordValues(rec Record, dbcFile *DBCFile, meta *MetaFile) []interface{} {
+	var values []interface{}
+	for _, field := range meta.Fields {
+		repeat := int(field.Count)
+		if repeat == 0 {
+			repeat = 1
+		}
+		for j := 0; j < repeat; j++ {
+			name := field.Name
+			if field.Count > 1 {
+				name = fmt.Sprintf("%s_%d", field.Name, j+1)
+			}
+			switch field.Type {
+			case "int32", "uint32", "uint8", "float":
+				values = append(values, rec[name])
+			case "string":
+				offset := rec[name].(uint32)
+				values = append(values, ReadString(dbcFile.StringBlock, offset))
+			case "Loc":
+				locArr := rec[name].([]uint32)
+				numTexts := len(locArr) - 1
+				for i := range LocLangs {
+					if i < numTexts {
+						values = append(values, ReadString(dbcFile.StringBlock, locArr[i]))
+					} else if i == numTexts {
+						values = append(values, locArr[numTexts]) // flags
+					} else {
+						values = append(values, nil)
+					}
+				}
+			}
+		}
+	}
+	return values
+}
+
+// insertRecordBatches inserts records with multi-row INSERT statements in one transaction.
+func insertRecordBatches(db *sql.DB, tableName string, dbcFile *DBCFile, meta *MetaFile) error {
+	total := len(dbcFile.Records)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	columnsBase := insertColumns(meta)
 
 	// Batch size: stay under MySQL's 65535 placeholder limit
 	colsPerRow := len(columnsBase)
@@ -247,6 +435,7 @@ func insertRecords(db *sql.DB, tableName string, dbcFile *DBCFile, meta *MetaFil
 		batchSize = 2000
 	}
 
+	rowPlaceholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", colsPerRow), ", ") + ")"
 	for start := 0; start < total; start += batchSize {
 		end := start + batchSize
 		if end > total {
@@ -254,46 +443,11 @@ func insertRecords(db *sql.DB, tableName string, dbcFile *DBCFile, meta *MetaFil
 		}
 		records := dbcFile.Records[start:end]
 
-		var allPlaceholders []string
-		var allValues []interface{}
-
+		allPlaceholders := make([]string, 0, len(records))
+		allValues := make([]interface{}, 0, len(records)*colsPerRow)
 		for _, rec := range records {
-			var rowPlaceholders []string
-			for _, field := range meta.Fields {
-				repeat := int(field.Count)
-				if repeat == 0 {
-					repeat = 1
-				}
-				for j := 0; j < repeat; j++ {
-					name := field.Name
-					if field.Count > 1 {
-						name = fmt.Sprintf("%s_%d", field.Name, j+1)
-					}
-					switch field.Type {
-					case "int32", "uint32", "uint8", "float":
-						rowPlaceholders = append(rowPlaceholders, "?")
-						allValues = append(allValues, rec[name])
-					case "string":
-						rowPlaceholders = append(rowPlaceholders, "?")
-						offset := rec[name].(uint32)
-						allValues = append(allValues, ReadString(dbcFile.StringBlock, offset))
-					case "Loc":
-						locArr := rec[name].([]uint32)
-						numTexts := len(locArr) - 1
-						for i := range LocLangs {
-							rowPlaceholders = append(rowPlaceholders, "?")
-							if i < numTexts {
-								allValues = append(allValues, ReadString(dbcFile.StringBlock, locArr[i]))
-							} else if i == numTexts {
-								allValues = append(allValues, locArr[numTexts]) // flags
-							} else {
-								allValues = append(allValues, nil)
-							}
-						}
-					}
-				}
-			}
-			allPlaceholders = append(allPlaceholders, "("+strings.Join(rowPlaceholders, ", ")+")")
+			allPlaceholders = append(allPlaceholders, rowPlaceholders)
+			allValues = append(allValues, recordValues(rec, dbcFile, meta)...)
 		}
 
 		query := fmt.Sprintf(
@@ -384,7 +538,7 @@ func UpdateChecksum(db *sql.DB, tableName string, checksum uint64) error {
 
 // --- Unique key validation ---
 
-func checkUniqueKeys(records []Record, meta *MetaFile, tableName string) {
+func checkUniqueKeys(w io.Writer, records []Record, meta *MetaFile, tableName string) {
 	for i, uk := range meta.UniqueKeys {
 		if len(uk) == 0 {
 			continue
@@ -404,7 +558,7 @@ func checkUniqueKeys(records []Record, meta *MetaFile, tableName string) {
 		}
 		for _, indices := range seen {
 			if len(indices) > 1 {
-				fmt.Printf("\n  Warning: duplicate records in '%s' for unique key #%d (%v):\n", tableName, i, uk)
+				fmt.Fprintf(w, "  Warning: duplicate records in '%s' for unique key #%d (%v):\n", tableName, i, uk)
 				for _, idx := range indices {
 					rec := records[idx]
 					keys := make([]string, 0, len(rec))
@@ -412,14 +566,14 @@ func checkUniqueKeys(records []Record, meta *MetaFile, tableName string) {
 						keys = append(keys, k)
 					}
 					sort.Strings(keys)
-					fmt.Printf("    Record %d: {", idx)
+					fmt.Fprintf(w, "    Record %d: {", idx)
 					for ki, k := range keys {
 						if ki > 0 {
-							fmt.Print(", ")
+							fmt.Fprint(w, ", ")
 						}
-						fmt.Printf("%s: %v", k, rec[k])
+						fmt.Fprintf(w, "%s: %v", k, rec[k])
 					}
-					fmt.Println("}")
+					fmt.Fprintln(w, "}")
 				}
 			}
 		}
@@ -428,8 +582,9 @@ func checkUniqueKeys(records []Record, meta *MetaFile, tableName string) {
 
 // --- File finding ---
 
-// findDBCFile searches for a DBC file case-insensitively.
-func findDBCFile(dir, filename string) string {
+// FindDBCFile searches dir for a DBC file case-insensitively.
+// Returns "" if it does not exist.
+func FindDBCFile(dir, filename string) string {
 	exact := filepath.Join(dir, filename)
 	if _, err := os.Stat(exact); err == nil {
 		return exact
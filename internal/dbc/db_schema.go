@@ -0,0 +1,48 @@
+package dbc
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// CloneSchema copies every table of schema from (structure, rows and the
+// dbc_checksum entries) into the schema db is connected to. Tables that
+// already exist in the target are replaced. Because the copies have the same
+// contents, CHECKSUM TABLE still matches the stored baseline checksums, so
+// ExportModifiedDBCs only picks up tables changed after the clone.
+func CloneSchema(db *sql.DB, from string) (int, error) {
+	rows, err := db.Query(
+		"SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE'",
+		from,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("list tables in %s: %w", from, err)
+	}
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		tables = append(tables, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, table := range tables {
+		stmts := []string{
+			fmt.Sprintf("DROP TABLE IF EXISTS `%s`", table),
+			fmt.Sprintf("CREATE TABLE `%s` LIKE `%s`.`%s`", table, from, table),
+			fmt.Sprintf("INSERT INTO `%s` SELECT * FROM `%s`.`%s`", table, from, table),
+		}
+		for _, stmt := range stmts {
+			if _, err := db.Exec(stmt); err != nil {
+				return 0, fmt.Errorf("clone %s.%s: %w", from, table, err)
+			}
+		}
+	}
+	return len(tables), nil
+}
@@ -0,0 +1,86 @@
+package dbc
+
+import "fmt"
+
+// BadString is a string column whose offset points outside the string block.
+type BadString struct {
+	Record int    // 0-based record index
+	Column string // SQL column name
+	Offset uint32
+}
+
+// SchemaCheck is the result of checking a DBC file against its meta.
+type SchemaCheck struct {
+	Header         DBCHeader
+	MetaRecordSize uint32
+	MetaFieldCount uint32
+	BadStrings     []BadString
+	Err            error // the file couldn't be read with the meta
+}
+
+// RecordSizeOK reports whether the header's record size matches the meta.
+func (c *SchemaCheck) RecordSizeOK() bool {
+	return c.Header.RecordSize == c.MetaRecordSize
+}
+
+// FieldCountOK reports whether the header's field count matches the meta.
+func (c *SchemaCheck) FieldCountOK() bool {
+	return c.Header.FieldCount == c.MetaFieldCount
+}
+
+// OK reports whether the file matches the meta with no suspicious strings.
+func (c *SchemaCheck) OK() bool {
+	return c.Err == nil && c.RecordSizeOK() && c.FieldCountOK() && len(c.BadStrings) == 0
+}
+
+// CheckSchema compares a DBC file's header with the record size and field
+// count its meta describes and, if the records parse, looks for string
+// offsets outside the string block — a sign that a column the meta calls a
+// string isn't one, or that the fields are shifted.
+func CheckSchema(data []byte, meta *MetaFile) *SchemaCheck {
+	c := &SchemaCheck{
+		MetaRecordSize: calculateRecordSize(meta),
+		MetaFieldCount: calculateFieldCount(meta),
+	}
+	if len(data) < 20 {
+		c.Err = fmt.Errorf("file too small to be a valid DBC (%d bytes)", len(data))
+		return c
+	}
+	header, err := ParseHeader(data[:20])
+	if err != nil {
+		c.Err = err
+		return c
+	}
+	c.Header = header
+	if !c.RecordSizeOK() {
+		return c
+	}
+
+	file, err := LoadDBCFromBytes(data, *meta)
+	if err != nil {
+		c.Err = err
+		return c
+	}
+	blockSize := uint32(len(file.StringBlock))
+	cols := Columns(meta)
+	for i, rec := range file.Records {
+		for _, col := range cols {
+			if col.Type != "string" {
+				continue
+			}
+			var off uint32
+			if col.Loc >= 0 {
+				loc, _ := rec[col.Field].([]uint32)
+				if col.Loc < len(loc) {
+					off = loc[col.Loc]
+				}
+			} else {
+				off, _ = rec[col.Field].(uint32)
+			}
+			if off >= blockSize && off != 0 {
+				c.BadStrings = append(c.BadStrings, BadString{Record: i, Column: col.Name, Offset: off})
+			}
+		}
+	}
+	return c
+}
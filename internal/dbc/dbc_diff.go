@@ -0,0 +1,120 @@
+package dbc
+
+import (
+	"sort"
+	"strings"
+)
+
+// FieldChange is a single column whose value differs between two records.
+type FieldChange struct {
+	Column string
+	Old    interface{}
+	New    interface{}
+}
+
+// RecordChange is a record present on both sides with at least one changed column.
+type RecordChange struct {
+	Key     string
+	Old     Row
+	New     Row
+	Changes []FieldChange
+}
+
+// TableDiff is the record-level difference between two versions of a table.
+// Records are matched by the meta's key (see KeyColumns).
+type TableDiff struct {
+	Columns []Column
+	KeyCols []int
+	Added   []Row
+	Removed []Row
+	Changed []RecordChange
+}
+
+// Empty reports whether the two versions are identical.
+func (d *TableDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// KeyString renders a row's primary key as "id=123" (or "a=1, b=2").
+func (d *TableDiff) KeyString(row Row) string {
+	parts := make([]string, len(d.KeyCols))
+	for i, k := range d.KeyCols {
+		parts[i] = d.Columns[k].Name + "=" + FormatValue(row[k])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// DiffRows compares base against current. Records with the same key pair up
+// by occurrence: the nth duplicate in current against the nth in base. Added
+// and removed rows are sorted by key, numerically where possible; changed
+// records follow the order of current.
+func DiffRows(base, current []Row, meta *MetaFile) (*TableDiff, error) {
+	keyCols, err := KeyColumns(meta)
+	if err != nil {
+		return nil, err
+	}
+	d := &TableDiff{
+		Columns: Columns(meta),
+		KeyCols: keyCols,
+	}
+
+	baseByKey := make(map[string]Row, len(base))
+	baseKeys := make([]string, len(base))
+	occurrences := make(map[string]int)
+	for i, row := range base {
+		baseKeys[i] = occurrenceKey(row, d.KeyCols, occurrences)
+		baseByKey[baseKeys[i]] = row
+	}
+	seen := make(map[string]bool, len(current))
+
+	occurrences = make(map[string]int)
+	for _, row := range current {
+		key := occurrenceKey(row, d.KeyCols, occurrences)
+		seen[key] = true
+		old, ok := baseByKey[key]
+		if !ok {
+			d.Added = append(d.Added, row)
+			continue
+		}
+		var changes []FieldChange
+		for i, col := range d.Columns {
+			if !ValuesEqual(col, old[i], row[i]) {
+				changes = append(changes, FieldChange{Column: col.Name, Old: old[i], New: row[i]})
+			}
+		}
+		if len(changes) > 0 {
+			d.Changed = append(d.Changed, RecordChange{Key: d.KeyString(row), Old: old, New: row, Changes: changes})
+		}
+	}
+
+	for i, row := range base {
+		if !seen[baseKeys[i]] {
+			d.Removed = append(d.Removed, row)
+		}
+	}
+
+	sortRowsByKey(d.Added, d.KeyCols)
+	sortRowsByKey(d.Removed, d.KeyCols)
+	return d, nil
+}
+
+// sortRowsByKey orders rows by their primary key, numerically where possible.
+func sortRowsByKey(rows []Row, keyCols []int) {
+	sort.SliceStable(rows, func(a, b int) bool {
+		for _, k := range keyCols {
+			va, vb := rows[a][k], rows[b][k]
+			if _, isStr := va.(string); isStr {
+				sa, sb := toStringValue(va), toStringValue(vb)
+				if sa != sb {
+					return sa < sb
+				}
+				continue
+			}
+			na, nb := toInt64Value(va), toInt64Value(vb)
+			if na != nb {
+				return na < nb
+			}
+		}
+		return false
+	})
+}
@@ -0,0 +1,46 @@
+package dbc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffRowsDuplicateKeys(t *testing.T) {
+	meta := &MetaFile{
+		File:        "Test.dbc",
+		PrimaryKeys: []string{"id"},
+		Fields: []FieldMeta{
+			{Name: "id", Type: "uint32"},
+			{Name: "value", Type: "uint32"},
+		},
+	}
+	base := []Row{
+		{uint32(1), uint32(10)},
+		{uint32(1), uint32(11)},
+		{uint32(1), uint32(12)},
+		{uint32(2), uint32(20)},
+	}
+	current := []Row{
+		{uint32(1), uint32(10)},
+		{uint32(1), uint32(99)},
+		{uint32(2), uint32(20)},
+	}
+
+	d, err := DiffRows(base, current, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Added) != 0 {
+		t.Errorf("Added = %v, want none", d.Added)
+	}
+	if want := []Row{{uint32(1), uint32(12)}}; !reflect.DeepEqual(d.Removed, want) {
+		t.Errorf("Removed = %v, want %v", d.Removed, want)
+	}
+	if len(d.Changed) != 1 {
+		t.Fatalf("Changed = %v, want one record", d.Changed)
+	}
+	c := d.Changed[0]
+	if !reflect.DeepEqual(c.Old, base[1]) || !reflect.DeepEqual(c.New, current[1]) {
+		t.Errorf("Changed pairs %v with %v, want %v with %v", c.Old, c.New, base[1], current[1])
+	}
+}
@@ -0,0 +1,133 @@
+package dbc
+
+import (
+	"fmt"
+	"math"
+)
+
+// PreserveBaseline re-encodes file so that everything it shares with the
+// baseline DBC keeps its original bytes: baseline records stay in their
+// original order, unchanged values keep their raw float32 bits and string
+// offsets, and the baseline string block is kept as-is with new strings
+// appended after it. Records not in the baseline follow in file order.
+//
+// An unmodified table therefore encodes byte-for-byte identical to baseline.
+// Records are matched by key (by occurrence for duplicate keys), or by
+// position in tables that have no key.
+func PreserveBaseline(file *DBCFile, meta *MetaFile, baseline []byte) (*DBCFile, error) {
+	base, err := LoadDBCFromBytes(baseline, *meta)
+	if err != nil {
+		return nil, fmt.Errorf("parse baseline %s: %w", meta.File, err)
+	}
+
+	cols := Columns(meta)
+	// Records of tables without a key pair up by position
+	keyCols, _ := KeyColumns(meta)
+	baseRows := DecodeRows(&base, meta)
+	current := DecodeRows(file, meta)
+
+	pos := make(map[string]int, len(baseRows))
+	seen := make(map[string]int, len(baseRows))
+	for i, row := range baseRows {
+		pos[occurrenceKey(row, keyCols, seen)] = i
+	}
+
+	matched := make([]Row, len(baseRows))
+	var added []Row
+	seen = make(map[string]int, len(current))
+	for _, row := range current {
+		if i, ok := pos[occurrenceKey(row, keyCols, seen)]; ok {
+			matched[i] = row
+		} else {
+			added = append(added, row)
+		}
+	}
+
+	sb := newStringBlockFrom(base.StringBlock)
+	out := &DBCFile{
+		Header:  base.Header,
+		Records: make([]Record, 0, len(current)),
+	}
+	for i, row := range matched {
+		if row == nil {
+			continue // removed
+		}
+		rec := make(Record)
+		for j, col := range cols {
+			if sameStoredValue(col, row[j], baseRows[i][j]) {
+				copyRawValue(rec, base.Records[i], col)
+			} else {
+				encodeValue(rec, col, row[j], sb)
+			}
+		}
+		out.Records = append(out.Records, rec)
+	}
+	for _, row := range added {
+		out.Records = append(out.Records, EncodeRow(row, cols, sb))
+	}
+
+	out.StringBlock = sb.Bytes()
+	out.Header.RecordCount = uint32(len(out.Records))
+	out.Header.StringBlockSize = uint32(len(out.StringBlock))
+	return out, nil
+}
+
+// occurrenceKey returns a row's primary key, numbered by how many rows with
+// the same key came before it, so duplicate keys pair up in order.
+func occurrenceKey(row Row, keyCols []int, seen map[string]int) string {
+	key := RowKey(row, keyCols)
+	n := seen[key]
+	seen[key] = n + 1
+	return fmt.Sprintf("%s#%d", key, n)
+}
+
+// sameStoredValue reports whether cur is base after a trip through storage.
+// Floats pass through DECIMAL(38,16) columns in MySQL, which rounds to 16
+// decimal places and can't hold NaN or infinity (those read back as 0).
+func sameStoredValue(col Column, cur, base interface{}) bool {
+	if ValuesEqual(col, cur, base) {
+		return true
+	}
+	if col.Type != "float" {
+		return false
+	}
+	c, b := float64(toFloat32Value(cur)), float64(toFloat32Value(base))
+	if math.IsNaN(b) || math.IsInf(b, 0) {
+		return c == 0
+	}
+	return math.Abs(c-b) <= 1e-16
+}
+
+// copyRawValue copies one column's raw value (bits or string offset) from src.
+func copyRawValue(rec, src Record, col Column) {
+	if col.Loc < 0 {
+		rec[col.Field] = src[col.Field]
+		return
+	}
+	loc, ok := rec[col.Field].([]uint32)
+	if !ok {
+		loc = make([]uint32, len(LocLangs))
+		rec[col.Field] = loc
+	}
+	if srcLoc, _ := src[col.Field].([]uint32); col.Loc < len(srcLoc) {
+		loc[col.Loc] = srcLoc[col.Loc]
+	}
+}
+
+// newStringBlockFrom returns a string block that starts with data verbatim.
+// Every string in data can be reused; new strings are appended after it.
+func newStringBlockFrom(data []byte) *StringBlock {
+	b := &StringBlock{data: append([]byte(nil), data...), offsets: make(map[string]uint32)}
+	start := 0
+	for i, c := range data {
+		if c != 0 {
+			continue
+		}
+		s := string(data[start:i])
+		if _, ok := b.offsets[s]; !ok {
+			b.offsets[s] = uint32(start)
+		}
+		start = i + 1
+	}
+	return b
+}
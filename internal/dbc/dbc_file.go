@@ -6,9 +6,11 @@
 package dbc
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -36,6 +38,15 @@ type FieldMeta struct {
 	Count uint32 `json:"count,omitempty"`
 }
 
+// Reference declares that a field holds a key of a row in another DBC file.
+// For array fields, Field may be the base name (applies to every element)
+// or a single element (e.g., "spell_visual_1").
+type Reference struct {
+	Field  string `json:"field"`
+	Table  string `json:"table"`            // referenced DBC, e.g. "SpellIcon"
+	Column string `json:"column,omitempty"` // defaults to the referenced table's primary key
+}
+
 // MetaFile is the schema description for a DBC file.
 type MetaFile struct {
 	File        string      `json:"file"`
@@ -44,6 +55,9 @@ type MetaFile struct {
 	UniqueKeys  [][]string  `json:"uniqueKeys,omitempty"`
 	SortOrder   []SortField `json:"sortOrder,omitempty"`
 	Fields      []FieldMeta `json:"fields"`
+	References  []Reference `json:"references,omitempty"`
+	Enums       []EnumMeta  `json:"enums,omitempty"`
+	Flags       []FlagsMeta `json:"flags,omitempty"`
 }
 
 // Record is a single DBC record stored as field-name → value.
@@ -175,67 +189,71 @@ func ParseRecords(data []byte, start int, header DBCHeader, meta MetaFile, strin
 
 	records := make([]Record, 0, header.RecordCount)
 	for i := uint32(0); i < header.RecordCount; i++ {
-		rec := make(Record)
 		recordOffset := start + int(i*header.RecordSize)
-		offset := 0
+		rec, err := ParseRecord(data[recordOffset:recordOffset+int(header.RecordSize)], meta)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+		records = append(records, rec)
+	}
 
-		for _, field := range meta.Fields {
-			repeat := int(field.Count)
-			if repeat == 0 {
-				repeat = 1
-			}
+	return records, nil
+}
 
-			for j := 0; j < repeat; j++ {
-				name := field.Name
-				if field.Count > 1 {
-					name = fmt.Sprintf("%s_%d", field.Name, j+1)
-				}
+// ParseRecord reads one record from its raw bytes. String fields hold
+// offsets into the file's string block.
+func ParseRecord(data []byte, meta MetaFile) (Record, error) {
+	rec := make(Record)
+	offset := 0
 
-				elemSize, _ := sizeOf(field.Type)
-				if recordOffset+offset+elemSize > len(data) {
-					return nil, fmt.Errorf("out of bounds reading record %d field %s", i, name)
-				}
+	for _, field := range meta.Fields {
+		repeat := int(field.Count)
+		if repeat == 0 {
+			repeat = 1
+		}
 
-				switch field.Type {
-				case "int32":
-					val := int32(binary.LittleEndian.Uint32(data[recordOffset+offset : recordOffset+offset+4]))
-					rec[name] = val
-					offset += 4
-				case "uint32":
-					val := binary.LittleEndian.Uint32(data[recordOffset+offset : recordOffset+offset+4])
-					rec[name] = val
-					offset += 4
-				case "uint8":
-					rec[name] = data[recordOffset+offset]
-					offset += 1
-				case "float":
-					bits := binary.LittleEndian.Uint32(data[recordOffset+offset : recordOffset+offset+4])
-					rec[name] = math.Float32frombits(bits)
-					offset += 4
-				case "string":
-					strOffset := binary.LittleEndian.Uint32(data[recordOffset+offset : recordOffset+offset+4])
-					rec[name] = strOffset
-					offset += 4
-				case "Loc":
-					loc := make([]uint32, 17)
-					for col := 0; col < 17; col++ {
-						loc[col] = binary.LittleEndian.Uint32(data[recordOffset+offset : recordOffset+offset+4])
-						offset += 4
-					}
-					rec[name] = loc
-				default:
-					return nil, fmt.Errorf("unknown field type: %s", field.Type)
-				}
+		for j := 0; j < repeat; j++ {
+			name := field.Name
+			if field.Count > 1 {
+				name = fmt.Sprintf("%s_%d", field.Name, j+1)
+			}
+
+			elemSize, err := sizeOf(field.Type)
+			if err != nil {
+				return nil, err
+			}
+			if offset+elemSize > len(data) {
+				return nil, fmt.Errorf("out of bounds reading field %s", name)
 			}
-		}
 
-		if offset != expectedRecordSize {
-			return nil, fmt.Errorf("parsed record %d consumed %d bytes but expected %d", i, offset, expectedRecordSize)
+			switch field.Type {
+			case "int32":
+				rec[name] = int32(binary.LittleEndian.Uint32(data[offset : offset+4]))
+			case "uint32":
+				rec[name] = binary.LittleEndian.Uint32(data[offset : offset+4])
+			case "uint8":
+				rec[name] = data[offset]
+			case "float":
+				rec[name] = math.Float32frombits(binary.LittleEndian.Uint32(data[offset : offset+4]))
+			case "string":
+				rec[name] = binary.LittleEndian.Uint32(data[offset : offset+4])
+			case "Loc":
+				loc := make([]uint32, 17)
+				for col := 0; col < 17; col++ {
+					loc[col] = binary.LittleEndian.Uint32(data[offset+col*4 : offset+col*4+4])
+				}
+				rec[name] = loc
+			default:
+				return nil, fmt.Errorf("unknown field type: %s", field.Type)
+			}
+			offset += elemSize
 		}
-		records = append(records, rec)
 	}
 
-	return records, nil
+	if offset != len(data) {
+		return nil, fmt.Errorf("parsed %d bytes but the record has %d", offset, len(data))
+	}
+	return rec, nil
 }
 
 // ReadString extracts a null-terminated string from the string block.
@@ -262,6 +280,19 @@ func WriteDBC(dbc *DBCFile, meta *MetaFile, outPath string) error {
 	}
 	defer outFile.Close()
 
+	return writeDBCTo(outFile, dbc, meta)
+}
+
+// EncodeDBC serializes a DBCFile to its binary form in memory.
+func EncodeDBC(dbc *DBCFile, meta *MetaFile) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeDBCTo(&buf, dbc, meta); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeDBCTo(w io.Writer, dbc *DBCFile, meta *MetaFile) error {
 	// Write header
 	headerBuf := make([]byte, 20)
 	copy(headerBuf[0:4], dbc.Header.Magic[:])
@@ -269,67 +300,65 @@ func WriteDBC(dbc *DBCFile, meta *MetaFile, outPath string) error {
 	binary.LittleEndian.PutUint32(headerBuf[8:12], dbc.Header.FieldCount)
 	binary.LittleEndian.PutUint32(headerBuf[12:16], dbc.Header.RecordSize)
 	binary.LittleEndian.PutUint32(headerBuf[16:20], dbc.Header.StringBlockSize)
-	if _, err := outFile.Write(headerBuf); err != nil {
+	if _, err := w.Write(headerBuf); err != nil {
 		return err
 	}
 
 	// Write records
-	recordData := make([]byte, dbc.Header.RecordCount*dbc.Header.RecordSize)
-	offset := 0
-
+	recordData := make([]byte, 0, dbc.Header.RecordCount*dbc.Header.RecordSize)
 	for _, rec := range dbc.Records {
-		for _, field := range meta.Fields {
-			repeat := int(field.Count)
-			if repeat == 0 {
-				repeat = 1
-			}
-
-			for j := 0; j < repeat; j++ {
-				name := field.Name
-				if field.Count > 1 {
-					name = fmt.Sprintf("%s_%d", field.Name, j+1)
-				}
-
-				switch field.Type {
-				case "int32":
-					binary.LittleEndian.PutUint32(recordData[offset:offset+4], uint32(rec[name].(int32)))
-					offset += 4
-				case "uint32":
-					binary.LittleEndian.PutUint32(recordData[offset:offset+4], rec[name].(uint32))
-					offset += 4
-				case "uint8":
-					recordData[offset] = rec[name].(uint8)
-					offset += 1
-				case "float":
-					bits := math.Float32bits(rec[name].(float32))
-					binary.LittleEndian.PutUint32(recordData[offset:offset+4], bits)
-					offset += 4
-				case "string":
-					binary.LittleEndian.PutUint32(recordData[offset:offset+4], rec[name].(uint32))
-					offset += 4
-				case "Loc":
-					loc := rec[name].([]uint32)
-					for _, v := range loc {
-						binary.LittleEndian.PutUint32(recordData[offset:offset+4], v)
-						offset += 4
-					}
-				}
-			}
-		}
+		recordData = AppendRecord(recordData, rec, meta)
+	}
+	if n := int(dbc.Header.RecordCount * dbc.Header.RecordSize); len(recordData) < n {
+		recordData = append(recordData, make([]byte, n-len(recordData))...)
 	}
 
-	if _, err := outFile.Write(recordData); err != nil {
+	if _, err := w.Write(recordData); err != nil {
 		return err
 	}
 
 	// Write string block
-	if _, err := outFile.Write(dbc.StringBlock); err != nil {
+	if _, err := w.Write(dbc.StringBlock); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// AppendRecord appends the binary form of one record to dst. String fields
+// must already hold string block offsets.
+func AppendRecord(dst []byte, rec Record, meta *MetaFile) []byte {
+	for _, field := range meta.Fields {
+		repeat := int(field.Count)
+		if repeat == 0 {
+			repeat = 1
+		}
+
+		for j := 0; j < repeat; j++ {
+			name := field.Name
+			if field.Count > 1 {
+				name = fmt.Sprintf("%s_%d", field.Name, j+1)
+			}
+
+			switch field.Type {
+			case "int32":
+				dst = binary.LittleEndian.AppendUint32(dst, uint32(rec[name].(int32)))
+			case "uint32", "string":
+				dst = binary.LittleEndian.AppendUint32(dst, rec[name].(uint32))
+			case "uint8":
+				dst = append(dst, rec[name].(uint8))
+			case "float":
+				dst = binary.LittleEndian.AppendUint32(dst, math.Float32bits(rec[name].(float32)))
+			case "Loc":
+				for _, v := range rec[name].([]uint32) {
+					dst = binary.LittleEndian.AppendUint32(dst, v)
+				}
+			}
+		}
+	}
+	return dst
+}
+
 // ExpandedFieldNames returns the flat list of column names for a meta,
 // expanding arrays (name_1, name_2, ...) and Loc fields (name_enUS, name_koKR, ...).
 func ExpandedFieldNames(meta *MetaFile) []string {
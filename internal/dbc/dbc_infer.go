@@ -0,0 +1,284 @@
+package dbc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// InferMeta guesses a schema for a DBC file that has no meta. Every column is
+// classified from the record data alone: string-block offsets become "string",
+// a string followed by 15 offset columns and a flags column becomes "Loc", values
+// that look like IEEE floats become "float", and columns holding small negative
+// numbers become "int32". Everything else is "uint32". Field names are
+// placeholders (id, field_1, field_2, ...) meant to be renamed by hand.
+func InferMeta(data []byte, file string) (*MetaFile, error) {
+	if len(data) < 20 {
+		return nil, fmt.Errorf("data too small to be a valid DBC (%d bytes)", len(data))
+	}
+	header, err := ParseHeader(data[:20])
+	if err != nil {
+		return nil, err
+	}
+
+	recordsEnd := 20 + int(header.RecordCount)*int(header.RecordSize)
+	if recordsEnd+int(header.StringBlockSize) > len(data) {
+		return nil, fmt.Errorf("data too small for records + string block")
+	}
+	stringBlock := data[recordsEnd : recordsEnd+int(header.StringBlockSize)]
+
+	meta := &MetaFile{File: file}
+
+	// Byte-packed tables: every field is one byte.
+	if header.FieldCount > 0 && header.RecordSize == header.FieldCount {
+		for i := 0; i < int(header.FieldCount); i++ {
+			meta.Fields = append(meta.Fields, FieldMeta{Name: inferFieldName(i), Type: "uint8"})
+		}
+		return meta, nil
+	}
+	if header.RecordSize != header.FieldCount*4 {
+		return nil, fmt.Errorf("unsupported record layout: %d fields in %d bytes (mixed field sizes can't be inferred)",
+			header.FieldCount, header.RecordSize)
+	}
+
+	// Column-major copy of every 4-byte value.
+	nCols := int(header.FieldCount)
+	columns := make([][]uint32, nCols)
+	for c := range columns {
+		columns[c] = make([]uint32, header.RecordCount)
+	}
+	for r := 0; r < int(header.RecordCount); r++ {
+		base := 20 + r*int(header.RecordSize)
+		for c := 0; c < nCols; c++ {
+			columns[c][r] = binary.LittleEndian.Uint32(data[base+c*4:])
+		}
+	}
+
+	offsetOK := make([]bool, nCols)
+	isString := make([]bool, nCols)
+	for c, vals := range columns {
+		offsetOK[c] = allStringOffsets(vals, stringBlock)
+		isString[c] = offsetOK[c] && distinctNonZero(vals) >= 2 && c > 0
+	}
+
+	for c := 0; c < nCols; {
+		switch {
+		case c == 0:
+			meta.Fields = append(meta.Fields, FieldMeta{Name: "id", Type: inferNumericType(columns[c])})
+			c++
+		case isString[c] && isLocRun(offsetOK, c):
+			meta.Fields = append(meta.Fields, FieldMeta{Name: inferFieldName(c), Type: "Loc"})
+			c += 17
+		case isString[c]:
+			meta.Fields = append(meta.Fields, FieldMeta{Name: inferFieldName(c), Type: "string"})
+			c++
+		default:
+			meta.Fields = append(meta.Fields, FieldMeta{Name: inferFieldName(c), Type: inferNumericType(columns[c])})
+			c++
+		}
+	}
+
+	if nCols > 0 && allUnique(columns[0]) {
+		meta.PrimaryKeys = []string{"id"}
+		meta.SortOrder = []SortField{{Name: "id", Direction: "ASC"}}
+	}
+	return meta, nil
+}
+
+func inferFieldName(col int) string {
+	if col == 0 {
+		return "id"
+	}
+	return fmt.Sprintf("field_%d", col)
+}
+
+// isLocRun reports whether columns c..c+15 are all string offsets, leaving
+// room for the flags column at c+16.
+func isLocRun(offsetOK []bool, c int) bool {
+	if c+16 >= len(offsetOK) {
+		return false
+	}
+	for i := c + 1; i < c+16; i++ {
+		if !offsetOK[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// allStringOffsets reports whether every value points at the start of a
+// non-empty string in the block (offset 0, or right after a null terminator).
+func allStringOffsets(vals []uint32, stringBlock []byte) bool {
+	if len(stringBlock) <= 1 {
+		return false
+	}
+	for _, v := range vals {
+		if v == 0 {
+			continue
+		}
+		if v >= uint32(len(stringBlock)) || stringBlock[v-1] != 0 || stringBlock[v] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// inferNumericType picks float, int32 or uint32 for a non-string column.
+func inferNumericType(vals []uint32) string {
+	nonZero, floatLike, negative := 0, 0, 0
+	for _, v := range vals {
+		if v == 0 {
+			continue
+		}
+		nonZero++
+		// Small integers read as denormals and negative integers as NaN, so
+		// only values of a plausible magnitude count as floats.
+		f := float64(math.Float32frombits(v))
+		if abs := math.Abs(f); abs >= 1e-6 && abs <= 1e7 {
+			floatLike++
+		}
+		if n := int32(v); n < 0 && n > -1000000 {
+			negative++
+		}
+	}
+	switch {
+	case nonZero == 0:
+		return "uint32"
+	case floatLike*10 >= nonZero*9:
+		return "float"
+	case negative > 0:
+		return "int32"
+	default:
+		return "uint32"
+	}
+}
+
+// distinctNonZero counts distinct non-zero values. A column of 0/1 flags would
+// otherwise pass as string offsets whenever the first string starts at 1.
+func distinctNonZero(vals []uint32) int {
+	seen := make(map[uint32]bool)
+	for _, v := range vals {
+		if v != 0 {
+			seen[v] = true
+		}
+	}
+	return len(seen)
+}
+
+func allUnique(vals []uint32) bool {
+	seen := make(map[uint32]bool, len(vals))
+	for _, v := range vals {
+		if seen[v] {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
+
+// MarshalMeta renders a meta in the layout used by the embedded meta files,
+// one field per line.
+func MarshalMeta(meta *MetaFile) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+
+	writeKey := func(key string, v interface{}) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&buf, "  %q: %s,\n", key, spaceJSON(data))
+		return nil
+	}
+
+	if err := writeKey("file", meta.File); err != nil {
+		return nil, err
+	}
+	if meta.TableName != "" {
+		if err := writeKey("tableName", meta.TableName); err != nil {
+			return nil, err
+		}
+	}
+	pks := meta.PrimaryKeys
+	if pks == nil {
+		pks = []string{}
+	}
+	if err := writeKey("primaryKeys", pks); err != nil {
+		return nil, err
+	}
+	if len(meta.UniqueKeys) > 0 {
+		if err := writeKey("uniqueKeys", meta.UniqueKeys); err != nil {
+			return nil, err
+		}
+	}
+	if len(meta.SortOrder) > 0 {
+		buf.WriteString("  \"sortOrder\": [\n")
+		for i, sf := range meta.SortOrder {
+			data, err := json.Marshal(sf)
+			if err != nil {
+				return nil, err
+			}
+			buf.WriteString("    " + spaceJSON(data))
+			if i < len(meta.SortOrder)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString("  ],\n")
+	}
+
+	buf.WriteString("  \"fields\": [\n")
+	for i, f := range meta.Fields {
+		data, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("    " + spaceJSON(data))
+		if i < len(meta.Fields)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("  ]")
+
+	if len(meta.References) > 0 {
+		buf.WriteString(",\n  \"references\": [\n")
+		for i, ref := range meta.References {
+			data, err := json.Marshal(ref)
+			if err != nil {
+				return nil, err
+			}
+			buf.WriteString("    " + spaceJSON(data))
+			if i < len(meta.References)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString("  ]")
+	}
+	buf.WriteString("\n}\n")
+	return buf.Bytes(), nil
+}
+
+// spaceJSON adds a space after ':' and ',' outside of strings in compact JSON.
+func spaceJSON(data []byte) string {
+	var sb strings.Builder
+	inString, escaped := false, false
+	for _, b := range data {
+		sb.WriteByte(b)
+		switch {
+		case escaped:
+			escaped = false
+		case b == '\\' && inString:
+			escaped = true
+		case b == '"':
+			inString = !inString
+		case !inString && (b == ':' || b == ','):
+			sb.WriteByte(' ')
+		}
+	}
+	return sb.String()
+}
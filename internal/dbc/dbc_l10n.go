@@ -0,0 +1,459 @@
+package dbc
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Translation file formats supported by WriteLocStrings / ReadLocStrings.
+const (
+	FormatPO    = "po"
+	FormatXLIFF = "xliff"
+)
+
+// L10nFormatFromPath returns the translation format implied by a file
+// extension, or "".
+func L10nFormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".po", ".pot":
+		return FormatPO
+	case ".xliff", ".xlf":
+		return FormatXLIFF
+	}
+	return ""
+}
+
+// LocString is one translatable string: a Loc field of one record, with its
+// enUS text and the translation into the target language.
+type LocString struct {
+	Table       string // SQL table name, e.g. "spell"
+	Key         string // record key, as returned by RowKey
+	Field       string // Loc field name, e.g. "name"
+	Source      string // enUS text
+	Translation string
+	Note        string // shown to translators, e.g. "Spell.dbc id=133"
+}
+
+// ID identifies the string in translation files: "<table>/<key>/<field>".
+func (s LocString) ID() string {
+	return s.Table + "/" + s.Key + "/" + s.Field
+}
+
+// ParseLocStringID splits an ID made by LocString.ID.
+func ParseLocStringID(id string) (table, key, field string, err error) {
+	first, last := strings.Index(id, "/"), strings.LastIndex(id, "/")
+	if first < 0 || first == last {
+		return "", "", "", fmt.Errorf("invalid string id %q (expected <table>/<key>/<field>)", id)
+	}
+	return id[:first], id[first+1 : last], id[last+1:], nil
+}
+
+// locLangAliases maps current locale codes to the names of their LocLangs slots.
+var locLangAliases = map[string]string{"zhcn": "enCN", "zhtw": "enTW"}
+
+// LocLangIndex returns the Loc slot of a language such as "deDE", "de_DE" or
+// "de-DE". Only the twelve language slots are accepted.
+func LocLangIndex(lang string) (int, error) {
+	norm := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(lang))
+	if alias, ok := locLangAliases[norm]; ok {
+		norm = strings.ToLower(alias)
+	}
+	for i, l := range LocLangs[:12] {
+		if strings.ToLower(l) == norm {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown language %q (expected one of enUS, koKR, frFR, deDE, zhCN, zhTW, esES, esMX, ruRU, jaJP, ptPT, itIT)", lang)
+}
+
+// LocLangCode returns the locale code of a Loc slot as used in translation
+// files, e.g. "deDE" → "de" + sep + "DE".
+func LocLangCode(lang int, sep string) string {
+	name := LocLangs[lang]
+	for code, alias := range locLangAliases {
+		if alias == name {
+			name = code[:2] + strings.ToUpper(code[2:])
+		}
+	}
+	return name[:2] + sep + name[2:]
+}
+
+// CollectLocStrings returns the Loc strings a diff introduces: every Loc field
+// of an added record and every Loc field whose enUS text changed, skipping
+// empty ones. Translation is set to the record's current text in lang, unless
+// that is still the untranslated enUS text.
+func CollectLocStrings(d *TableDiff, meta *MetaFile, lang int) []LocString {
+	table := TableName(meta)
+	var out []LocString
+	collect := func(row Row, changed map[string]bool) {
+		for i, col := range d.Columns {
+			if col.Loc != 0 || (changed != nil && !changed[col.Name]) {
+				continue
+			}
+			source := toStringValue(row[i])
+			if source == "" {
+				continue
+			}
+			s := LocString{
+				Table:  table,
+				Key:    RowKey(row, d.KeyCols),
+				Field:  col.Field,
+				Source: source,
+				Note:   meta.File + " " + d.KeyString(row),
+			}
+			if j := locColumn(d.Columns, col.Field, lang); j >= 0 && lang != 0 {
+				if t := toStringValue(row[j]); t != source {
+					s.Translation = t
+				}
+			}
+			out = append(out, s)
+		}
+	}
+	for _, row := range d.Added {
+		collect(row, nil)
+	}
+	for _, rc := range d.Changed {
+		changed := make(map[string]bool, len(rc.Changes))
+		for _, fc := range rc.Changes {
+			changed[fc.Column] = true
+		}
+		collect(rc.New, changed)
+	}
+	return out
+}
+
+// TranslationSQL renders UPDATE statements that set each translated string's
+// lang column, and a rollback that restores the values in rows. Strings
+// without a translation are skipped; strings whose record or field isn't in
+// rows are returned as missing. A string whose key matches several records
+// is an error, since the UPDATE would change all of them.
+func TranslationSQL(strs []LocString, meta *MetaFile, rows []Row, lang int) (forward, rollback string, missing []LocString, err error) {
+	keyCols, err := KeyColumns(meta)
+	if err != nil {
+		return "", "", nil, err
+	}
+	d := &TableDiff{Columns: Columns(meta), KeyCols: keyCols}
+	byKey := make(map[string]Row, len(rows))
+	shared := make(map[string]bool)
+	for _, row := range rows {
+		key := RowKey(row, d.KeyCols)
+		if _, ok := byKey[key]; ok {
+			shared[key] = true
+		}
+		byKey[key] = row
+	}
+
+	table := TableName(meta)
+	var fw, rb strings.Builder
+	for _, s := range strs {
+		if s.Translation == "" {
+			continue
+		}
+		if shared[s.Key] {
+			return "", "", nil, fmt.Errorf("%s: several %s records have key %s, so it can't be translated", s.ID(), meta.File, s.Key)
+		}
+		row, ok := byKey[s.Key]
+		j := locColumn(d.Columns, s.Field, lang)
+		if !ok || j < 0 {
+			missing = append(missing, s)
+			continue
+		}
+		col := d.Columns[j]
+		if toStringValue(row[j]) == s.Translation {
+			continue
+		}
+		fmt.Fprintf(&fw, "UPDATE `%s` SET `%s` = %s WHERE %s;\n", table, col.Name, SQLValue(col, s.Translation), whereKey(d, row))
+		fmt.Fprintf(&rb, "UPDATE `%s` SET `%s` = %s WHERE %s;\n", table, col.Name, SQLValue(col, row[j]), whereKey(d, row))
+	}
+	return fw.String(), rb.String(), missing, nil
+}
+
+// locColumn returns the index of a Loc field's column for one language, or -1.
+func locColumn(cols []Column, field string, lang int) int {
+	for i, col := range cols {
+		if col.Field == field && col.Loc == lang {
+			return i
+		}
+	}
+	return -1
+}
+
+// WriteLocStrings writes strings as a PO or XLIFF translation file for lang.
+// project names the strings' origin (e.g. the mod) in the file header.
+func WriteLocStrings(w io.Writer, strs []LocString, lang int, project, format string) error {
+	switch format {
+	case FormatPO:
+		return writePO(w, strs, lang, project)
+	case FormatXLIFF:
+		return writeXLIFF(w, strs, lang, project)
+	default:
+		return fmt.Errorf("unknown format: %s (expected po or xliff)", format)
+	}
+}
+
+// ReadLocStrings reads a PO or XLIFF translation file and returns its strings
+// and target language. Table, Key and Field are parsed from each string's ID.
+func ReadLocStrings(r io.Reader, format string) ([]LocString, int, error) {
+	switch format {
+	case FormatPO:
+		return readPO(r)
+	case FormatXLIFF:
+		return readXLIFF(r)
+	default:
+		return nil, 0, fmt.Errorf("unknown format: %s (expected po or xliff)", format)
+	}
+}
+
+func writePO(w io.Writer, strs []LocString, lang int, project string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# %s DBC strings, %s\n", project, LocLangCode(lang, "_"))
+	fmt.Fprintln(bw, `msgid ""`)
+	fmt.Fprintln(bw, `msgstr ""`)
+	fmt.Fprintf(bw, "%s\n", poQuote("Project-Id-Version: "+project+"\n"))
+	fmt.Fprintf(bw, "%s\n", poQuote("Language: "+LocLangCode(lang, "_")+"\n"))
+	fmt.Fprintf(bw, "%s\n", poQuote("MIME-Version: 1.0\n"))
+	fmt.Fprintf(bw, "%s\n", poQuote("Content-Type: text/plain; charset=UTF-8\n"))
+	fmt.Fprintf(bw, "%s\n", poQuote("Content-Transfer-Encoding: 8bit\n"))
+	for _, s := range strs {
+		fmt.Fprintln(bw)
+		if s.Note != "" {
+			fmt.Fprintf(bw, "#. %s\n", s.Note)
+		}
+		fmt.Fprintf(bw, "msgctxt %s\n", poQuote(s.ID()))
+		fmt.Fprintf(bw, "msgid %s\n", poQuote(s.Source))
+		fmt.Fprintf(bw, "msgstr %s\n", poQuote(s.Translation))
+	}
+	return bw.Flush()
+}
+
+func poQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func poUnquote(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("expected a quoted string, got %s", s)
+	}
+	var sb strings.Builder
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		if c != '\\' || i+1 == len(s)-1 {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String(), nil
+}
+
+// readPO parses the subset of PO that writePO produces, as edited by the
+// usual translation tools: continuation lines are joined and fuzzy entries
+// count as untranslated.
+func readPO(r io.Reader) ([]LocString, int, error) {
+	type entry struct {
+		ctxt, id, str string
+		fuzzy         bool
+	}
+	var entries []entry
+	var cur entry
+	var field *string
+	started := false
+	flush := func() {
+		if started {
+			entries = append(entries, cur)
+		}
+		cur, field, started = entry{}, nil, false
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#,"):
+			if field == &cur.str {
+				flush()
+			}
+			cur.fuzzy = cur.fuzzy || strings.Contains(line, "fuzzy")
+		case strings.HasPrefix(line, "#"):
+			// translator, extracted or reference comment
+		case strings.HasPrefix(line, `"`):
+			if field == nil {
+				return nil, 0, fmt.Errorf("line %d: string without msgid/msgstr", lineNo)
+			}
+			s, err := poUnquote(line)
+			if err != nil {
+				return nil, 0, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			*field += s
+		default:
+			keyword, rest, _ := strings.Cut(line, " ")
+			if (keyword == "msgctxt" || keyword == "msgid") && field == &cur.str {
+				flush() // next entry without a blank line in between
+			}
+			switch keyword {
+			case "msgctxt":
+				field = &cur.ctxt
+			case "msgid":
+				field = &cur.id
+			case "msgstr":
+				field = &cur.str
+			default:
+				return nil, 0, fmt.Errorf("line %d: unsupported PO keyword %q", lineNo, keyword)
+			}
+			s, err := poUnquote(rest)
+			if err != nil {
+				return nil, 0, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			*field = s
+			started = true
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, 0, err
+	}
+	flush()
+
+	lang := -1
+	var strs []LocString
+	for _, e := range entries {
+		if e.id == "" && e.ctxt == "" {
+			// Header: "Language: de_DE"
+			for _, h := range strings.Split(e.str, "\n") {
+				if name, value, ok := strings.Cut(h, ":"); ok && strings.EqualFold(strings.TrimSpace(name), "Language") {
+					l, err := LocLangIndex(strings.TrimSpace(value))
+					if err != nil {
+						return nil, 0, err
+					}
+					lang = l
+				}
+			}
+			continue
+		}
+		s, err := locStringFromID(e.ctxt, e.id)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !e.fuzzy {
+			s.Translation = e.str
+		}
+		strs = append(strs, s)
+	}
+	if lang < 0 {
+		return nil, 0, fmt.Errorf("no Language header in PO file")
+	}
+	return strs, lang, nil
+}
+
+func locStringFromID(id, source string) (LocString, error) {
+	table, key, field, err := ParseLocStringID(id)
+	if err != nil {
+		return LocString{}, err
+	}
+	return LocString{Table: table, Key: key, Field: field, Source: source}, nil
+}
+
+// XLIFF 1.2 document, reduced to the elements mithril reads and writes.
+type xliffDoc struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string      `xml:"original,attr"`
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr"`
+	Datatype       string      `xml:"datatype,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+type xliffUnit struct {
+	ID     string       `xml:"id,attr"`
+	Source string       `xml:"source"`
+	Target *xliffTarget `xml:"target"`
+	Note   string       `xml:"note,omitempty"`
+}
+
+type xliffTarget struct {
+	State string `xml:"state,attr,omitempty"`
+	Text  string `xml:",chardata"`
+}
+
+func writeXLIFF(w io.Writer, strs []LocString, lang int, project string) error {
+	doc := xliffDoc{
+		Version: "1.2",
+		File: xliffFile{
+			Original:       project,
+			SourceLanguage: LocLangCode(0, "-"),
+			TargetLanguage: LocLangCode(lang, "-"),
+			Datatype:       "plaintext",
+		},
+	}
+	for _, s := range strs {
+		target := &xliffTarget{Text: s.Translation, State: "translated"}
+		if s.Translation == "" {
+			target.State = "needs-translation"
+		}
+		doc.File.Units = append(doc.File.Units, xliffUnit{ID: s.ID(), Source: s.Source, Target: target, Note: s.Note})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func readXLIFF(r io.Reader) ([]LocString, int, error) {
+	var doc xliffDoc
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, 0, fmt.Errorf("parse XLIFF: %w", err)
+	}
+	if doc.File.TargetLanguage == "" {
+		return nil, 0, fmt.Errorf("no target-language in XLIFF file")
+	}
+	lang, err := LocLangIndex(doc.File.TargetLanguage)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var strs []LocString
+	for _, u := range doc.File.Units {
+		s, err := locStringFromID(u.ID, u.Source)
+		if err != nil {
+			return nil, 0, err
+		}
+		if u.Target != nil {
+			s.Translation = u.Target.Text
+		}
+		s.Note = u.Note
+		strs = append(strs, s)
+	}
+	return strs, lang, nil
+}
@@ -0,0 +1,187 @@
+package dbc
+
+import "fmt"
+
+// MergeSource is one version of a table to merge, e.g. one mod's built DBC.
+type MergeSource struct {
+	Name string
+	Rows []Row
+}
+
+// MergeConflict is a record or field that two sources changed differently
+// relative to the common base. Column is empty for record-level conflicts,
+// where one source removed a record the other changed.
+type MergeConflict struct {
+	Key         string
+	Column      string
+	First       string // earlier source
+	FirstValue  string
+	Second      string // later source
+	SecondValue string
+	Winner      string
+}
+
+func (c MergeConflict) String() string {
+	field := c.Key
+	if c.Column != "" {
+		field += " " + c.Column
+	}
+	return fmt.Sprintf("%s: %s (%s) vs %s (%s) → %s", field, c.FirstValue, c.First, c.SecondValue, c.Second, c.Winner)
+}
+
+// MergeRowsThreeWay combines the changes each source made to base, in source
+// order. Records are matched by key, and by occurrence where several records
+// share a key (the nth such record in a source pairs with the nth in base),
+// so no base record is lost to another with the same key; changes to different records or
+// different fields of the same record are all kept, and identical changes are
+// not conflicts. When two sources set the same field to different values, or
+// one removes a record another changed, the later source wins if preferLast
+// is set and the earlier one otherwise; every such case is returned.
+//
+// Base records keep their order; records added by any source follow, in the
+// order they were first added.
+func MergeRowsThreeWay(base []Row, sources []MergeSource, meta *MetaFile, preferLast bool) ([]Row, []MergeConflict, error) {
+	cols := Columns(meta)
+	keyCols, err := KeyColumns(meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := &TableDiff{Columns: cols, KeyCols: keyCols}
+
+	baseKeys := make([]string, len(base))
+	baseByKey := make(map[string]Row, len(base))
+	merged := make(map[string]Row, len(base))
+	seenBase := make(map[string]int, len(base))
+	for i, row := range base {
+		baseKeys[i] = occurrenceKey(row, keyCols, seenBase)
+		baseByKey[baseKeys[i]] = row
+		merged[baseKeys[i]] = append(Row(nil), row...)
+	}
+	setBy := make(map[string]map[int]int) // key → column → source index
+	removedBy := make(map[string]int)
+	var addedOrder []string
+	var conflicts []MergeConflict
+
+	winner := func(earlier, later int) string {
+		if preferLast {
+			return sources[later].Name
+		}
+		return sources[earlier].Name
+	}
+	setField := func(key string, c int, v interface{}, si int) {
+		if setBy[key] == nil {
+			setBy[key] = make(map[int]int)
+		}
+		if sj, ok := setBy[key][c]; ok && !ValuesEqual(cols[c], merged[key][c], v) {
+			conflicts = append(conflicts, MergeConflict{
+				Key: names.KeyString(merged[key]), Column: cols[c].Name,
+				First: sources[sj].Name, FirstValue: FormatValue(merged[key][c]),
+				Second: sources[si].Name, SecondValue: FormatValue(v),
+				Winner: winner(sj, si),
+			})
+			if !preferLast {
+				return
+			}
+		}
+		merged[key][c] = v
+		setBy[key][c] = si
+	}
+	lastSetter := func(key string) int {
+		last := -1
+		for _, sj := range setBy[key] {
+			if sj > last {
+				last = sj
+			}
+		}
+		return last
+	}
+
+	for si, src := range sources {
+		seen := make(map[string]bool, len(src.Rows))
+		occurrences := make(map[string]int, len(src.Rows))
+		for _, row := range src.Rows {
+			key := occurrenceKey(row, keyCols, occurrences)
+			seen[key] = true
+
+			baseRow, inBase := baseByKey[key]
+			if !inBase {
+				if _, added := merged[key]; !added {
+					merged[key] = append(Row(nil), row...)
+					setBy[key] = make(map[int]int, len(cols))
+					for c := range cols {
+						setBy[key][c] = si
+					}
+					addedOrder = append(addedOrder, key)
+					continue
+				}
+				// Added by an earlier source too — merge field by field
+				for c := range cols {
+					setField(key, c, row[c], si)
+				}
+				continue
+			}
+
+			var changed []int
+			for c, col := range cols {
+				if !ValuesEqual(col, baseRow[c], row[c]) {
+					changed = append(changed, c)
+				}
+			}
+			if sj, removed := removedBy[key]; removed {
+				if len(changed) == 0 {
+					continue
+				}
+				conflicts = append(conflicts, MergeConflict{
+					Key:   names.KeyString(baseRow),
+					First: sources[sj].Name, FirstValue: "removed",
+					Second: src.Name, SecondValue: "changed",
+					Winner: winner(sj, si),
+				})
+				if !preferLast {
+					continue
+				}
+				delete(removedBy, key)
+			}
+			for _, c := range changed {
+				setField(key, c, row[c], si)
+			}
+		}
+
+		for i, baseRow := range base {
+			key := baseKeys[i]
+			if seen[key] {
+				continue
+			}
+			if _, removed := removedBy[key]; removed {
+				continue
+			}
+			if sj := lastSetter(key); sj >= 0 {
+				conflicts = append(conflicts, MergeConflict{
+					Key:   names.KeyString(baseRow),
+					First: sources[sj].Name, FirstValue: "changed",
+					Second: src.Name, SecondValue: "removed",
+					Winner: winner(sj, si),
+				})
+				if !preferLast {
+					continue
+				}
+			}
+			// A record that comes back later starts over from the base
+			merged[key] = append(Row(nil), baseRow...)
+			delete(setBy, key)
+			removedBy[key] = si
+		}
+	}
+
+	out := make([]Row, 0, len(merged))
+	for _, key := range baseKeys {
+		if _, removed := removedBy[key]; removed {
+			continue
+		}
+		out = append(out, merged[key])
+	}
+	for _, key := range addedOrder {
+		out = append(out, merged[key])
+	}
+	return out, conflicts, nil
+}
@@ -0,0 +1,51 @@
+package dbc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeRowsThreeWayDuplicateKeys(t *testing.T) {
+	meta := &MetaFile{
+		File:        "Test.dbc",
+		PrimaryKeys: []string{"id"},
+		Fields: []FieldMeta{
+			{Name: "id", Type: "uint32"},
+			{Name: "value", Type: "uint32"},
+		},
+	}
+	base := []Row{
+		{uint32(1), uint32(10)},
+		{uint32(1), uint32(11)},
+		{uint32(1), uint32(12)},
+		{uint32(2), uint32(20)},
+	}
+	changed := []Row{
+		{uint32(1), uint32(10)},
+		{uint32(1), uint32(99)},
+		{uint32(1), uint32(12)},
+		{uint32(2), uint32(20)},
+	}
+	added := append(append([]Row(nil), base...), Row{uint32(3), uint32(30)})
+
+	merged, conflicts, err := MergeRowsThreeWay(base, []MergeSource{
+		{Name: "a", Rows: changed},
+		{Name: "b", Rows: added},
+	}, meta, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 0 {
+		t.Fatalf("conflicts = %v, want none", conflicts)
+	}
+	want := []Row{
+		{uint32(1), uint32(10)},
+		{uint32(1), uint32(99)},
+		{uint32(1), uint32(12)},
+		{uint32(2), uint32(20)},
+		{uint32(3), uint32(30)},
+	}
+	if !reflect.DeepEqual(merged, want) {
+		t.Fatalf("MergeRowsThreeWay =\n%v\nwant\n%v", merged, want)
+	}
+}
@@ -0,0 +1,170 @@
+package dbc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DanglingRef is a reference value with no matching row in the referenced table.
+type DanglingRef struct {
+	File     string // referencing DBC file, e.g. "Spell.dbc"
+	Key      string // referencing record, e.g. "id=100001"
+	Column   string // referencing column, e.g. "spell_icon_id"
+	Value    string
+	RefTable string // referenced DBC file, e.g. "SpellIcon.dbc"
+}
+
+func (r DanglingRef) String() string {
+	return fmt.Sprintf("%s %s: %s = %s → no row in %s", r.File, r.Key, r.Column, r.Value, r.RefTable)
+}
+
+// RowSource returns the current meta and rows of a DBC by name.
+type RowSource func(dbcName string) (*MetaFile, []Row, error)
+
+// CheckReferences validates meta.References for the values a diff introduces:
+// every referencing column of an added record, and every changed referencing
+// column of a changed record. Dangling IDs that were already in the baseline
+// are not reported. Zero (and negative int32) values mean "none" and are skipped.
+func CheckReferences(meta *MetaFile, d *TableDiff, source RowSource) ([]DanglingRef, error) {
+	if len(meta.References) == 0 || d.Empty() {
+		return nil, nil
+	}
+
+	// Column index → reference, for every column a reference covers
+	refByCol := make(map[int]Reference)
+	for _, ref := range meta.References {
+		matched := false
+		for i, col := range d.Columns {
+			if referenceCovers(ref.Field, col.Name) {
+				refByCol[i] = ref
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("%s: reference to unknown field %q", meta.File, ref.Field)
+		}
+	}
+
+	type keySet struct {
+		file string
+		keys map[string]bool
+	}
+	keySets := make(map[string]keySet)
+	targetKeys := func(ref Reference) (keySet, error) {
+		cacheKey := strings.ToLower(ref.Table + "." + ref.Column)
+		if ks, ok := keySets[cacheKey]; ok {
+			return ks, nil
+		}
+		refMeta, rows, err := source(ref.Table)
+		if err != nil {
+			return keySet{}, fmt.Errorf("%s: referenced table %s: %w", meta.File, ref.Table, err)
+		}
+		colIdx := -1
+		if ref.Column == "" {
+			if keys, err := KeyColumns(refMeta); err == nil {
+				colIdx = keys[0]
+			}
+		} else {
+			for i, col := range Columns(refMeta) {
+				if strings.EqualFold(col.Name, ref.Column) {
+					colIdx = i
+					break
+				}
+			}
+		}
+		if colIdx < 0 {
+			return keySet{}, fmt.Errorf("%s: referenced column %s.%s not found", meta.File, ref.Table, ref.Column)
+		}
+		ks := keySet{file: refMeta.File, keys: make(map[string]bool, len(rows))}
+		for _, row := range rows {
+			ks.keys[FormatValue(row[colIdx])] = true
+		}
+		keySets[cacheKey] = ks
+		return ks, nil
+	}
+
+	var dangling []DanglingRef
+	check := func(key string, colIdx int, v interface{}) error {
+		ref, ok := refByCol[colIdx]
+		if !ok || isNullReference(v) {
+			return nil
+		}
+		ks, err := targetKeys(ref)
+		if err != nil {
+			return err
+		}
+		value := FormatValue(v)
+		if !ks.keys[value] {
+			dangling = append(dangling, DanglingRef{
+				File:     meta.File,
+				Key:      key,
+				Column:   d.Columns[colIdx].Name,
+				Value:    value,
+				RefTable: ks.file,
+			})
+		}
+		return nil
+	}
+
+	for _, row := range d.Added {
+		key := d.KeyString(row)
+		for i := range d.Columns {
+			if err := check(key, i, row[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	colIndex := make(map[string]int, len(d.Columns))
+	for i, col := range d.Columns {
+		colIndex[col.Name] = i
+	}
+	for _, rc := range d.Changed {
+		for _, fc := range rc.Changes {
+			if err := check(rc.Key, colIndex[fc.Column], fc.New); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return dangling, nil
+}
+
+// referenceCovers reports whether a reference's field names the column,
+// either exactly or as the base name of an array element (field_1, field_2...).
+func referenceCovers(field, column string) bool {
+	if strings.EqualFold(field, column) {
+		return true
+	}
+	prefix := strings.ToLower(field) + "_"
+	lower := strings.ToLower(column)
+	if !strings.HasPrefix(lower, prefix) {
+		return false
+	}
+	suffix := lower[len(prefix):]
+	if suffix == "" {
+		return false
+	}
+	for _, c := range suffix {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isNullReference(v interface{}) bool {
+	if n, ok := v.(int32); ok {
+		return n <= 0
+	}
+	s := FormatValue(v)
+	return s == "" || s == "0"
+}
+
+// ReferenceFor returns the reference a meta declares for a column, or nil.
+func ReferenceFor(meta *MetaFile, column string) *Reference {
+	for i, ref := range meta.References {
+		if referenceCovers(ref.Field, column) {
+			return &meta.References[i]
+		}
+	}
+	return nil
+}
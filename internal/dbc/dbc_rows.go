@@ -0,0 +1,470 @@
+package dbc
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Column is a single flat column of a DBC table, named the same way as the
+// MySQL column created by createTable (arrays → name_1, Loc → name_enus ...).
+type Column struct {
+	Name  string // SQL column name
+	Field string // key in Record (array element name for Count > 1)
+	Type  string // int32, uint32, uint8, float, string
+	Loc   int    // locale slot (0-16) for Loc columns, -1 otherwise
+}
+
+// Row is a decoded record: one value per Column, with string offsets resolved
+// to Go strings. Values are int32, uint32, uint8, float32 or string.
+type Row []interface{}
+
+// Columns returns the flat column list for a meta in record order.
+func Columns(meta *MetaFile) []Column {
+	var cols []Column
+	for _, field := range meta.Fields {
+		repeat := int(field.Count)
+		if repeat == 0 {
+			repeat = 1
+		}
+		for j := 0; j < repeat; j++ {
+			name := field.Name
+			if field.Count > 1 {
+				name = fmt.Sprintf("%s_%d", field.Name, j+1)
+			}
+			if field.Type == "Loc" {
+				for i, lang := range LocLangs {
+					typ := "string"
+					if i == len(LocLangs)-1 {
+						typ = "uint32"
+					}
+					cols = append(cols, Column{
+						Name:  fmt.Sprintf("%s_%s", name, strings.ToLower(lang)),
+						Field: name,
+						Type:  typ,
+						Loc:   i,
+					})
+				}
+				continue
+			}
+			cols = append(cols, Column{Name: name, Field: name, Type: field.Type, Loc: -1})
+		}
+	}
+	return cols
+}
+
+// ColumnNames returns the SQL column names for a meta in record order.
+func ColumnNames(meta *MetaFile) []string {
+	cols := Columns(meta)
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// DecodeRow resolves a parsed Record into a Row aligned with cols.
+func DecodeRow(rec Record, cols []Column, stringBlock []byte) Row {
+	row := make(Row, len(cols))
+	for i, col := range cols {
+		v := rec[col.Field]
+		switch {
+		case col.Loc >= 0:
+			loc, _ := v.([]uint32)
+			if col.Loc >= len(loc) {
+				row[i] = zeroValue(col)
+			} else if col.Type == "string" {
+				row[i] = ReadString(stringBlock, loc[col.Loc])
+			} else {
+				row[i] = loc[col.Loc]
+			}
+		case col.Type == "string":
+			off, _ := v.(uint32)
+			row[i] = ReadString(stringBlock, off)
+		case v == nil:
+			row[i] = zeroValue(col)
+		default:
+			row[i] = v
+		}
+	}
+	return row
+}
+
+// DecodeRows resolves every record of a DBC file into Rows.
+func DecodeRows(file *DBCFile, meta *MetaFile) []Row {
+	cols := Columns(meta)
+	rows := make([]Row, len(file.Records))
+	for i, rec := range file.Records {
+		rows[i] = DecodeRow(rec, cols, file.StringBlock)
+	}
+	return rows
+}
+
+// EncodeRow converts a Row back into a Record, interning strings into sb.
+func EncodeRow(row Row, cols []Column, sb *StringBlock) Record {
+	rec := make(Record)
+	for i, col := range cols {
+		encodeValue(rec, col, row[i], sb)
+	}
+	return rec
+}
+
+// encodeValue stores one column value into rec, interning strings into sb.
+func encodeValue(rec Record, col Column, v interface{}, sb *StringBlock) {
+	if col.Loc >= 0 {
+		loc, ok := rec[col.Field].([]uint32)
+		if !ok {
+			loc = make([]uint32, len(LocLangs))
+			rec[col.Field] = loc
+		}
+		if col.Type == "string" {
+			loc[col.Loc] = sb.Add(toStringValue(v))
+		} else {
+			loc[col.Loc] = toUint32Value(v)
+		}
+		return
+	}
+	switch col.Type {
+	case "int32":
+		rec[col.Field] = toInt32Value(v)
+	case "uint32":
+		rec[col.Field] = toUint32Value(v)
+	case "uint8":
+		rec[col.Field] = uint8(toUint32Value(v))
+	case "float":
+		rec[col.Field] = toFloat32Value(v)
+	case "string":
+		rec[col.Field] = sb.Add(toStringValue(v))
+	}
+}
+
+// BuildDBC assembles a DBCFile from decoded rows with a freshly built string block.
+func BuildDBC(rows []Row, meta *MetaFile) *DBCFile {
+	cols := Columns(meta)
+	sb := NewStringBlock()
+	dbcFile := &DBCFile{
+		Header:  DBCHeader{Magic: [4]byte{'W', 'D', 'B', 'C'}},
+		Records: make([]Record, 0, len(rows)),
+	}
+	for _, row := range rows {
+		dbcFile.Records = append(dbcFile.Records, EncodeRow(row, cols, sb))
+	}
+	dbcFile.StringBlock = sb.Bytes()
+	dbcFile.Header.RecordCount = uint32(len(dbcFile.Records))
+	dbcFile.Header.FieldCount = calculateFieldCount(meta)
+	dbcFile.Header.RecordSize = calculateRecordSize(meta)
+	dbcFile.Header.StringBlockSize = uint32(len(dbcFile.StringBlock))
+	return dbcFile
+}
+
+// ParseValue converts the text form of a column value into its typed value.
+func ParseValue(col Column, s string) (interface{}, error) {
+	switch col.Type {
+	case "string":
+		return s, nil
+	case "int32":
+		if s == "" {
+			return int32(0), nil
+		}
+		n, err := strconv.ParseInt(trimHex(s), intBase(s), 32)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: invalid int32 %q", col.Name, s)
+		}
+		return int32(n), nil
+	case "uint32":
+		if s == "" {
+			return uint32(0), nil
+		}
+		n, err := strconv.ParseUint(trimHex(s), intBase(s), 32)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: invalid uint32 %q", col.Name, s)
+		}
+		return uint32(n), nil
+	case "uint8":
+		if s == "" {
+			return uint8(0), nil
+		}
+		n, err := strconv.ParseUint(trimHex(s), intBase(s), 8)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: invalid uint8 %q", col.Name, s)
+		}
+		return uint8(n), nil
+	case "float":
+		if s == "" {
+			return float32(0), nil
+		}
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: invalid float %q", col.Name, s)
+		}
+		return float32(f), nil
+	default:
+		return nil, fmt.Errorf("column %s: unknown type %s", col.Name, col.Type)
+	}
+}
+
+// intBase returns 16 for "0x"-prefixed integers and 10 otherwise, so values
+// with leading zeros are never read as octal.
+func intBase(s string) int {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return 16
+	}
+	return 10
+}
+
+func trimHex(s string) string {
+	if intBase(s) == 16 {
+		return s[2:]
+	}
+	return s
+}
+
+// FormatValue renders a Row value as text. Floats use the shortest form that
+// round-trips to the same float32.
+func FormatValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case float32:
+		return strconv.FormatFloat(float64(val), 'g', -1, 32)
+	case string:
+		return val
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// ValuesEqual reports whether two Row values are equal after normalizing to
+// the column's type.
+func ValuesEqual(col Column, a, b interface{}) bool {
+	switch col.Type {
+	case "string":
+		return toStringValue(a) == toStringValue(b)
+	case "int32":
+		return toInt32Value(a) == toInt32Value(b)
+	case "float":
+		fa, fb := toFloat32Value(a), toFloat32Value(b)
+		return fa == fb || (math.IsNaN(float64(fa)) && math.IsNaN(float64(fb)))
+	default:
+		return toUint32Value(a) == toUint32Value(b)
+	}
+}
+
+func zeroValue(col Column) interface{} {
+	switch col.Type {
+	case "int32":
+		return int32(0)
+	case "uint8":
+		return uint8(0)
+	case "float":
+		return float32(0)
+	case "string":
+		return ""
+	default:
+		return uint32(0)
+	}
+}
+
+// --- Value coercion (Row values may come from files, SQL or JSON) ---
+
+func toStringValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	default:
+		return FormatValue(val)
+	}
+}
+
+func toInt64Value(v interface{}) int64 {
+	switch val := v.(type) {
+	case int32:
+		return int64(val)
+	case uint32:
+		return int64(val)
+	case uint8:
+		return int64(val)
+	case int:
+		return int64(val)
+	case int64:
+		return val
+	case uint64:
+		return int64(val)
+	case float32:
+		return int64(val)
+	case float64:
+		return int64(val)
+	case string:
+		if n, err := strconv.ParseInt(trimHex(val), intBase(val), 64); err == nil {
+			return n
+		}
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return int64(f)
+		}
+	case []byte:
+		return toInt64Value(string(val))
+	case fmt.Stringer:
+		return toInt64Value(val.String())
+	}
+	return 0
+}
+
+func toInt32Value(v interface{}) int32 {
+	return int32(toInt64Value(v))
+}
+
+func toUint32Value(v interface{}) uint32 {
+	return uint32(toInt64Value(v))
+}
+
+func toFloat32Value(v interface{}) float32 {
+	switch val := v.(type) {
+	case float32:
+		return val
+	case float64:
+		return float32(val)
+	case string:
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return float32(f)
+		}
+		return 0
+	case []byte:
+		return toFloat32Value(string(val))
+	case fmt.Stringer:
+		return toFloat32Value(val.String())
+	default:
+		return float32(toInt64Value(v))
+	}
+}
+
+// StringBlock builds a DBC string block incrementally, deduplicating strings.
+// Offset 0 is always the empty string.
+type StringBlock struct {
+	data    []byte
+	offsets map[string]uint32
+}
+
+// NewStringBlock returns a string block containing only the leading null byte.
+func NewStringBlock() *StringBlock {
+	return &StringBlock{data: []byte{0}, offsets: map[string]uint32{"": 0}}
+}
+
+// Add interns s and returns its offset.
+func (b *StringBlock) Add(s string) uint32 {
+	if off, ok := b.offsets[s]; ok {
+		return off
+	}
+	off := uint32(len(b.data))
+	b.data = append(b.data, s...)
+	b.data = append(b.data, 0)
+	b.offsets[s] = off
+	return off
+}
+
+// Bytes returns the raw string block.
+func (b *StringBlock) Bytes() []byte {
+	return b.data
+}
+
+// Len returns the current size of the string block in bytes.
+func (b *StringBlock) Len() int {
+	return len(b.data)
+}
+
+// KeyColumns returns the column indexes that identify a record: the meta's
+// primary key, or its first unique key when the primary key isn't made of
+// real columns (tables keyed on MySQL's synthetic auto_id). Tables with
+// neither have no way to tell their records apart, which is an error.
+func KeyColumns(meta *MetaFile) ([]int, error) {
+	cols := Columns(meta)
+	if idx, ok := columnIndexes(cols, meta.PrimaryKeys); ok {
+		return idx, nil
+	}
+	for _, uk := range meta.UniqueKeys {
+		if idx, ok := columnIndexes(cols, uk); ok {
+			return idx, nil
+		}
+	}
+	return nil, fmt.Errorf("%s has no primary or unique key among its columns, so its records can't be told apart", meta.File)
+}
+
+// columnIndexes returns the indexes of the named columns, and whether every
+// name is a column.
+func columnIndexes(cols []Column, names []string) ([]int, bool) {
+	if len(names) == 0 {
+		return nil, false
+	}
+	idx := make([]int, 0, len(names))
+	for _, name := range names {
+		found := false
+		for i, c := range cols {
+			if strings.EqualFold(c.Name, name) {
+				idx = append(idx, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return idx, true
+}
+
+// RowKey renders a row's primary key as a comparable string.
+func RowKey(row Row, keyCols []int) string {
+	parts := make([]string, len(keyCols))
+	for i, k := range keyCols {
+		parts[i] = FormatValue(row[k])
+	}
+	return strings.Join(parts, ",")
+}
+
+// MergeRows upserts patch rows into base by key (see KeyColumns). For rows
+// whose key already exists, non-nil patch values replace the base values in
+// place; new rows are appended in patch order with nil values set to zero /
+// empty.
+func MergeRows(base, patch []Row, meta *MetaFile) ([]Row, error) {
+	cols := Columns(meta)
+	keyCols, err := KeyColumns(meta)
+	if err != nil {
+		return nil, err
+	}
+	merged := make([]Row, len(base))
+	copy(merged, base)
+
+	pos := make(map[string]int, len(merged))
+	for i, row := range merged {
+		pos[RowKey(row, keyCols)] = i
+	}
+	for _, row := range patch {
+		key := RowKey(row, keyCols)
+		if i, ok := pos[key]; ok {
+			updated := make(Row, len(cols))
+			copy(updated, merged[i])
+			for j, v := range row {
+				if v != nil {
+					updated[j] = v
+				}
+			}
+			merged[i] = updated
+			continue
+		}
+		added := make(Row, len(cols))
+		for j, col := range cols {
+			if row[j] != nil {
+				added[j] = row[j]
+			} else {
+				added[j] = zeroValue(col)
+			}
+		}
+		pos[key] = len(merged)
+		merged = append(merged, added)
+	}
+	return merged, nil
+}
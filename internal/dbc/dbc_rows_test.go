@@ -0,0 +1,85 @@
+package dbc
+
+import (
+	"reflect"
+	"testing"
+)
+
+// autoIDMeta is shaped like ItemSubClass: keyed on MySQL's synthetic auto_id,
+// with records told apart by (class, subclass).
+func autoIDMeta() *MetaFile {
+	return &MetaFile{
+		File:        "ItemSubClass.dbc",
+		PrimaryKeys: []string{"auto_id"},
+		UniqueKeys:  [][]string{{"class", "subclass"}},
+		Fields: []FieldMeta{
+			{Name: "class", Type: "uint32"},
+			{Name: "subclass", Type: "uint32"},
+			{Name: "flags", Type: "uint32"},
+		},
+	}
+}
+
+func TestKeyColumnsAutoIDUsesUniqueKey(t *testing.T) {
+	keyCols, err := KeyColumns(autoIDMeta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 1}; !reflect.DeepEqual(keyCols, want) {
+		t.Fatalf("KeyColumns = %v, want %v", keyCols, want)
+	}
+}
+
+func TestKeyColumnsAutoIDWithoutUniqueKey(t *testing.T) {
+	meta := &MetaFile{
+		File:        "gtCombatRatings.dbc",
+		PrimaryKeys: []string{"auto_id"},
+		Fields:      []FieldMeta{{Name: "data", Type: "float"}},
+	}
+	if keyCols, err := KeyColumns(meta); err == nil {
+		t.Fatalf("KeyColumns = %v, want an error", keyCols)
+	}
+	if _, err := MergeRows([]Row{{float32(1)}}, []Row{{float32(2)}}, meta); err == nil {
+		t.Fatal("MergeRows on a table without a key: want an error")
+	}
+}
+
+func TestMergeRowsAutoID(t *testing.T) {
+	base := []Row{
+		{uint32(2), uint32(0), uint32(0)},
+		{uint32(2), uint32(1), uint32(0)},
+		{uint32(2), uint32(2), uint32(0)},
+	}
+	patch := []Row{
+		{uint32(2), uint32(0), uint32(7)},
+		{uint32(4), uint32(0), nil},
+	}
+	merged, err := MergeRows(base, patch, autoIDMeta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Row{
+		{uint32(2), uint32(0), uint32(7)},
+		{uint32(2), uint32(1), uint32(0)},
+		{uint32(2), uint32(2), uint32(0)},
+		{uint32(4), uint32(0), uint32(0)},
+	}
+	if !reflect.DeepEqual(merged, want) {
+		t.Fatalf("MergeRows =\n%v\nwant\n%v", merged, want)
+	}
+}
+
+func TestKeyColumnsEmbeddedMetas(t *testing.T) {
+	metas, err := AllMetas()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, meta := range metas {
+		if len(meta.PrimaryKeys) == 1 && meta.PrimaryKeys[0] == "auto_id" {
+			continue
+		}
+		if _, err := KeyColumns(meta); err != nil {
+			t.Errorf("KeyColumns(%s): %v", meta.File, err)
+		}
+	}
+}
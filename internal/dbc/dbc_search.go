@@ -0,0 +1,164 @@
+package dbc
+
+import (
+	"crypto/md5"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// stringIndexVersion changes whenever the index format does, so old index
+// files are rebuilt rather than misread.
+const stringIndexVersion = 1
+
+// StringIndex holds every non-empty string and Loc value of a set of DBC
+// files, so text can be searched without parsing the files again. Each file
+// is re-indexed only when its size, modification time or meta changes.
+type StringIndex struct {
+	Version int
+	Files   map[string]*IndexedFile // by path
+}
+
+// IndexedFile is the indexed strings of one DBC file.
+type IndexedFile struct {
+	Size    int64
+	ModTime int64  // UnixNano
+	MetaSum string // md5 of the meta the file was parsed with
+	File    string // DBC file name, e.g. "Spell.dbc"
+	Entries []StringEntry
+}
+
+// StringEntry is one string value of a record.
+type StringEntry struct {
+	Key    string // the record's primary key, e.g. "id=133"
+	Column string // SQL column name, e.g. "spell_name_enus"
+	Text   string
+}
+
+// LoadStringIndex reads an index written by Save. A missing, unreadable or
+// outdated file gives an empty index.
+func LoadStringIndex(path string) *StringIndex {
+	idx := &StringIndex{Version: stringIndexVersion, Files: make(map[string]*IndexedFile)}
+	f, err := os.Open(path)
+	if err != nil {
+		return idx
+	}
+	defer f.Close()
+	var loaded StringIndex
+	if err := gob.NewDecoder(f).Decode(&loaded); err != nil || loaded.Version != stringIndexVersion || loaded.Files == nil {
+		return idx
+	}
+	return &loaded
+}
+
+// Save writes the index to path, replacing it atomically.
+func (idx *StringIndex) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(f).Encode(idx); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("encode string index: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Update makes sure the index holds the current strings of a DBC file,
+// parsing it with meta if it changed since it was indexed. It reports
+// whether the file had to be (re-)indexed.
+func (idx *StringIndex) Update(path string, meta *MetaFile) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	metaSum, err := metaChecksum(meta)
+	if err != nil {
+		return false, err
+	}
+	if f, ok := idx.Files[path]; ok && f.Size == info.Size() && f.ModTime == info.ModTime().UnixNano() && f.MetaSum == metaSum {
+		return false, nil
+	}
+
+	file, err := LoadDBC(path, *meta)
+	if err != nil {
+		return false, err
+	}
+	// Tables without a key show the record's position instead
+	keyCols, keyErr := KeyColumns(meta)
+	diff := &TableDiff{Columns: Columns(meta), KeyCols: keyCols}
+	indexed := &IndexedFile{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		MetaSum: metaSum,
+		File:    meta.File,
+	}
+	for n, row := range DecodeRows(&file, meta) {
+		key := ""
+		for i, col := range diff.Columns {
+			if col.Type != "string" {
+				continue
+			}
+			text, _ := row[i].(string)
+			if text == "" {
+				continue
+			}
+			if key == "" && keyErr != nil {
+				key = fmt.Sprintf("record #%d", n+1)
+			} else if key == "" {
+				key = diff.KeyString(row)
+			}
+			indexed.Entries = append(indexed.Entries, StringEntry{Key: key, Column: col.Name, Text: text})
+		}
+	}
+	idx.Files[path] = indexed
+	return true, nil
+}
+
+// Prune drops the files not in keep and reports whether any were dropped.
+func (idx *StringIndex) Prune(keep map[string]bool) bool {
+	pruned := false
+	for path := range idx.Files {
+		if !keep[path] {
+			delete(idx.Files, path)
+			pruned = true
+		}
+	}
+	return pruned
+}
+
+// Search returns the entries of an indexed file whose text contains query,
+// ignoring case. With exact, the whole text must match (still ignoring case).
+func (f *IndexedFile) Search(query string, exact bool) []StringEntry {
+	q := strings.ToLower(query)
+	var hits []StringEntry
+	for _, e := range f.Entries {
+		text := strings.ToLower(e.Text)
+		if (exact && text == q) || (!exact && strings.Contains(text, q)) {
+			hits = append(hits, e)
+		}
+	}
+	return hits
+}
+
+func metaChecksum(meta *MetaFile) (string, error) {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return "", err
+	}
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:]), nil
+}
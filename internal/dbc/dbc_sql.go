@@ -0,0 +1,123 @@
+package dbc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DiffSQL renders a TableDiff as a forward script (INSERT / UPDATE / DELETE
+// turning base into current) and a rollback script that reverses it. UPDATEs
+// and DELETEs match a record on d.KeyCols, which must tell records apart
+// (see KeyColumns).
+func DiffSQL(d *TableDiff, meta *MetaFile) (forward, rollback string) {
+	table := TableName(meta)
+	var fw, rb strings.Builder
+
+	for _, row := range d.Added {
+		fw.WriteString(insertSQL(table, d.Columns, row))
+		rb.WriteString(deleteSQL(table, d, row))
+	}
+	for _, rc := range d.Changed {
+		fw.WriteString(updateSQL(table, d, rc.New, rc.Changes, false))
+		rb.WriteString(updateSQL(table, d, rc.Old, rc.Changes, true))
+	}
+	for _, row := range d.Removed {
+		fw.WriteString(deleteSQL(table, d, row))
+		rb.WriteString(insertSQL(table, d.Columns, row))
+	}
+	return fw.String(), rb.String()
+}
+
+// SQLValue renders a Row value as a MySQL literal for the given column.
+// Floats are written at float64 precision so they match the DECIMAL values
+// stored by ImportDBC and CHECKSUM TABLE stays stable across a rollback.
+func SQLValue(col Column, v interface{}) string {
+	switch col.Type {
+	case "string":
+		return escapeSQLString(toStringValue(v))
+	case "float":
+		return strconv.FormatFloat(float64(toFloat32Value(v)), 'g', -1, 64)
+	}
+	if v == nil {
+		v = zeroValue(col)
+	}
+	return FormatValue(v)
+}
+
+func insertSQL(table string, cols []Column, row Row) string {
+	return rowSQL("INSERT", table, cols, row)
+}
+
+// replaceSQL writes a whole row whether or not its key exists yet, so the
+// statement can run any number of times.
+func replaceSQL(table string, cols []Column, row Row) string {
+	return rowSQL("REPLACE", table, cols, row)
+}
+
+// upsertSQL adds row if its key is free, and otherwise sets only the set
+// columns of the record already there, so the statement can run any number of
+// times without clobbering columns it doesn't name.
+func upsertSQL(table string, cols []Column, row Row, keyCols, set []int) string {
+	var updates []string
+	for _, c := range set {
+		updates = append(updates, fmt.Sprintf("`%s` = VALUES(`%s`)", cols[c].Name, cols[c].Name))
+	}
+	if len(updates) == 0 {
+		// Nothing to set: a no-op assignment keeps an existing record as is
+		k := cols[keyCols[0]].Name
+		updates = append(updates, fmt.Sprintf("`%s` = `%s`", k, k))
+	}
+	insert := strings.TrimSuffix(rowSQL("INSERT", table, cols, row), ";\n")
+	return fmt.Sprintf("%s ON DUPLICATE KEY UPDATE %s;\n", insert, strings.Join(updates, ", "))
+}
+
+func rowSQL(verb, table string, cols []Column, row Row) string {
+	names := make([]string, len(cols))
+	values := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = "`" + col.Name + "`"
+		values[i] = SQLValue(col, row[i])
+	}
+	return fmt.Sprintf("%s INTO `%s` (%s) VALUES (%s);\n",
+		verb, table, strings.Join(names, ", "), strings.Join(values, ", "))
+}
+
+func deleteSQL(table string, d *TableDiff, row Row) string {
+	return fmt.Sprintf("DELETE FROM `%s` WHERE %s;\n", table, whereKey(d, row))
+}
+
+// updateSQL sets the changed columns to their new values, or to their old
+// values when reverse is true.
+func updateSQL(table string, d *TableDiff, row Row, changes []FieldChange, reverse bool) string {
+	byName := make(map[string]Column, len(d.Columns))
+	for _, col := range d.Columns {
+		byName[col.Name] = col
+	}
+	sets := make([]string, len(changes))
+	for i, fc := range changes {
+		v := fc.New
+		if reverse {
+			v = fc.Old
+		}
+		sets[i] = fmt.Sprintf("`%s` = %s", fc.Column, SQLValue(byName[fc.Column], v))
+	}
+	return fmt.Sprintf("UPDATE `%s` SET %s WHERE %s;\n", table, strings.Join(sets, ", "), whereKey(d, row))
+}
+
+// whereKey matches row on every key column. A diff without key columns
+// matches on all of the row's values instead, never on part of a key.
+func whereKey(d *TableDiff, row Row) string {
+	keyCols := d.KeyCols
+	if len(keyCols) == 0 {
+		for i := range d.Columns {
+			keyCols = append(keyCols, i)
+		}
+	}
+	parts := make([]string, len(keyCols))
+	for i, k := range keyCols {
+		col := d.Columns[k]
+		parts[i] = fmt.Sprintf("`%s` = %s", col.Name, SQLValue(col, row[k]))
+	}
+	return strings.Join(parts, " AND ")
+}
@@ -0,0 +1,294 @@
+package dbc
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EnumMeta gives symbolic names to the values of an integer field. As with
+// references, Field may name an array field's base name or a single element.
+type EnumMeta struct {
+	Field  string           `json:"field"`
+	Values map[string]int64 `json:"values"` // name → value
+}
+
+// FlagsMeta gives symbolic names to the bits of a bitmask field.
+type FlagsMeta struct {
+	Field string          `json:"field"`
+	Bits  map[string]uint `json:"bits"` // name → bit index (0-31)
+}
+
+type symbolName struct {
+	name  string
+	value int64
+}
+
+// symbolSet is the enum or flag names of one column, sorted by value.
+type symbolSet struct {
+	flags bool
+	names []symbolName
+}
+
+// Symbols renders a table's enum and flag columns with their symbolic names.
+type Symbols struct {
+	byColumn map[string]*symbolSet // lowercase column name
+}
+
+// NewSymbols collects the enums and flags a meta declares. It fails if one
+// names a field the meta doesn't have or a bit outside 0-31.
+func NewSymbols(meta *MetaFile) (*Symbols, error) {
+	s := &Symbols{byColumn: make(map[string]*symbolSet)}
+	cols := Columns(meta)
+	add := func(field string, set *symbolSet) error {
+		sort.Slice(set.names, func(i, j int) bool {
+			if set.names[i].value != set.names[j].value {
+				return set.names[i].value < set.names[j].value
+			}
+			return set.names[i].name < set.names[j].name
+		})
+		matched := false
+		for _, col := range cols {
+			if referenceCovers(field, col.Name) {
+				s.byColumn[strings.ToLower(col.Name)] = set
+				matched = true
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: enum/flags for unknown field %q", meta.File, field)
+		}
+		return nil
+	}
+
+	for _, e := range meta.Enums {
+		set := &symbolSet{}
+		for name, v := range e.Values {
+			set.names = append(set.names, symbolName{name, v})
+		}
+		if err := add(e.Field, set); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range meta.Flags {
+		set := &symbolSet{flags: true}
+		for name, bit := range f.Bits {
+			if bit > 31 {
+				return nil, fmt.Errorf("%s: flag %s: bit %d out of range", meta.File, name, bit)
+			}
+			set.names = append(set.names, symbolName{name, 1 << bit})
+		}
+		if err := add(f.Field, set); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Has reports whether a column has enum or flag names.
+func (s *Symbols) Has(column string) bool {
+	_, ok := s.byColumn[strings.ToLower(column)]
+	return ok
+}
+
+// IsFlags reports whether a column is a bitmask with flag names.
+func (s *Symbols) IsFlags(column string) bool {
+	set, ok := s.byColumn[strings.ToLower(column)]
+	return ok && set.flags
+}
+
+// Format renders a column value symbolically: the enum name, or the set flags
+// joined with "|" (with any unnamed bits as a trailing hex value). ok is false
+// when the column has no symbols or the value has no name.
+func (s *Symbols) Format(column string, v interface{}) (string, bool) {
+	set, ok := s.byColumn[strings.ToLower(column)]
+	if !ok {
+		return "", false
+	}
+	n := toInt64Value(v)
+	if !set.flags {
+		for _, sym := range set.names {
+			if sym.value == n {
+				return sym.name, true
+			}
+		}
+		return "", false
+	}
+
+	bits := uint32(n)
+	if bits == 0 {
+		return "", false
+	}
+	var parts []string
+	for _, sym := range set.names {
+		if bits&uint32(sym.value) != 0 {
+			parts = append(parts, sym.name)
+			bits &^= uint32(sym.value)
+		}
+	}
+	if len(parts) == 0 {
+		return "", false
+	}
+	if bits != 0 {
+		parts = append(parts, fmt.Sprintf("0x%X", bits))
+	}
+	return strings.Join(parts, "|"), true
+}
+
+// Value parses a column value written with its symbolic names: an enum name,
+// or flag names joined with "|" (numbers may be mixed in). ok is false when
+// the column has no symbols or text uses a name the column doesn't have.
+func (s *Symbols) Value(column, text string) (int64, bool) {
+	set, ok := s.byColumn[strings.ToLower(column)]
+	if !ok {
+		return 0, false
+	}
+	parts := []string{text}
+	if set.flags {
+		parts = strings.Split(text, "|")
+	}
+	var v int64
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		found := false
+		for _, sym := range set.names {
+			if sym.name == part {
+				v |= sym.value
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		n, err := strconv.ParseInt(trimHex(part), intBase(part), 64)
+		if err != nil || !set.flags {
+			return 0, false
+		}
+		v |= n
+	}
+	return v, true
+}
+
+// ParseSymbolicValue parses text as a value of col like ParseValue, also
+// accepting the column's enum and flag names.
+func ParseSymbolicValue(col Column, s *Symbols, text string) (interface{}, error) {
+	v, err := ParseValue(col, text)
+	if err == nil || s == nil {
+		return v, err
+	}
+	n, ok := s.Value(col.Name, text)
+	if !ok {
+		return nil, err
+	}
+	return ParseValue(col, strconv.FormatInt(n, 10))
+}
+
+// ResolveSymbols replaces enum and flag names in a SQL script with their
+// numeric values, so migrations can write
+//
+//	UPDATE spell SET attributes = attributes | SPELL_ATTR0_PASSIVE WHERE id = 133;
+//
+// Names are matched as whole, case-sensitive words outside string literals,
+// quoted identifiers and comments. A name defined with different values by
+// two metas is an error when used.
+func ResolveSymbols(script string, metas []*MetaFile) (string, error) {
+	values := make(map[string]int64)
+	ambiguous := make(map[string]bool)
+	define := func(name string, v int64) {
+		if old, ok := values[name]; ok && old != v {
+			ambiguous[name] = true
+		}
+		values[name] = v
+	}
+	for _, meta := range metas {
+		for _, e := range meta.Enums {
+			for name, v := range e.Values {
+				define(name, v)
+			}
+		}
+		for _, f := range meta.Flags {
+			for name, bit := range f.Bits {
+				define(name, 1<<bit)
+			}
+		}
+	}
+	if len(values) == 0 {
+		return script, nil
+	}
+
+	var out strings.Builder
+	out.Grow(len(script))
+	for i := 0; i < len(script); {
+		c := script[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := skipQuoted(script, i)
+			out.WriteString(script[i:end])
+			i = end
+		case c == '#' || (c == '-' && strings.HasPrefix(script[i:], "-- ")):
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				end = len(script) - i
+			}
+			out.WriteString(script[i : i+end])
+			i += end
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				end = len(script) - i
+			} else {
+				end += 4
+			}
+			out.WriteString(script[i : i+end])
+			i += end
+		case isIdentStart(c):
+			j := i + 1
+			for j < len(script) && isIdentPart(script[j]) {
+				j++
+			}
+			word := script[i:j]
+			if v, ok := values[word]; ok && (i == 0 || script[i-1] != '.' && script[i-1] != '@') {
+				if ambiguous[word] {
+					return "", fmt.Errorf("symbol %s is defined with different values by more than one DBC schema", word)
+				}
+				out.WriteString(strconv.FormatInt(v, 10))
+			} else {
+				out.WriteString(word)
+			}
+			i = j
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+	return out.String(), nil
+}
+
+// skipQuoted returns the index just past the quoted string starting at i,
+// honoring backslash escapes and doubled quotes.
+func skipQuoted(s string, i int) int {
+	q := s[i]
+	j := i + 1
+	for j < len(s) {
+		switch {
+		case s[j] == '\\' && q != '`':
+			j += 2
+		case s[j] == q && j+1 < len(s) && s[j+1] == q:
+			j += 2
+		case s[j] == q:
+			return j + 1
+		default:
+			j++
+		}
+	}
+	return len(s)
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9') || c == '$'
+}
@@ -0,0 +1,237 @@
+package dbc
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Text formats supported by DumpRows / LoadRows.
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// FormatFromPath returns the text format implied by a file extension, or "".
+func FormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV
+	case ".json":
+		return FormatJSON
+	}
+	return ""
+}
+
+// DumpRows writes rows in the given text format. Column names match the
+// MySQL columns, so dumps and SQL migrations use the same vocabulary.
+func DumpRows(w io.Writer, rows []Row, meta *MetaFile, format string) error {
+	switch format {
+	case FormatCSV:
+		return dumpCSV(w, rows, meta)
+	case FormatJSON:
+		return dumpJSON(w, rows, meta)
+	default:
+		return fmt.Errorf("unknown format: %s (expected csv or json)", format)
+	}
+}
+
+// LoadRows reads rows in the given text format. Columns missing from the
+// input are left nil (see MergeRows); unknown columns are an error.
+func LoadRows(r io.Reader, meta *MetaFile, format string) ([]Row, error) {
+	switch format {
+	case FormatCSV:
+		return loadCSV(r, meta)
+	case FormatJSON:
+		return loadJSON(r, meta)
+	default:
+		return nil, fmt.Errorf("unknown format: %s (expected csv or json)", format)
+	}
+}
+
+// LoadTextRows reads the rows of a CSV or JSON table file.
+func LoadTextRows(path string, meta *MetaFile) ([]Row, error) {
+	format := FormatFromPath(path)
+	if format == "" {
+		return nil, fmt.Errorf("unsupported file type: %s (expected .csv or .json)", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := LoadRows(bufio.NewReader(f), meta, format)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return rows, nil
+}
+
+func dumpCSV(w io.Writer, rows []Row, meta *MetaFile) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ColumnNames(meta)); err != nil {
+		return err
+	}
+	record := make([]string, len(Columns(meta)))
+	for _, row := range rows {
+		for i, v := range row {
+			record[i] = FormatValue(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func loadCSV(r io.Reader, meta *MetaFile) ([]Row, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read CSV header: %w", err)
+	}
+
+	cols := Columns(meta)
+	index, err := columnIndex(cols, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	line := 1
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		row := make(Row, len(cols))
+		for j, text := range record {
+			i := index[j]
+			v, err := ParseValue(cols[i], text)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			row[i] = v
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func dumpJSON(w io.Writer, rows []Row, meta *MetaFile) error {
+	names := ColumnNames(meta)
+	bw := bufio.NewWriter(w)
+	bw.WriteString("[")
+	for r, row := range rows {
+		if r > 0 {
+			bw.WriteString(",")
+		}
+		bw.WriteString("\n  {")
+		for i, v := range row {
+			if i > 0 {
+				bw.WriteString(", ")
+			}
+			key, _ := json.Marshal(names[i])
+			val, err := json.Marshal(jsonValue(v))
+			if err != nil {
+				return fmt.Errorf("encode %s: %w", names[i], err)
+			}
+			bw.Write(key)
+			bw.WriteString(": ")
+			bw.Write(val)
+		}
+		bw.WriteString("}")
+	}
+	if len(rows) > 0 {
+		bw.WriteString("\n")
+	}
+	bw.WriteString("]\n")
+	return bw.Flush()
+}
+
+// jsonValue maps float32 to a json.Number so the shortest float32 form is kept.
+func jsonValue(v interface{}) interface{} {
+	if f, ok := v.(float32); ok {
+		return json.Number(FormatValue(f))
+	}
+	return v
+}
+
+func loadJSON(r io.Reader, meta *MetaFile) ([]Row, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var objects []map[string]interface{}
+	if err := dec.Decode(&objects); err != nil {
+		return nil, fmt.Errorf("parse JSON: %w", err)
+	}
+
+	cols := Columns(meta)
+	byName := make(map[string]int, len(cols))
+	for i, c := range cols {
+		byName[c.Name] = i
+	}
+
+	rows := make([]Row, 0, len(objects))
+	for n, obj := range objects {
+		row := make(Row, len(cols))
+		for key, raw := range obj {
+			i, ok := byName[strings.ToLower(key)]
+			if !ok {
+				return nil, fmt.Errorf("record %d: unknown column %q", n, key)
+			}
+			text := ""
+			switch val := raw.(type) {
+			case nil:
+			case string:
+				text = val
+			case json.Number:
+				text = val.String()
+			case bool:
+				text = "0"
+				if val {
+					text = "1"
+				}
+			default:
+				return nil, fmt.Errorf("record %d: column %s has unsupported value %v", n, key, raw)
+			}
+			v, err := ParseValue(cols[i], text)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: %w", n, err)
+			}
+			row[i] = v
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// columnIndex maps input header positions to column positions.
+func columnIndex(cols []Column, header []string) ([]int, error) {
+	byName := make(map[string]int, len(cols))
+	for i, c := range cols {
+		byName[c.Name] = i
+	}
+	index := make([]int, len(header))
+	for j, h := range header {
+		i, ok := byName[strings.ToLower(strings.TrimSpace(h))]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", h)
+		}
+		index[j] = i
+	}
+	return index, nil
+}
@@ -0,0 +1,336 @@
+package dbc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TableEdits is the records a YAML edit file declares for one table, in file
+// order.
+type TableEdits struct {
+	Name  string // the table name as written in the file
+	Meta  *MetaFile
+	Edits []RecordEdit
+}
+
+// RecordEdit upserts or deletes one record, identified by its primary key.
+type RecordEdit struct {
+	Line      int  // line of the record in the YAML file
+	Values    Row  // the columns the edit sets, key included; nil = unchanged
+	CloneFrom Row  // key of the record to copy unset columns from, or nil
+	Delete    bool // remove the record instead
+}
+
+// IsEditFile reports whether a path is a YAML edit file.
+func IsEditFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// LoadEditFile reads a YAML edit file. Its top level maps table names (DBC
+// file or SQL table name) to one record or a list of records:
+//
+//	Spell:
+//	  - id: 90001
+//	    clone_from: 133
+//	    spell_name_enus: Greater Fireball
+//	  - id: 90002
+//	    delete: true
+//
+// Column names are the SQL column names. Enum and flag columns also take
+// their symbolic names (e.g. "SPELL_ATTR0_PASSIVE|SPELL_ATTR0_HIDDEN_CLIENTSIDE").
+func LoadEditFile(path string) ([]*TableEdits, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := ParseEdits(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return tables, nil
+}
+
+// ParseEdits parses the contents of a YAML edit file (see LoadEditFile).
+func ParseEdits(data []byte) ([]*TableEdits, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil // empty file
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: expected a mapping of table names to records", root.Line)
+	}
+
+	var tables []*TableEdits
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		name, value := root.Content[i], root.Content[i+1]
+		meta, err := metaForEditTable(name.Value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", name.Line, err)
+		}
+		te := &TableEdits{Name: name.Value, Meta: meta}
+
+		records := []*yaml.Node{value}
+		if value.Kind == yaml.SequenceNode {
+			records = value.Content
+		}
+		for _, rec := range records {
+			edit, err := parseRecordEdit(rec, meta)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name.Value, err)
+			}
+			te.Edits = append(te.Edits, edit)
+		}
+		tables = append(tables, te)
+	}
+	return tables, nil
+}
+
+// metaForEditTable finds a meta by DBC file name or SQL table name.
+func metaForEditTable(name string) (*MetaFile, error) {
+	if metas, err := AllMetas(); err == nil {
+		for _, meta := range metas {
+			if TableName(meta) == name {
+				return meta, nil
+			}
+		}
+	}
+	return GetMetaForDBC(name)
+}
+
+func parseRecordEdit(node *yaml.Node, meta *MetaFile) (RecordEdit, error) {
+	edit := RecordEdit{Line: node.Line}
+	if node.Kind != yaml.MappingNode {
+		return edit, fmt.Errorf("line %d: expected a record (column: value mapping)", node.Line)
+	}
+
+	cols := Columns(meta)
+	keyCols, err := KeyColumns(meta)
+	if err != nil {
+		return edit, err
+	}
+	byName := make(map[string]int, len(cols))
+	for i, c := range cols {
+		byName[c.Name] = i
+	}
+	symbols, err := NewSymbols(meta)
+	if err != nil {
+		return edit, err
+	}
+
+	edit.Values = make(Row, len(cols))
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if value.Kind != yaml.ScalarNode {
+			return edit, fmt.Errorf("line %d: %s must be a single value", value.Line, key.Value)
+		}
+		switch name := strings.ToLower(key.Value); name {
+		case "delete":
+			del, err := strconv.ParseBool(value.Value)
+			if err != nil {
+				return edit, fmt.Errorf("line %d: delete must be true or false", value.Line)
+			}
+			edit.Delete = del
+		case "clone_from":
+			if len(keyCols) != 1 {
+				return edit, fmt.Errorf("line %d: clone_from needs a single-column primary key", value.Line)
+			}
+			v, err := ParseValue(cols[keyCols[0]], value.Value)
+			if err != nil {
+				return edit, fmt.Errorf("line %d: clone_from: %w", value.Line, err)
+			}
+			edit.CloneFrom = make(Row, len(cols))
+			edit.CloneFrom[keyCols[0]] = v
+		default:
+			c, ok := byName[name]
+			if !ok {
+				return edit, fmt.Errorf("line %d: unknown column %q", key.Line, key.Value)
+			}
+			v, err := ParseSymbolicValue(cols[c], symbols, value.Value)
+			if err != nil {
+				return edit, fmt.Errorf("line %d: %w", value.Line, err)
+			}
+			edit.Values[c] = v
+		}
+	}
+
+	for _, k := range keyCols {
+		if edit.Values[k] == nil {
+			return edit, fmt.Errorf("line %d: record has no %s", node.Line, cols[k].Name)
+		}
+	}
+	if edit.Delete {
+		if edit.CloneFrom != nil {
+			return edit, fmt.Errorf("line %d: a deleted record can't have clone_from", node.Line)
+		}
+		for i, v := range edit.Values {
+			if v != nil && !isKeyIndex(keyCols, i) {
+				return edit, fmt.Errorf("line %d: a deleted record can only name its key, not %s", node.Line, cols[i].Name)
+			}
+		}
+	}
+	return edit, nil
+}
+
+// EditSQL compiles a table's edits against the baseline rows into a forward
+// script and a rollback script. Every statement is idempotent: a baseline
+// record is updated column by column, a cloned record is written whole with
+// REPLACE, any other record is inserted or, if something else added it
+// already, has just its declared columns set, and deletes are plain DELETEs.
+//
+// The rollback puts back the rows as they were before the forward script ran,
+// which is before; nil means the baseline. Records before doesn't have are
+// the ones the edits created, and only those are deleted.
+//
+// clone_from may name a baseline record or one declared earlier in the same
+// edits, but not one whose other columns the edits leave unknown.
+func EditSQL(te *TableEdits, base, before []Row) (forward, rollback string, err error) {
+	meta := te.Meta
+	table := TableName(meta)
+	keyCols, err := KeyColumns(meta)
+	if err != nil {
+		return "", "", err
+	}
+	d := &TableDiff{Columns: Columns(meta), KeyCols: keyCols}
+
+	baseByKey := make(map[string]Row, len(base))
+	for _, row := range base {
+		baseByKey[RowKey(row, d.KeyCols)] = row
+	}
+	beforeByKey := baseByKey
+	if before != nil {
+		beforeByKey = make(map[string]Row, len(before))
+		for _, row := range before {
+			beforeByKey[RowKey(row, d.KeyCols)] = row
+		}
+	}
+	state := make(map[string]Row) // records as the edits leave them; nil = deleted
+	current := func(key string) (Row, bool) {
+		if row, ok := state[key]; ok {
+			return row, row != nil
+		}
+		row, ok := baseByKey[key]
+		return row, ok
+	}
+
+	var fw strings.Builder
+	var touched []string
+	touchedRow := make(map[string]Row)
+	setCols := make(map[string]map[int]bool) // columns updated in place
+	rewritten := make(map[string]bool)       // written whole or deleted
+	partial := make(map[string]bool)         // upserted; undeclared columns unknown
+
+	for _, e := range te.Edits {
+		key := RowKey(e.Values, d.KeyCols)
+		if _, ok := touchedRow[key]; !ok {
+			touched = append(touched, key)
+			touchedRow[key] = e.Values
+			setCols[key] = make(map[int]bool)
+		}
+
+		if e.Delete {
+			fw.WriteString(deleteSQL(table, d, e.Values))
+			state[key] = nil
+			rewritten[key] = true
+			delete(partial, key)
+			continue
+		}
+
+		cur, exists := current(key)
+		if e.CloneFrom == nil && exists {
+			row := append(Row(nil), cur...)
+			var changes []FieldChange
+			for i, v := range e.Values {
+				if v == nil || isKeyIndex(d.KeyCols, i) {
+					continue
+				}
+				row[i] = v
+				changes = append(changes, FieldChange{Column: d.Columns[i].Name, Old: cur[i], New: v})
+				setCols[key][i] = true
+			}
+			if len(changes) > 0 {
+				fw.WriteString(updateSQL(table, d, row, changes, false))
+			}
+			state[key] = row
+			continue
+		}
+
+		row := make(Row, len(d.Columns))
+		if e.CloneFrom != nil {
+			srcKey := RowKey(e.CloneFrom, d.KeyCols)
+			src, ok := current(srcKey)
+			if !ok {
+				return "", "", fmt.Errorf("line %d: clone_from: no %s record with %s", e.Line, table, d.KeyString(e.CloneFrom))
+			}
+			if partial[srcKey] {
+				return "", "", fmt.Errorf("line %d: clone_from: %s record with %s is only partly declared", e.Line, table, d.KeyString(e.CloneFrom))
+			}
+			copy(row, src)
+		} else {
+			for i, col := range d.Columns {
+				row[i] = zeroValue(col)
+			}
+		}
+		var set []int
+		for i, v := range e.Values {
+			if v != nil {
+				row[i] = v
+				if !isKeyIndex(d.KeyCols, i) {
+					set = append(set, i)
+				}
+			}
+		}
+		state[key] = row
+		if e.CloneFrom != nil {
+			fw.WriteString(replaceSQL(table, d.Columns, row))
+			rewritten[key] = true
+			delete(partial, key)
+			continue
+		}
+		fw.WriteString(upsertSQL(table, d.Columns, row, d.KeyCols, set))
+		for _, c := range set {
+			setCols[key][c] = true
+		}
+		partial[key] = true
+	}
+
+	// Undo in reverse order, each record straight back to how it was before
+	var rb strings.Builder
+	for i := len(touched) - 1; i >= 0; i-- {
+		key := touched[i]
+		b, existed := beforeByKey[key]
+		switch {
+		case !existed:
+			rb.WriteString(deleteSQL(table, d, touchedRow[key]))
+		case rewritten[key]:
+			rb.WriteString(replaceSQL(table, d.Columns, b))
+		case len(setCols[key]) > 0:
+			var changes []FieldChange
+			for c := range d.Columns {
+				if setCols[key][c] {
+					changes = append(changes, FieldChange{Column: d.Columns[c].Name, Old: b[c], New: b[c]})
+				}
+			}
+			rb.WriteString(updateSQL(table, d, b, changes, false))
+		}
+	}
+	return fw.String(), rb.String(), nil
+}
+
+func isKeyIndex(keyCols []int, i int) bool {
+	for _, k := range keyCols {
+		if k == i {
+			return true
+		}
+	}
+	return false
+}
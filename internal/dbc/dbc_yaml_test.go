@@ -0,0 +1,49 @@
+package dbc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEditSQLRecordAddedOutsideTheEdits(t *testing.T) {
+	meta := &MetaFile{
+		File:        "Test.dbc",
+		PrimaryKeys: []string{"id"},
+		Fields: []FieldMeta{
+			{Name: "id", Type: "uint32"},
+			{Name: "flags", Type: "uint32"},
+			{Name: "cost", Type: "uint32"},
+		},
+	}
+	te := &TableEdits{Name: "Test", Meta: meta, Edits: []RecordEdit{
+		{Line: 1, Values: Row{uint32(5), uint32(8), nil}},
+		{Line: 2, Values: Row{uint32(6), uint32(1), nil}},
+	}}
+	base := []Row{{uint32(1), uint32(0), uint32(10)}}
+	// A migration added record 5 before the edits ran
+	before := append(append([]Row(nil), base...), Row{uint32(5), uint32(2), uint32(40)})
+
+	forward, rollback, err := EditSQL(te, base, before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantForward := "INSERT INTO `test` (`id`, `flags`, `cost`) VALUES (5, 8, 0) ON DUPLICATE KEY UPDATE `flags` = VALUES(`flags`);\n" +
+		"INSERT INTO `test` (`id`, `flags`, `cost`) VALUES (6, 1, 0) ON DUPLICATE KEY UPDATE `flags` = VALUES(`flags`);\n"
+	if forward != wantForward {
+		t.Fatalf("forward =\n%s\nwant\n%s", forward, wantForward)
+	}
+	wantRollback := "DELETE FROM `test` WHERE `id` = 6;\n" +
+		"UPDATE `test` SET `flags` = 2 WHERE `id` = 5;\n"
+	if rollback != wantRollback {
+		t.Fatalf("rollback =\n%s\nwant\n%s", rollback, wantRollback)
+	}
+
+	// Without the rows from before, both records are taken as the edits' own
+	_, rollback, err = EditSQL(te, base, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(rollback, "DELETE") != 2 {
+		t.Fatalf("rollback against the baseline =\n%s\nwant two DELETEs", rollback)
+	}
+}
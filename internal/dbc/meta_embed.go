@@ -46,8 +46,8 @@ func LoadEmbeddedMeta(name string) (*MetaFile, error) {
 	return &meta, nil
 }
 
-// GetMetaForDBC returns the meta file for a given DBC filename (e.g., "Spell" or "Spell.dbc").
-func GetMetaForDBC(dbcName string) (*MetaFile, error) {
+// getEmbeddedMetaForDBC returns the embedded meta file for a given DBC filename (e.g., "Spell" or "Spell.dbc").
+func getEmbeddedMetaForDBC(dbcName string) (*MetaFile, error) {
 	// Strip .dbc extension if present
 	name := strings.TrimSuffix(dbcName, ".dbc")
 	name = strings.TrimSuffix(name, ".DBC")
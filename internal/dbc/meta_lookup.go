@@ -0,0 +1,165 @@
+package dbc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// metaDirs are searched for *.meta.json files before the embedded metas,
+// highest precedence first.
+var metaDirs []string
+
+// SetMetaDirs sets the directories searched for meta overrides, highest
+// precedence first. A meta in one of these directories replaces the embedded
+// meta for the same DBC file; a meta for a file with no embedded meta adds a
+// custom table. Directories that don't exist are ignored.
+func SetMetaDirs(dirs ...string) {
+	metaDirs = dirs
+}
+
+// GetMetaForDBC returns the meta file for a given DBC filename (e.g., "Spell" or "Spell.dbc").
+// Override directories are searched first (see SetMetaDirs), then the embedded metas.
+func GetMetaForDBC(dbcName string) (*MetaFile, error) {
+	meta, _, err := findOverrideMeta(dbcName)
+	if err != nil {
+		return nil, err
+	}
+	if meta != nil {
+		return meta, nil
+	}
+	return getEmbeddedMetaForDBC(dbcName)
+}
+
+// MetaSource returns the path of the override meta used for a DBC file, or
+// "embedded" if the built-in meta is used.
+func MetaSource(dbcName string) string {
+	if _, path, err := findOverrideMeta(dbcName); err == nil && path != "" {
+		return path
+	}
+	return "embedded"
+}
+
+// IsCustomMeta reports whether a meta describes a DBC file that Mithril has no
+// embedded meta for, i.e. a table that only exists because a mod declared it.
+func IsCustomMeta(meta *MetaFile) bool {
+	_, err := getEmbeddedMetaForDBC(meta.File)
+	return err != nil
+}
+
+// AllMetas returns one meta per DBC file: overrides first, then the embedded
+// metas not overridden. Sorted by DBC filename.
+func AllMetas() ([]*MetaFile, error) {
+	seen := make(map[string]bool)
+	var metas []*MetaFile
+
+	for _, dir := range metaDirs {
+		paths, err := metaFilesIn(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			meta, err := loadOverrideMeta(path)
+			if err != nil {
+				return nil, err
+			}
+			key := strings.ToLower(meta.File)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			m := meta
+			metas = append(metas, &m)
+		}
+	}
+
+	files, err := GetEmbeddedMetaFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		meta, err := LoadEmbeddedMeta(file)
+		if err != nil {
+			continue
+		}
+		key := strings.ToLower(meta.File)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		metas = append(metas, meta)
+	}
+
+	sort.Slice(metas, func(i, j int) bool {
+		return strings.ToLower(metas[i].File) < strings.ToLower(metas[j].File)
+	})
+	return metas, nil
+}
+
+// findOverrideMeta searches the override directories for a DBC's meta, by
+// its "file" field or by the meta's base filename. Returns nil if none matches.
+func findOverrideMeta(dbcName string) (*MetaFile, string, error) {
+	name := strings.TrimSuffix(dbcName, filepath.Ext(dbcName))
+	if !strings.EqualFold(filepath.Ext(dbcName), ".dbc") {
+		name = dbcName
+	}
+
+	for _, dir := range metaDirs {
+		paths, err := metaFilesIn(dir)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, path := range paths {
+			base := strings.TrimSuffix(filepath.Base(path), ".meta.json")
+			meta, err := loadOverrideMeta(path)
+			if err != nil {
+				return nil, "", err
+			}
+			if strings.EqualFold(base, name) || strings.EqualFold(strings.TrimSuffix(meta.File, filepath.Ext(meta.File)), name) {
+				return &meta, path, nil
+			}
+		}
+	}
+	return nil, "", nil
+}
+
+// loadOverrideMeta loads a meta from disk and checks the fields every
+// consumer relies on.
+func loadOverrideMeta(path string) (MetaFile, error) {
+	meta, err := LoadMeta(path)
+	if err != nil {
+		return MetaFile{}, err
+	}
+	if meta.File == "" {
+		return MetaFile{}, fmt.Errorf("meta file %s: missing \"file\"", path)
+	}
+	if len(meta.Fields) == 0 {
+		return MetaFile{}, fmt.Errorf("meta file %s: no fields", path)
+	}
+	for _, f := range meta.Fields {
+		if _, err := sizeOf(f.Type); err != nil {
+			return MetaFile{}, fmt.Errorf("meta file %s: field %s: %w", path, f.Name, err)
+		}
+	}
+	return meta, nil
+}
+
+// metaFilesIn lists the *.meta.json files in dir, sorted. A missing dir is empty.
+func metaFilesIn(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read meta dir %s: %w", dir, err)
+	}
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(strings.ToLower(entry.Name()), ".meta.json") {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return paths, nil
+}
@@ -0,0 +1,88 @@
+// Package dbc reads and writes WoW 3.3.5a DBC files. It is the public,
+// importable face of the DBC code Mithril itself uses: the schema types,
+// schema lookup, and a streaming Reader and Writer.
+//
+//	meta, err := dbc.GetMetaForDBC("Spell")
+//	f, err := os.Open("DBFilesClient/Spell.dbc")
+//	r, err := dbc.NewReader(f, meta)
+//	for {
+//		row, err := r.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//	}
+//
+// Records are Rows: one value per Column, in record order, with strings
+// resolved. Values are int32, uint32, uint8, float32 or string. For typed
+// structs, see the records subpackage.
+//
+// This package follows the module's semantic versioning; packages under
+// internal/ do not.
+package dbc
+
+import (
+	idbc "github.com/suprsokr/mithril/internal/dbc"
+)
+
+// Meta is the schema of a DBC file, as stored in a *.meta.json file.
+type Meta = idbc.MetaFile
+
+// Field is one logical field of a Meta (an array or Loc field counts once).
+type Field = idbc.FieldMeta
+
+// Column is one flat column of a table: arrays are expanded to name_1,
+// name_2, ... and Loc fields to name_enus ... name_flags.
+type Column = idbc.Column
+
+// Row is a decoded record, one value per Column.
+type Row = idbc.Row
+
+// Header is the 20-byte header at the start of every DBC file.
+type Header = idbc.DBCHeader
+
+// LocLangs are the 16 locale slots and the flags slot of a Loc field. It is a
+// copy, so changing it doesn't change how columns are named.
+var LocLangs = append([]string(nil), idbc.LocLangs...)
+
+// GetMetaForDBC returns the schema for a DBC file, by name with or without
+// the .dbc extension ("Spell", "Spell.dbc"). Directories set with SetMetaDirs
+// are searched first, then the schemas embedded in the module.
+func GetMetaForDBC(name string) (*Meta, error) {
+	return idbc.GetMetaForDBC(name)
+}
+
+// SetMetaDirs sets directories of *.meta.json files that override or add to
+// the embedded schemas, highest precedence first.
+func SetMetaDirs(dirs ...string) {
+	idbc.SetMetaDirs(dirs...)
+}
+
+// AllMetas returns one schema per known DBC file, sorted by file name.
+func AllMetas() ([]*Meta, error) {
+	return idbc.AllMetas()
+}
+
+// LoadMeta reads a schema from a *.meta.json file.
+func LoadMeta(path string) (*Meta, error) {
+	meta, err := idbc.LoadMeta(path)
+	if err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// Columns returns a schema's flat column list in record order.
+func Columns(meta *Meta) []Column {
+	return idbc.Columns(meta)
+}
+
+// RecordSize returns the size in bytes of one record of a schema.
+func RecordSize(meta *Meta) uint32 {
+	return idbc.RecordSize(meta)
+}
+
+// FieldCount returns the field count a DBC header carries for a schema.
+func FieldCount(meta *Meta) uint32 {
+	return idbc.FieldCount(meta)
+}
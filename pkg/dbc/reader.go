@@ -0,0 +1,123 @@
+package dbc
+
+import (
+	"fmt"
+	"io"
+
+	idbc "github.com/suprsokr/mithril/internal/dbc"
+)
+
+// Reader reads the records of a DBC file on demand. Only the header and the
+// string block are held in memory; each record is read from the underlying
+// io.ReaderAt when it is asked for, so a Reader can serve random access as
+// well as a front-to-back scan.
+type Reader struct {
+	r           io.ReaderAt
+	meta        *Meta
+	cols        []Column
+	header      Header
+	stringBlock []byte
+	buf         []byte
+	next        int
+}
+
+// NewReader reads the header and string block of a DBC file and checks the
+// header's record size against the schema.
+func NewReader(r io.ReaderAt, meta *Meta) (*Reader, error) {
+	head := make([]byte, 20)
+	if err := readAt(r, head, 0); err != nil {
+		return nil, fmt.Errorf("%s: read header: %w", meta.File, err)
+	}
+	header, err := idbc.ParseHeader(head)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", meta.File, err)
+	}
+	if want := RecordSize(meta); header.RecordSize != want {
+		return nil, fmt.Errorf("%s: record size is %d bytes, schema expects %d", meta.File, header.RecordSize, want)
+	}
+
+	stringBlock := make([]byte, header.StringBlockSize)
+	offset := 20 + int64(header.RecordCount)*int64(header.RecordSize)
+	if err := readAt(r, stringBlock, offset); err != nil {
+		return nil, fmt.Errorf("%s: read string block: %w", meta.File, err)
+	}
+
+	return &Reader{
+		r:           r,
+		meta:        meta,
+		cols:        Columns(meta),
+		header:      header,
+		stringBlock: stringBlock,
+		buf:         make([]byte, header.RecordSize),
+	}, nil
+}
+
+// Header returns the file's header.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Meta returns the schema the file is read with.
+func (r *Reader) Meta() *Meta {
+	return r.meta
+}
+
+// Columns returns the columns of the Rows the Reader returns.
+func (r *Reader) Columns() []Column {
+	return r.cols
+}
+
+// Len returns the number of records in the file.
+func (r *Reader) Len() int {
+	return int(r.header.RecordCount)
+}
+
+// Row reads record i (0-based).
+func (r *Reader) Row(i int) (Row, error) {
+	if i < 0 || i >= r.Len() {
+		return nil, fmt.Errorf("%s: record %d out of range (%d records)", r.meta.File, i, r.Len())
+	}
+	offset := 20 + int64(i)*int64(r.header.RecordSize)
+	if err := readAt(r.r, r.buf, offset); err != nil {
+		return nil, fmt.Errorf("%s: read record %d: %w", r.meta.File, i, err)
+	}
+	rec, err := idbc.ParseRecord(r.buf, *r.meta)
+	if err != nil {
+		return nil, fmt.Errorf("%s: record %d: %w", r.meta.File, i, err)
+	}
+	return idbc.DecodeRow(rec, r.cols, r.stringBlock), nil
+}
+
+// Next reads the record after the one Next last returned, starting at the
+// first. It returns io.EOF after the last record.
+func (r *Reader) Next() (Row, error) {
+	if r.next >= r.Len() {
+		return nil, io.EOF
+	}
+	row, err := r.Row(r.next)
+	if err != nil {
+		return nil, err
+	}
+	r.next++
+	return row, nil
+}
+
+// String returns the string at an offset in the string block, or "" if the
+// offset is outside it.
+func (r *Reader) String(offset uint32) string {
+	return idbc.ReadString(r.stringBlock, offset)
+}
+
+// readAt fills buf from offset. io.ReaderAt may report io.EOF along with a
+// full read that ends at the end of the input, which is not an error here; an
+// empty buf (a file without strings) isn't read at all.
+func readAt(r io.ReaderAt, buf []byte, offset int64) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	n, err := r.ReadAt(buf, offset)
+	if err == io.EOF && n == len(buf) {
+		return nil
+	}
+	return err
+}
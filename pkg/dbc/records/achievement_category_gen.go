@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AchievementCategoryFile is the client file AchievementCategoryRecord is read from.
+const AchievementCategoryFile = "Achievement_Category.dbc"
+
+// AchievementCategoryRecord is one record of Achievement_Category.dbc.
+type AchievementCategoryRecord struct {
+	ID       uint32
+	ParentID int32
+	Name     Loc
+	UIOrder  uint32
+}
+
+// ReadAchievementCategory reads every record of Achievement_Category.dbc from r.
+func ReadAchievementCategory(r io.Reader) ([]AchievementCategoryRecord, error) {
+	return readRecords[AchievementCategoryRecord](r, AchievementCategoryFile, 80)
+}
+
+// WriteAchievementCategory writes records to w in Achievement_Category.dbc format.
+func WriteAchievementCategory(w io.Writer, records []AchievementCategoryRecord) error {
+	return writeRecords(w, records, 80, 20)
+}
+
+func (r *AchievementCategoryRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.ParentID = d.int32()
+	r.Name = d.loc()
+	r.UIOrder = d.uint32()
+}
+
+func (r *AchievementCategoryRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.ParentID)
+	e.loc(r.Name)
+	e.uint32(r.UIOrder)
+}
@@ -0,0 +1,73 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AchievementCriteriaFile is the client file AchievementCriteriaRecord is read from.
+const AchievementCriteriaFile = "Achievement_Criteria.dbc"
+
+// AchievementCriteriaRecord is one record of Achievement_Criteria.dbc.
+type AchievementCriteriaRecord struct {
+	ID              uint32
+	AchievementID   uint32
+	Type            uint32
+	ReqAssetID      uint32
+	ReqAssetCount   uint32
+	StartEvent      uint32
+	StartAssetID    uint32
+	FailEvent       uint32
+	FailAssetID     uint32
+	Desc            Loc
+	Flags           uint32
+	TimerStartEvent uint32
+	TimerAssetID    uint32
+	TimerLimit      uint32
+	UIOrder         uint32
+}
+
+// ReadAchievementCriteria reads every record of Achievement_Criteria.dbc from r.
+func ReadAchievementCriteria(r io.Reader) ([]AchievementCriteriaRecord, error) {
+	return readRecords[AchievementCriteriaRecord](r, AchievementCriteriaFile, 124)
+}
+
+// WriteAchievementCriteria writes records to w in Achievement_Criteria.dbc format.
+func WriteAchievementCriteria(w io.Writer, records []AchievementCriteriaRecord) error {
+	return writeRecords(w, records, 124, 31)
+}
+
+func (r *AchievementCriteriaRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.AchievementID = d.uint32()
+	r.Type = d.uint32()
+	r.ReqAssetID = d.uint32()
+	r.ReqAssetCount = d.uint32()
+	r.StartEvent = d.uint32()
+	r.StartAssetID = d.uint32()
+	r.FailEvent = d.uint32()
+	r.FailAssetID = d.uint32()
+	r.Desc = d.loc()
+	r.Flags = d.uint32()
+	r.TimerStartEvent = d.uint32()
+	r.TimerAssetID = d.uint32()
+	r.TimerLimit = d.uint32()
+	r.UIOrder = d.uint32()
+}
+
+func (r *AchievementCriteriaRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.AchievementID)
+	e.uint32(r.Type)
+	e.uint32(r.ReqAssetID)
+	e.uint32(r.ReqAssetCount)
+	e.uint32(r.StartEvent)
+	e.uint32(r.StartAssetID)
+	e.uint32(r.FailEvent)
+	e.uint32(r.FailAssetID)
+	e.loc(r.Desc)
+	e.uint32(r.Flags)
+	e.uint32(r.TimerStartEvent)
+	e.uint32(r.TimerAssetID)
+	e.uint32(r.TimerLimit)
+	e.uint32(r.UIOrder)
+}
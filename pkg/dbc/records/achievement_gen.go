@@ -0,0 +1,70 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AchievementFile is the client file AchievementRecord is read from.
+const AchievementFile = "Achievement.dbc"
+
+// AchievementRecord is one record of Achievement.dbc.
+type AchievementRecord struct {
+	ID                uint32
+	Faction           int32
+	Map               int32
+	Previous          uint32
+	Name              Loc
+	Desc              Loc
+	Category          uint32
+	Points            uint32
+	OrderInCategory   uint32
+	Flags             uint32
+	SpellIcon         uint32
+	Reward            Loc
+	MinCriteriaDemand uint32
+	LinkedAchievement uint32
+}
+
+// ReadAchievement reads every record of Achievement.dbc from r.
+func ReadAchievement(r io.Reader) ([]AchievementRecord, error) {
+	return readRecords[AchievementRecord](r, AchievementFile, 248)
+}
+
+// WriteAchievement writes records to w in Achievement.dbc format.
+func WriteAchievement(w io.Writer, records []AchievementRecord) error {
+	return writeRecords(w, records, 248, 62)
+}
+
+func (r *AchievementRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Faction = d.int32()
+	r.Map = d.int32()
+	r.Previous = d.uint32()
+	r.Name = d.loc()
+	r.Desc = d.loc()
+	r.Category = d.uint32()
+	r.Points = d.uint32()
+	r.OrderInCategory = d.uint32()
+	r.Flags = d.uint32()
+	r.SpellIcon = d.uint32()
+	r.Reward = d.loc()
+	r.MinCriteriaDemand = d.uint32()
+	r.LinkedAchievement = d.uint32()
+}
+
+func (r *AchievementRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Faction)
+	e.int32(r.Map)
+	e.uint32(r.Previous)
+	e.loc(r.Name)
+	e.loc(r.Desc)
+	e.uint32(r.Category)
+	e.uint32(r.Points)
+	e.uint32(r.OrderInCategory)
+	e.uint32(r.Flags)
+	e.uint32(r.SpellIcon)
+	e.loc(r.Reward)
+	e.uint32(r.MinCriteriaDemand)
+	e.uint32(r.LinkedAchievement)
+}
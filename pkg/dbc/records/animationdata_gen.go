@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AnimationDataFile is the client file AnimationDataRecord is read from.
+const AnimationDataFile = "AnimationData.dbc"
+
+// AnimationDataRecord is one record of AnimationData.dbc.
+type AnimationDataRecord struct {
+	ID             uint32
+	Name           string
+	WepFlags       uint32
+	BodyFlags      uint32
+	Flags          uint32
+	FallbackAnimID uint32
+	BehaviorID     uint32
+	BehaviorTier   uint32
+}
+
+// ReadAnimationData reads every record of AnimationData.dbc from r.
+func ReadAnimationData(r io.Reader) ([]AnimationDataRecord, error) {
+	return readRecords[AnimationDataRecord](r, AnimationDataFile, 32)
+}
+
+// WriteAnimationData writes records to w in AnimationData.dbc format.
+func WriteAnimationData(w io.Writer, records []AnimationDataRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *AnimationDataRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.string()
+	r.WepFlags = d.uint32()
+	r.BodyFlags = d.uint32()
+	r.Flags = d.uint32()
+	r.FallbackAnimID = d.uint32()
+	r.BehaviorID = d.uint32()
+	r.BehaviorTier = d.uint32()
+}
+
+func (r *AnimationDataRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Name)
+	e.uint32(r.WepFlags)
+	e.uint32(r.BodyFlags)
+	e.uint32(r.Flags)
+	e.uint32(r.FallbackAnimID)
+	e.uint32(r.BehaviorID)
+	e.uint32(r.BehaviorTier)
+}
@@ -0,0 +1,41 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AreaGroupFile is the client file AreaGroupRecord is read from.
+const AreaGroupFile = "AreaGroup.dbc"
+
+// AreaGroupRecord is one record of AreaGroup.dbc.
+type AreaGroupRecord struct {
+	ID             uint32
+	AreaID         [6]uint32
+	ChildAreaGroup uint32
+}
+
+// ReadAreaGroup reads every record of AreaGroup.dbc from r.
+func ReadAreaGroup(r io.Reader) ([]AreaGroupRecord, error) {
+	return readRecords[AreaGroupRecord](r, AreaGroupFile, 32)
+}
+
+// WriteAreaGroup writes records to w in AreaGroup.dbc format.
+func WriteAreaGroup(w io.Writer, records []AreaGroupRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *AreaGroupRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.AreaID {
+		r.AreaID[i] = d.uint32()
+	}
+	r.ChildAreaGroup = d.uint32()
+}
+
+func (r *AreaGroupRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.AreaID {
+		e.uint32(v)
+	}
+	e.uint32(r.ChildAreaGroup)
+}
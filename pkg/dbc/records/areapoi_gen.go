@@ -0,0 +1,74 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AreaPOIFile is the client file AreaPOIRecord is read from.
+const AreaPOIFile = "AreaPOI.dbc"
+
+// AreaPOIRecord is one record of AreaPOI.dbc.
+type AreaPOIRecord struct {
+	ID           uint32
+	Importance   uint32
+	Icon         [9]uint32
+	FactionID    uint32
+	X            float32
+	Y            float32
+	Z            float32
+	MapID        uint32
+	Flags        uint32
+	AreaID       int32
+	Name         Loc
+	Description  Loc
+	WorldStateID uint32
+	WorldMapLink uint32
+}
+
+// ReadAreaPOI reads every record of AreaPOI.dbc from r.
+func ReadAreaPOI(r io.Reader) ([]AreaPOIRecord, error) {
+	return readRecords[AreaPOIRecord](r, AreaPOIFile, 216)
+}
+
+// WriteAreaPOI writes records to w in AreaPOI.dbc format.
+func WriteAreaPOI(w io.Writer, records []AreaPOIRecord) error {
+	return writeRecords(w, records, 216, 54)
+}
+
+func (r *AreaPOIRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Importance = d.uint32()
+	for i := range r.Icon {
+		r.Icon[i] = d.uint32()
+	}
+	r.FactionID = d.uint32()
+	r.X = d.float32()
+	r.Y = d.float32()
+	r.Z = d.float32()
+	r.MapID = d.uint32()
+	r.Flags = d.uint32()
+	r.AreaID = d.int32()
+	r.Name = d.loc()
+	r.Description = d.loc()
+	r.WorldStateID = d.uint32()
+	r.WorldMapLink = d.uint32()
+}
+
+func (r *AreaPOIRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Importance)
+	for _, v := range r.Icon {
+		e.uint32(v)
+	}
+	e.uint32(r.FactionID)
+	e.float32(r.X)
+	e.float32(r.Y)
+	e.float32(r.Z)
+	e.uint32(r.MapID)
+	e.uint32(r.Flags)
+	e.int32(r.AreaID)
+	e.loc(r.Name)
+	e.loc(r.Description)
+	e.uint32(r.WorldStateID)
+	e.uint32(r.WorldMapLink)
+}
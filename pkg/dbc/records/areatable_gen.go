@@ -0,0 +1,83 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AreaTableFile is the client file AreaTableRecord is read from.
+const AreaTableFile = "AreaTable.dbc"
+
+// AreaTableRecord is one record of AreaTable.dbc.
+type AreaTableRecord struct {
+	ID                          uint32
+	MapID                       uint32
+	ZoneID                      uint32
+	AreaBit                     uint32
+	Flags                       uint32
+	SoundProviderPref           uint32
+	SoundProviderPrefUnderwater uint32
+	Ambience                    uint32
+	ZoneMusic                   uint32
+	ZoneMusicIntro              uint32
+	ExplorationLevel            uint32
+	Name                        Loc
+	FactionGroup                uint32
+	LiquidType                  [4]uint32
+	MinElevation                float32
+	LightAmbientMultiplier      float32
+	LightID                     uint32
+}
+
+// ReadAreaTable reads every record of AreaTable.dbc from r.
+func ReadAreaTable(r io.Reader) ([]AreaTableRecord, error) {
+	return readRecords[AreaTableRecord](r, AreaTableFile, 144)
+}
+
+// WriteAreaTable writes records to w in AreaTable.dbc format.
+func WriteAreaTable(w io.Writer, records []AreaTableRecord) error {
+	return writeRecords(w, records, 144, 36)
+}
+
+func (r *AreaTableRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MapID = d.uint32()
+	r.ZoneID = d.uint32()
+	r.AreaBit = d.uint32()
+	r.Flags = d.uint32()
+	r.SoundProviderPref = d.uint32()
+	r.SoundProviderPrefUnderwater = d.uint32()
+	r.Ambience = d.uint32()
+	r.ZoneMusic = d.uint32()
+	r.ZoneMusicIntro = d.uint32()
+	r.ExplorationLevel = d.uint32()
+	r.Name = d.loc()
+	r.FactionGroup = d.uint32()
+	for i := range r.LiquidType {
+		r.LiquidType[i] = d.uint32()
+	}
+	r.MinElevation = d.float32()
+	r.LightAmbientMultiplier = d.float32()
+	r.LightID = d.uint32()
+}
+
+func (r *AreaTableRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.MapID)
+	e.uint32(r.ZoneID)
+	e.uint32(r.AreaBit)
+	e.uint32(r.Flags)
+	e.uint32(r.SoundProviderPref)
+	e.uint32(r.SoundProviderPrefUnderwater)
+	e.uint32(r.Ambience)
+	e.uint32(r.ZoneMusic)
+	e.uint32(r.ZoneMusicIntro)
+	e.uint32(r.ExplorationLevel)
+	e.loc(r.Name)
+	e.uint32(r.FactionGroup)
+	for _, v := range r.LiquidType {
+		e.uint32(v)
+	}
+	e.float32(r.MinElevation)
+	e.float32(r.LightAmbientMultiplier)
+	e.uint32(r.LightID)
+}
@@ -0,0 +1,58 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AreaTriggerFile is the client file AreaTriggerRecord is read from.
+const AreaTriggerFile = "AreaTrigger.dbc"
+
+// AreaTriggerRecord is one record of AreaTrigger.dbc.
+type AreaTriggerRecord struct {
+	ID        uint32
+	MapID     uint32
+	X         float32
+	Y         float32
+	Z         float32
+	Radius    float32
+	BoxLength float32
+	BoxWidth  float32
+	BoxHeight float32
+	BoxYaw    float32
+}
+
+// ReadAreaTrigger reads every record of AreaTrigger.dbc from r.
+func ReadAreaTrigger(r io.Reader) ([]AreaTriggerRecord, error) {
+	return readRecords[AreaTriggerRecord](r, AreaTriggerFile, 40)
+}
+
+// WriteAreaTrigger writes records to w in AreaTrigger.dbc format.
+func WriteAreaTrigger(w io.Writer, records []AreaTriggerRecord) error {
+	return writeRecords(w, records, 40, 10)
+}
+
+func (r *AreaTriggerRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MapID = d.uint32()
+	r.X = d.float32()
+	r.Y = d.float32()
+	r.Z = d.float32()
+	r.Radius = d.float32()
+	r.BoxLength = d.float32()
+	r.BoxWidth = d.float32()
+	r.BoxHeight = d.float32()
+	r.BoxYaw = d.float32()
+}
+
+func (r *AreaTriggerRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.MapID)
+	e.float32(r.X)
+	e.float32(r.Y)
+	e.float32(r.Z)
+	e.float32(r.Radius)
+	e.float32(r.BoxLength)
+	e.float32(r.BoxWidth)
+	e.float32(r.BoxHeight)
+	e.float32(r.BoxYaw)
+}
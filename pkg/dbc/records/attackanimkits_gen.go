@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AttackAnimKitsFile is the client file AttackAnimKitsRecord is read from.
+const AttackAnimKitsFile = "AttackAnimKits.dbc"
+
+// AttackAnimKitsRecord is one record of AttackAnimKits.dbc.
+type AttackAnimKitsRecord struct {
+	ID        uint32
+	Animation uint32
+	Type      uint32
+	Flags     uint32
+	WhichHand uint32
+}
+
+// ReadAttackAnimKits reads every record of AttackAnimKits.dbc from r.
+func ReadAttackAnimKits(r io.Reader) ([]AttackAnimKitsRecord, error) {
+	return readRecords[AttackAnimKitsRecord](r, AttackAnimKitsFile, 20)
+}
+
+// WriteAttackAnimKits writes records to w in AttackAnimKits.dbc format.
+func WriteAttackAnimKits(w io.Writer, records []AttackAnimKitsRecord) error {
+	return writeRecords(w, records, 20, 5)
+}
+
+func (r *AttackAnimKitsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Animation = d.uint32()
+	r.Type = d.uint32()
+	r.Flags = d.uint32()
+	r.WhichHand = d.uint32()
+}
+
+func (r *AttackAnimKitsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Animation)
+	e.uint32(r.Type)
+	e.uint32(r.Flags)
+	e.uint32(r.WhichHand)
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AttackAnimTypesFile is the client file AttackAnimTypesRecord is read from.
+const AttackAnimTypesFile = "AttackAnimTypes.dbc"
+
+// AttackAnimTypesRecord is one record of AttackAnimTypes.dbc.
+type AttackAnimTypesRecord struct {
+	ID   uint32
+	Name string
+}
+
+// ReadAttackAnimTypes reads every record of AttackAnimTypes.dbc from r.
+func ReadAttackAnimTypes(r io.Reader) ([]AttackAnimTypesRecord, error) {
+	return readRecords[AttackAnimTypesRecord](r, AttackAnimTypesFile, 8)
+}
+
+// WriteAttackAnimTypes writes records to w in AttackAnimTypes.dbc format.
+func WriteAttackAnimTypes(w io.Writer, records []AttackAnimTypesRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *AttackAnimTypesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.string()
+}
+
+func (r *AttackAnimTypesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Name)
+}
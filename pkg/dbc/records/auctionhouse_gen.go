@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// AuctionHouseFile is the client file AuctionHouseRecord is read from.
+const AuctionHouseFile = "AuctionHouse.dbc"
+
+// AuctionHouseRecord is one record of AuctionHouse.dbc.
+type AuctionHouseRecord struct {
+	ID              uint32
+	FactionID       uint32
+	DepositRate     uint32
+	ConsignmentRate uint32
+	Name            Loc
+}
+
+// ReadAuctionHouse reads every record of AuctionHouse.dbc from r.
+func ReadAuctionHouse(r io.Reader) ([]AuctionHouseRecord, error) {
+	return readRecords[AuctionHouseRecord](r, AuctionHouseFile, 84)
+}
+
+// WriteAuctionHouse writes records to w in AuctionHouse.dbc format.
+func WriteAuctionHouse(w io.Writer, records []AuctionHouseRecord) error {
+	return writeRecords(w, records, 84, 21)
+}
+
+func (r *AuctionHouseRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.FactionID = d.uint32()
+	r.DepositRate = d.uint32()
+	r.ConsignmentRate = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *AuctionHouseRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.FactionID)
+	e.uint32(r.DepositRate)
+	e.uint32(r.ConsignmentRate)
+	e.loc(r.Name)
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// BankbagslotpricesFile is the client file BankbagslotpricesRecord is read from.
+const BankbagslotpricesFile = "Bankbagslotprices.dbc"
+
+// BankbagslotpricesRecord is one record of Bankbagslotprices.dbc.
+type BankbagslotpricesRecord struct {
+	ID   uint32
+	Cost int32
+}
+
+// ReadBankbagslotprices reads every record of Bankbagslotprices.dbc from r.
+func ReadBankbagslotprices(r io.Reader) ([]BankbagslotpricesRecord, error) {
+	return readRecords[BankbagslotpricesRecord](r, BankbagslotpricesFile, 8)
+}
+
+// WriteBankbagslotprices writes records to w in Bankbagslotprices.dbc format.
+func WriteBankbagslotprices(w io.Writer, records []BankbagslotpricesRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *BankbagslotpricesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Cost = d.int32()
+}
+
+func (r *BankbagslotpricesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Cost)
+}
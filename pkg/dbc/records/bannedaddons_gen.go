@@ -0,0 +1,51 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// BannedAddOnsFile is the client file BannedAddOnsRecord is read from.
+const BannedAddOnsFile = "BannedAddOns.dbc"
+
+// BannedAddOnsRecord is one record of BannedAddOns.dbc.
+type BannedAddOnsRecord struct {
+	ID           uint32
+	NameMd5      [4]uint32
+	VersionMd5   [4]uint32
+	LastModified uint32
+	Flags        uint32
+}
+
+// ReadBannedAddOns reads every record of BannedAddOns.dbc from r.
+func ReadBannedAddOns(r io.Reader) ([]BannedAddOnsRecord, error) {
+	return readRecords[BannedAddOnsRecord](r, BannedAddOnsFile, 44)
+}
+
+// WriteBannedAddOns writes records to w in BannedAddOns.dbc format.
+func WriteBannedAddOns(w io.Writer, records []BannedAddOnsRecord) error {
+	return writeRecords(w, records, 44, 11)
+}
+
+func (r *BannedAddOnsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.NameMd5 {
+		r.NameMd5[i] = d.uint32()
+	}
+	for i := range r.VersionMd5 {
+		r.VersionMd5[i] = d.uint32()
+	}
+	r.LastModified = d.uint32()
+	r.Flags = d.uint32()
+}
+
+func (r *BannedAddOnsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.NameMd5 {
+		e.uint32(v)
+	}
+	for _, v := range r.VersionMd5 {
+		e.uint32(v)
+	}
+	e.uint32(r.LastModified)
+	e.uint32(r.Flags)
+}
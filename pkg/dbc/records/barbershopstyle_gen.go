@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// BarberShopStyleFile is the client file BarberShopStyleRecord is read from.
+const BarberShopStyleFile = "BarberShopStyle.dbc"
+
+// BarberShopStyleRecord is one record of BarberShopStyle.dbc.
+type BarberShopStyleRecord struct {
+	ID           uint32
+	Type         uint32
+	DisplayName  Loc
+	Description  Loc
+	CostModifier float32
+	Race         uint32
+	Gender       uint32
+	Data         uint32
+}
+
+// ReadBarberShopStyle reads every record of BarberShopStyle.dbc from r.
+func ReadBarberShopStyle(r io.Reader) ([]BarberShopStyleRecord, error) {
+	return readRecords[BarberShopStyleRecord](r, BarberShopStyleFile, 160)
+}
+
+// WriteBarberShopStyle writes records to w in BarberShopStyle.dbc format.
+func WriteBarberShopStyle(w io.Writer, records []BarberShopStyleRecord) error {
+	return writeRecords(w, records, 160, 40)
+}
+
+func (r *BarberShopStyleRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Type = d.uint32()
+	r.DisplayName = d.loc()
+	r.Description = d.loc()
+	r.CostModifier = d.float32()
+	r.Race = d.uint32()
+	r.Gender = d.uint32()
+	r.Data = d.uint32()
+}
+
+func (r *BarberShopStyleRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Type)
+	e.loc(r.DisplayName)
+	e.loc(r.Description)
+	e.float32(r.CostModifier)
+	e.uint32(r.Race)
+	e.uint32(r.Gender)
+	e.uint32(r.Data)
+}
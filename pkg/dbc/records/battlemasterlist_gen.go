@@ -0,0 +1,59 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// BattlemasterListFile is the client file BattlemasterListRecord is read from.
+const BattlemasterListFile = "BattlemasterList.dbc"
+
+// BattlemasterListRecord is one record of BattlemasterList.dbc.
+type BattlemasterListRecord struct {
+	ID                uint32
+	MapID             [8]int32
+	InstanceType      uint32
+	GroupsAllowed     uint32
+	Name              Loc
+	MaxGroupSize      uint32
+	HolidayWorldState uint32
+	MinLevel          uint32
+	MaxLevel          uint32
+}
+
+// ReadBattlemasterList reads every record of BattlemasterList.dbc from r.
+func ReadBattlemasterList(r io.Reader) ([]BattlemasterListRecord, error) {
+	return readRecords[BattlemasterListRecord](r, BattlemasterListFile, 128)
+}
+
+// WriteBattlemasterList writes records to w in BattlemasterList.dbc format.
+func WriteBattlemasterList(w io.Writer, records []BattlemasterListRecord) error {
+	return writeRecords(w, records, 128, 32)
+}
+
+func (r *BattlemasterListRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.MapID {
+		r.MapID[i] = d.int32()
+	}
+	r.InstanceType = d.uint32()
+	r.GroupsAllowed = d.uint32()
+	r.Name = d.loc()
+	r.MaxGroupSize = d.uint32()
+	r.HolidayWorldState = d.uint32()
+	r.MinLevel = d.uint32()
+	r.MaxLevel = d.uint32()
+}
+
+func (r *BattlemasterListRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.MapID {
+		e.int32(v)
+	}
+	e.uint32(r.InstanceType)
+	e.uint32(r.GroupsAllowed)
+	e.loc(r.Name)
+	e.uint32(r.MaxGroupSize)
+	e.uint32(r.HolidayWorldState)
+	e.uint32(r.MinLevel)
+	e.uint32(r.MaxLevel)
+}
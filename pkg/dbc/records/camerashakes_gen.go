@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CameraShakesFile is the client file CameraShakesRecord is read from.
+const CameraShakesFile = "CameraShakes.dbc"
+
+// CameraShakesRecord is one record of CameraShakes.dbc.
+type CameraShakesRecord struct {
+	ID          uint32
+	ShakeType   uint32
+	Direction   uint32
+	Amplitude   float32
+	Frequency   float32
+	Duration    float32
+	Phase       float32
+	Coefficient float32
+}
+
+// ReadCameraShakes reads every record of CameraShakes.dbc from r.
+func ReadCameraShakes(r io.Reader) ([]CameraShakesRecord, error) {
+	return readRecords[CameraShakesRecord](r, CameraShakesFile, 32)
+}
+
+// WriteCameraShakes writes records to w in CameraShakes.dbc format.
+func WriteCameraShakes(w io.Writer, records []CameraShakesRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *CameraShakesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.ShakeType = d.uint32()
+	r.Direction = d.uint32()
+	r.Amplitude = d.float32()
+	r.Frequency = d.float32()
+	r.Duration = d.float32()
+	r.Phase = d.float32()
+	r.Coefficient = d.float32()
+}
+
+func (r *CameraShakesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.ShakeType)
+	e.uint32(r.Direction)
+	e.float32(r.Amplitude)
+	e.float32(r.Frequency)
+	e.float32(r.Duration)
+	e.float32(r.Phase)
+	e.float32(r.Coefficient)
+}
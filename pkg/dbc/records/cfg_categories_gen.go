@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CfgCategoriesFile is the client file CfgCategoriesRecord is read from.
+const CfgCategoriesFile = "Cfg_Categories.dbc"
+
+// CfgCategoriesRecord is one record of Cfg_Categories.dbc.
+type CfgCategoriesRecord struct {
+	ID          uint32
+	LocaleMask  uint32
+	CharsetMask uint32
+	Flags       uint32
+	Name        Loc
+}
+
+// ReadCfgCategories reads every record of Cfg_Categories.dbc from r.
+func ReadCfgCategories(r io.Reader) ([]CfgCategoriesRecord, error) {
+	return readRecords[CfgCategoriesRecord](r, CfgCategoriesFile, 84)
+}
+
+// WriteCfgCategories writes records to w in Cfg_Categories.dbc format.
+func WriteCfgCategories(w io.Writer, records []CfgCategoriesRecord) error {
+	return writeRecords(w, records, 84, 21)
+}
+
+func (r *CfgCategoriesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.LocaleMask = d.uint32()
+	r.CharsetMask = d.uint32()
+	r.Flags = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *CfgCategoriesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.LocaleMask)
+	e.uint32(r.CharsetMask)
+	e.uint32(r.Flags)
+	e.loc(r.Name)
+}
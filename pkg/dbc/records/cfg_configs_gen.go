@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CfgConfigsFile is the client file CfgConfigsRecord is read from.
+const CfgConfigsFile = "Cfg_Configs.dbc"
+
+// CfgConfigsRecord is one record of Cfg_Configs.dbc.
+type CfgConfigsRecord struct {
+	ID                   uint32
+	RealmType            uint32
+	PlayerKillingAllowed uint32
+	Roleplaying          uint32
+}
+
+// ReadCfgConfigs reads every record of Cfg_Configs.dbc from r.
+func ReadCfgConfigs(r io.Reader) ([]CfgConfigsRecord, error) {
+	return readRecords[CfgConfigsRecord](r, CfgConfigsFile, 16)
+}
+
+// WriteCfgConfigs writes records to w in Cfg_Configs.dbc format.
+func WriteCfgConfigs(w io.Writer, records []CfgConfigsRecord) error {
+	return writeRecords(w, records, 16, 4)
+}
+
+func (r *CfgConfigsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.RealmType = d.uint32()
+	r.PlayerKillingAllowed = d.uint32()
+	r.Roleplaying = d.uint32()
+}
+
+func (r *CfgConfigsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.RealmType)
+	e.uint32(r.PlayerKillingAllowed)
+	e.uint32(r.Roleplaying)
+}
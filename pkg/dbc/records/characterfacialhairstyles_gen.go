@@ -0,0 +1,44 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CharacterFacialHairStylesFile is the client file CharacterFacialHairStylesRecord is read from.
+const CharacterFacialHairStylesFile = "CharacterFacialHairStyles.dbc"
+
+// CharacterFacialHairStylesRecord is one record of CharacterFacialHairStyles.dbc.
+type CharacterFacialHairStylesRecord struct {
+	Race        uint32
+	Gender      uint32
+	VariationID uint32
+	Geoset      [5]uint32
+}
+
+// ReadCharacterFacialHairStyles reads every record of CharacterFacialHairStyles.dbc from r.
+func ReadCharacterFacialHairStyles(r io.Reader) ([]CharacterFacialHairStylesRecord, error) {
+	return readRecords[CharacterFacialHairStylesRecord](r, CharacterFacialHairStylesFile, 32)
+}
+
+// WriteCharacterFacialHairStyles writes records to w in CharacterFacialHairStyles.dbc format.
+func WriteCharacterFacialHairStyles(w io.Writer, records []CharacterFacialHairStylesRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *CharacterFacialHairStylesRecord) decode(d *decoder) {
+	r.Race = d.uint32()
+	r.Gender = d.uint32()
+	r.VariationID = d.uint32()
+	for i := range r.Geoset {
+		r.Geoset[i] = d.uint32()
+	}
+}
+
+func (r *CharacterFacialHairStylesRecord) encode(e *encoder) {
+	e.uint32(r.Race)
+	e.uint32(r.Gender)
+	e.uint32(r.VariationID)
+	for _, v := range r.Geoset {
+		e.uint32(v)
+	}
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CharBaseInfoFile is the client file CharBaseInfoRecord is read from.
+const CharBaseInfoFile = "CharBaseInfo.dbc"
+
+// CharBaseInfoRecord is one record of CharBaseInfo.dbc.
+type CharBaseInfoRecord struct {
+	Race  uint8
+	Class uint8
+}
+
+// ReadCharBaseInfo reads every record of CharBaseInfo.dbc from r.
+func ReadCharBaseInfo(r io.Reader) ([]CharBaseInfoRecord, error) {
+	return readRecords[CharBaseInfoRecord](r, CharBaseInfoFile, 2)
+}
+
+// WriteCharBaseInfo writes records to w in CharBaseInfo.dbc format.
+func WriteCharBaseInfo(w io.Writer, records []CharBaseInfoRecord) error {
+	return writeRecords(w, records, 2, 2)
+}
+
+func (r *CharBaseInfoRecord) decode(d *decoder) {
+	r.Race = d.uint8()
+	r.Class = d.uint8()
+}
+
+func (r *CharBaseInfoRecord) encode(e *encoder) {
+	e.uint8(r.Race)
+	e.uint8(r.Class)
+}
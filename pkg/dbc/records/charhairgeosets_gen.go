@@ -0,0 +1,46 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CharHairGeosetsFile is the client file CharHairGeosetsRecord is read from.
+const CharHairGeosetsFile = "CharHairGeosets.dbc"
+
+// CharHairGeosetsRecord is one record of CharHairGeosets.dbc.
+type CharHairGeosetsRecord struct {
+	ID        uint32
+	Race      uint32
+	Gender    uint32
+	Variation uint32
+	Geoset    uint32
+	ShowScalp uint32
+}
+
+// ReadCharHairGeosets reads every record of CharHairGeosets.dbc from r.
+func ReadCharHairGeosets(r io.Reader) ([]CharHairGeosetsRecord, error) {
+	return readRecords[CharHairGeosetsRecord](r, CharHairGeosetsFile, 24)
+}
+
+// WriteCharHairGeosets writes records to w in CharHairGeosets.dbc format.
+func WriteCharHairGeosets(w io.Writer, records []CharHairGeosetsRecord) error {
+	return writeRecords(w, records, 24, 6)
+}
+
+func (r *CharHairGeosetsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Race = d.uint32()
+	r.Gender = d.uint32()
+	r.Variation = d.uint32()
+	r.Geoset = d.uint32()
+	r.ShowScalp = d.uint32()
+}
+
+func (r *CharHairGeosetsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Race)
+	e.uint32(r.Gender)
+	e.uint32(r.Variation)
+	e.uint32(r.Geoset)
+	e.uint32(r.ShowScalp)
+}
@@ -0,0 +1,44 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CharHairTexturesFile is the client file CharHairTexturesRecord is read from.
+const CharHairTexturesFile = "CharHairTextures.dbc"
+
+// CharHairTexturesRecord is one record of CharHairTextures.dbc.
+type CharHairTexturesRecord struct {
+	ID     uint32
+	Race   uint32
+	Gender uint32
+	Unk    [5]int32
+}
+
+// ReadCharHairTextures reads every record of CharHairTextures.dbc from r.
+func ReadCharHairTextures(r io.Reader) ([]CharHairTexturesRecord, error) {
+	return readRecords[CharHairTexturesRecord](r, CharHairTexturesFile, 32)
+}
+
+// WriteCharHairTextures writes records to w in CharHairTextures.dbc format.
+func WriteCharHairTextures(w io.Writer, records []CharHairTexturesRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *CharHairTexturesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Race = d.uint32()
+	r.Gender = d.uint32()
+	for i := range r.Unk {
+		r.Unk[i] = d.int32()
+	}
+}
+
+func (r *CharHairTexturesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Race)
+	e.uint32(r.Gender)
+	for _, v := range r.Unk {
+		e.int32(v)
+	}
+}
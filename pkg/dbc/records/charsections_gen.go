@@ -0,0 +1,56 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CharSectionsFile is the client file CharSectionsRecord is read from.
+const CharSectionsFile = "CharSections.dbc"
+
+// CharSectionsRecord is one record of CharSections.dbc.
+type CharSectionsRecord struct {
+	ID          uint32
+	Race        uint32
+	Gender      uint32
+	BaseSection uint32
+	Texture     [3]string
+	Flags       uint32
+	Type        uint32
+	ColorIndex  uint32
+}
+
+// ReadCharSections reads every record of CharSections.dbc from r.
+func ReadCharSections(r io.Reader) ([]CharSectionsRecord, error) {
+	return readRecords[CharSectionsRecord](r, CharSectionsFile, 40)
+}
+
+// WriteCharSections writes records to w in CharSections.dbc format.
+func WriteCharSections(w io.Writer, records []CharSectionsRecord) error {
+	return writeRecords(w, records, 40, 10)
+}
+
+func (r *CharSectionsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Race = d.uint32()
+	r.Gender = d.uint32()
+	r.BaseSection = d.uint32()
+	for i := range r.Texture {
+		r.Texture[i] = d.string()
+	}
+	r.Flags = d.uint32()
+	r.Type = d.uint32()
+	r.ColorIndex = d.uint32()
+}
+
+func (r *CharSectionsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Race)
+	e.uint32(r.Gender)
+	e.uint32(r.BaseSection)
+	for _, v := range r.Texture {
+		e.string(v)
+	}
+	e.uint32(r.Flags)
+	e.uint32(r.Type)
+	e.uint32(r.ColorIndex)
+}
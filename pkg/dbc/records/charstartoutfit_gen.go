@@ -0,0 +1,64 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CharStartOutfitFile is the client file CharStartOutfitRecord is read from.
+const CharStartOutfitFile = "CharStartOutfit.dbc"
+
+// CharStartOutfitRecord is one record of CharStartOutfit.dbc.
+type CharStartOutfitRecord struct {
+	ID            uint32
+	Race          uint8
+	Class         uint8
+	Gender        uint8
+	OutfitID      uint8
+	Item          [24]int32
+	DisplayItem   [24]int32
+	InventoryType [24]int32
+}
+
+// ReadCharStartOutfit reads every record of CharStartOutfit.dbc from r.
+func ReadCharStartOutfit(r io.Reader) ([]CharStartOutfitRecord, error) {
+	return readRecords[CharStartOutfitRecord](r, CharStartOutfitFile, 296)
+}
+
+// WriteCharStartOutfit writes records to w in CharStartOutfit.dbc format.
+func WriteCharStartOutfit(w io.Writer, records []CharStartOutfitRecord) error {
+	return writeRecords(w, records, 296, 77)
+}
+
+func (r *CharStartOutfitRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Race = d.uint8()
+	r.Class = d.uint8()
+	r.Gender = d.uint8()
+	r.OutfitID = d.uint8()
+	for i := range r.Item {
+		r.Item[i] = d.int32()
+	}
+	for i := range r.DisplayItem {
+		r.DisplayItem[i] = d.int32()
+	}
+	for i := range r.InventoryType {
+		r.InventoryType[i] = d.int32()
+	}
+}
+
+func (r *CharStartOutfitRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint8(r.Race)
+	e.uint8(r.Class)
+	e.uint8(r.Gender)
+	e.uint8(r.OutfitID)
+	for _, v := range r.Item {
+		e.int32(v)
+	}
+	for _, v := range r.DisplayItem {
+		e.int32(v)
+	}
+	for _, v := range r.InventoryType {
+		e.int32(v)
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CharTitlesFile is the client file CharTitlesRecord is read from.
+const CharTitlesFile = "CharTitles.dbc"
+
+// CharTitlesRecord is one record of CharTitles.dbc.
+type CharTitlesRecord struct {
+	ID        uint32
+	Condition uint32
+	Male      Loc
+	Female    Loc
+	MaskID    uint32
+}
+
+// ReadCharTitles reads every record of CharTitles.dbc from r.
+func ReadCharTitles(r io.Reader) ([]CharTitlesRecord, error) {
+	return readRecords[CharTitlesRecord](r, CharTitlesFile, 148)
+}
+
+// WriteCharTitles writes records to w in CharTitles.dbc format.
+func WriteCharTitles(w io.Writer, records []CharTitlesRecord) error {
+	return writeRecords(w, records, 148, 37)
+}
+
+func (r *CharTitlesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Condition = d.uint32()
+	r.Male = d.loc()
+	r.Female = d.loc()
+	r.MaskID = d.uint32()
+}
+
+func (r *CharTitlesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Condition)
+	e.loc(r.Male)
+	e.loc(r.Female)
+	e.uint32(r.MaskID)
+}
@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ChatChannelsFile is the client file ChatChannelsRecord is read from.
+const ChatChannelsFile = "ChatChannels.dbc"
+
+// ChatChannelsRecord is one record of ChatChannels.dbc.
+type ChatChannelsRecord struct {
+	ID           uint32
+	Flags        uint32
+	FactionGroup uint32
+	Name         Loc
+	Shortcut     Loc
+}
+
+// ReadChatChannels reads every record of ChatChannels.dbc from r.
+func ReadChatChannels(r io.Reader) ([]ChatChannelsRecord, error) {
+	return readRecords[ChatChannelsRecord](r, ChatChannelsFile, 148)
+}
+
+// WriteChatChannels writes records to w in ChatChannels.dbc format.
+func WriteChatChannels(w io.Writer, records []ChatChannelsRecord) error {
+	return writeRecords(w, records, 148, 37)
+}
+
+func (r *ChatChannelsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+	r.FactionGroup = d.uint32()
+	r.Name = d.loc()
+	r.Shortcut = d.loc()
+}
+
+func (r *ChatChannelsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+	e.uint32(r.FactionGroup)
+	e.loc(r.Name)
+	e.loc(r.Shortcut)
+}
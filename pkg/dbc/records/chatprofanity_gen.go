@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ChatProfanityFile is the client file ChatProfanityRecord is read from.
+const ChatProfanityFile = "ChatProfanity.dbc"
+
+// ChatProfanityRecord is one record of ChatProfanity.dbc.
+type ChatProfanityRecord struct {
+	ID       uint32
+	Text     string
+	Language int32
+}
+
+// ReadChatProfanity reads every record of ChatProfanity.dbc from r.
+func ReadChatProfanity(r io.Reader) ([]ChatProfanityRecord, error) {
+	return readRecords[ChatProfanityRecord](r, ChatProfanityFile, 12)
+}
+
+// WriteChatProfanity writes records to w in ChatProfanity.dbc format.
+func WriteChatProfanity(w io.Writer, records []ChatProfanityRecord) error {
+	return writeRecords(w, records, 12, 3)
+}
+
+func (r *ChatProfanityRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Text = d.string()
+	r.Language = d.int32()
+}
+
+func (r *ChatProfanityRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Text)
+	e.int32(r.Language)
+}
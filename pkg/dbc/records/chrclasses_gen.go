@@ -0,0 +1,64 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ChrClassesFile is the client file ChrClassesRecord is read from.
+const ChrClassesFile = "ChrClasses.dbc"
+
+// ChrClassesRecord is one record of ChrClasses.dbc.
+type ChrClassesRecord struct {
+	ID            uint32
+	Class         uint32
+	PowerType     uint32
+	PetNameToken  string
+	Name          Loc
+	NameFemale    Loc
+	NameMale      Loc
+	FileName      string
+	SpellClassSet uint32
+	Flags         uint32
+	IntroCameraID uint32
+	ReqExpansion  uint32
+}
+
+// ReadChrClasses reads every record of ChrClasses.dbc from r.
+func ReadChrClasses(r io.Reader) ([]ChrClassesRecord, error) {
+	return readRecords[ChrClassesRecord](r, ChrClassesFile, 240)
+}
+
+// WriteChrClasses writes records to w in ChrClasses.dbc format.
+func WriteChrClasses(w io.Writer, records []ChrClassesRecord) error {
+	return writeRecords(w, records, 240, 60)
+}
+
+func (r *ChrClassesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Class = d.uint32()
+	r.PowerType = d.uint32()
+	r.PetNameToken = d.string()
+	r.Name = d.loc()
+	r.NameFemale = d.loc()
+	r.NameMale = d.loc()
+	r.FileName = d.string()
+	r.SpellClassSet = d.uint32()
+	r.Flags = d.uint32()
+	r.IntroCameraID = d.uint32()
+	r.ReqExpansion = d.uint32()
+}
+
+func (r *ChrClassesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Class)
+	e.uint32(r.PowerType)
+	e.string(r.PetNameToken)
+	e.loc(r.Name)
+	e.loc(r.NameFemale)
+	e.loc(r.NameMale)
+	e.string(r.FileName)
+	e.uint32(r.SpellClassSet)
+	e.uint32(r.Flags)
+	e.uint32(r.IntroCameraID)
+	e.uint32(r.ReqExpansion)
+}
@@ -0,0 +1,92 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ChrRacesFile is the client file ChrRacesRecord is read from.
+const ChrRacesFile = "ChrRaces.dbc"
+
+// ChrRacesRecord is one record of ChrRaces.dbc.
+type ChrRacesRecord struct {
+	ID                      uint32
+	Flags                   uint32
+	FactionID               uint32
+	ExplorationSoundID      uint32
+	MaleDisplayID           uint32
+	FemaleDisplayID         uint32
+	ClientPrefix            string
+	BaseLanguage            uint32
+	CreatureType            uint32
+	ResSicknessSpellID      uint32
+	SplashSoundID           uint32
+	ClientFilestring        string
+	CinematicSequenceID     uint32
+	Alliance                uint32
+	NameNeutral             Loc
+	NameFemale              Loc
+	NameMale                Loc
+	FacialHairCustomization [2]string
+	HairCustomization       string
+	RequiredExpansion       uint32
+}
+
+// ReadChrRaces reads every record of ChrRaces.dbc from r.
+func ReadChrRaces(r io.Reader) ([]ChrRacesRecord, error) {
+	return readRecords[ChrRacesRecord](r, ChrRacesFile, 276)
+}
+
+// WriteChrRaces writes records to w in ChrRaces.dbc format.
+func WriteChrRaces(w io.Writer, records []ChrRacesRecord) error {
+	return writeRecords(w, records, 276, 69)
+}
+
+func (r *ChrRacesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+	r.FactionID = d.uint32()
+	r.ExplorationSoundID = d.uint32()
+	r.MaleDisplayID = d.uint32()
+	r.FemaleDisplayID = d.uint32()
+	r.ClientPrefix = d.string()
+	r.BaseLanguage = d.uint32()
+	r.CreatureType = d.uint32()
+	r.ResSicknessSpellID = d.uint32()
+	r.SplashSoundID = d.uint32()
+	r.ClientFilestring = d.string()
+	r.CinematicSequenceID = d.uint32()
+	r.Alliance = d.uint32()
+	r.NameNeutral = d.loc()
+	r.NameFemale = d.loc()
+	r.NameMale = d.loc()
+	for i := range r.FacialHairCustomization {
+		r.FacialHairCustomization[i] = d.string()
+	}
+	r.HairCustomization = d.string()
+	r.RequiredExpansion = d.uint32()
+}
+
+func (r *ChrRacesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+	e.uint32(r.FactionID)
+	e.uint32(r.ExplorationSoundID)
+	e.uint32(r.MaleDisplayID)
+	e.uint32(r.FemaleDisplayID)
+	e.string(r.ClientPrefix)
+	e.uint32(r.BaseLanguage)
+	e.uint32(r.CreatureType)
+	e.uint32(r.ResSicknessSpellID)
+	e.uint32(r.SplashSoundID)
+	e.string(r.ClientFilestring)
+	e.uint32(r.CinematicSequenceID)
+	e.uint32(r.Alliance)
+	e.loc(r.NameNeutral)
+	e.loc(r.NameFemale)
+	e.loc(r.NameMale)
+	for _, v := range r.FacialHairCustomization {
+		e.string(v)
+	}
+	e.string(r.HairCustomization)
+	e.uint32(r.RequiredExpansion)
+}
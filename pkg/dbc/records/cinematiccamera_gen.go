@@ -0,0 +1,49 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CinematicCameraFile is the client file CinematicCameraRecord is read from.
+const CinematicCameraFile = "CinematicCamera.dbc"
+
+// CinematicCameraRecord is one record of CinematicCamera.dbc.
+type CinematicCameraRecord struct {
+	ID           uint32
+	Model        string
+	SoundID      uint32
+	OriginX      float32
+	OriginY      float32
+	OriginZ      float32
+	OriginFacing float32
+}
+
+// ReadCinematicCamera reads every record of CinematicCamera.dbc from r.
+func ReadCinematicCamera(r io.Reader) ([]CinematicCameraRecord, error) {
+	return readRecords[CinematicCameraRecord](r, CinematicCameraFile, 28)
+}
+
+// WriteCinematicCamera writes records to w in CinematicCamera.dbc format.
+func WriteCinematicCamera(w io.Writer, records []CinematicCameraRecord) error {
+	return writeRecords(w, records, 28, 7)
+}
+
+func (r *CinematicCameraRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Model = d.string()
+	r.SoundID = d.uint32()
+	r.OriginX = d.float32()
+	r.OriginY = d.float32()
+	r.OriginZ = d.float32()
+	r.OriginFacing = d.float32()
+}
+
+func (r *CinematicCameraRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Model)
+	e.uint32(r.SoundID)
+	e.float32(r.OriginX)
+	e.float32(r.OriginY)
+	e.float32(r.OriginZ)
+	e.float32(r.OriginFacing)
+}
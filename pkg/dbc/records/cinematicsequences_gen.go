@@ -0,0 +1,41 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CinematicSequencesFile is the client file CinematicSequencesRecord is read from.
+const CinematicSequencesFile = "CinematicSequences.dbc"
+
+// CinematicSequencesRecord is one record of CinematicSequences.dbc.
+type CinematicSequencesRecord struct {
+	ID      uint32
+	SoundID uint32
+	Camera  [8]uint32
+}
+
+// ReadCinematicSequences reads every record of CinematicSequences.dbc from r.
+func ReadCinematicSequences(r io.Reader) ([]CinematicSequencesRecord, error) {
+	return readRecords[CinematicSequencesRecord](r, CinematicSequencesFile, 40)
+}
+
+// WriteCinematicSequences writes records to w in CinematicSequences.dbc format.
+func WriteCinematicSequences(w io.Writer, records []CinematicSequencesRecord) error {
+	return writeRecords(w, records, 40, 10)
+}
+
+func (r *CinematicSequencesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SoundID = d.uint32()
+	for i := range r.Camera {
+		r.Camera[i] = d.uint32()
+	}
+}
+
+func (r *CinematicSequencesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.SoundID)
+	for _, v := range r.Camera {
+		e.uint32(v)
+	}
+}
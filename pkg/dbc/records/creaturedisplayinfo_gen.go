@@ -0,0 +1,74 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureDisplayInfoFile is the client file CreatureDisplayInfoRecord is read from.
+const CreatureDisplayInfoFile = "CreatureDisplayInfo.dbc"
+
+// CreatureDisplayInfoRecord is one record of CreatureDisplayInfo.dbc.
+type CreatureDisplayInfoRecord struct {
+	ID                    uint32
+	ModelID               uint32
+	SoundID               uint32
+	ExtendedDisplayInfoID uint32
+	CreatureModelScale    float32
+	CreatureModelAlpha    uint32
+	TextureVariation      [3]string
+	PortraitTextureName   string
+	BloodLevel            int32
+	BloodID               uint32
+	NpcSoundID            uint32
+	PraticleColorID       uint32
+	CreatureGeosetData    uint32
+	ObjEffectPackageID    uint32
+}
+
+// ReadCreatureDisplayInfo reads every record of CreatureDisplayInfo.dbc from r.
+func ReadCreatureDisplayInfo(r io.Reader) ([]CreatureDisplayInfoRecord, error) {
+	return readRecords[CreatureDisplayInfoRecord](r, CreatureDisplayInfoFile, 64)
+}
+
+// WriteCreatureDisplayInfo writes records to w in CreatureDisplayInfo.dbc format.
+func WriteCreatureDisplayInfo(w io.Writer, records []CreatureDisplayInfoRecord) error {
+	return writeRecords(w, records, 64, 16)
+}
+
+func (r *CreatureDisplayInfoRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.ModelID = d.uint32()
+	r.SoundID = d.uint32()
+	r.ExtendedDisplayInfoID = d.uint32()
+	r.CreatureModelScale = d.float32()
+	r.CreatureModelAlpha = d.uint32()
+	for i := range r.TextureVariation {
+		r.TextureVariation[i] = d.string()
+	}
+	r.PortraitTextureName = d.string()
+	r.BloodLevel = d.int32()
+	r.BloodID = d.uint32()
+	r.NpcSoundID = d.uint32()
+	r.PraticleColorID = d.uint32()
+	r.CreatureGeosetData = d.uint32()
+	r.ObjEffectPackageID = d.uint32()
+}
+
+func (r *CreatureDisplayInfoRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.ModelID)
+	e.uint32(r.SoundID)
+	e.uint32(r.ExtendedDisplayInfoID)
+	e.float32(r.CreatureModelScale)
+	e.uint32(r.CreatureModelAlpha)
+	for _, v := range r.TextureVariation {
+		e.string(v)
+	}
+	e.string(r.PortraitTextureName)
+	e.int32(r.BloodLevel)
+	e.uint32(r.BloodID)
+	e.uint32(r.NpcSoundID)
+	e.uint32(r.PraticleColorID)
+	e.uint32(r.CreatureGeosetData)
+	e.uint32(r.ObjEffectPackageID)
+}
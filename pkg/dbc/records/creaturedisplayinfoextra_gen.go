@@ -0,0 +1,91 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureDisplayInfoExtraFile is the client file CreatureDisplayInfoExtraRecord is read from.
+const CreatureDisplayInfoExtraFile = "CreatureDisplayInfoExtra.dbc"
+
+// CreatureDisplayInfoExtraRecord is one record of CreatureDisplayInfoExtra.dbc.
+type CreatureDisplayInfoExtraRecord struct {
+	ID          uint32
+	Race        uint32
+	Gender      uint32
+	SkinColor   uint32
+	FaceType    uint32
+	HairStyle   uint32
+	HairColor   uint32
+	FacialHair  uint32
+	HelmID      uint32
+	ShouldersID uint32
+	ShirtID     uint32
+	ChestID     uint32
+	BeltID      uint32
+	LegsID      uint32
+	BootsID     uint32
+	WristsID    uint32
+	GlovesID    uint32
+	TabardID    uint32
+	CapeID      uint32
+	CanEquip    uint32
+	Texture     string
+}
+
+// ReadCreatureDisplayInfoExtra reads every record of CreatureDisplayInfoExtra.dbc from r.
+func ReadCreatureDisplayInfoExtra(r io.Reader) ([]CreatureDisplayInfoExtraRecord, error) {
+	return readRecords[CreatureDisplayInfoExtraRecord](r, CreatureDisplayInfoExtraFile, 84)
+}
+
+// WriteCreatureDisplayInfoExtra writes records to w in CreatureDisplayInfoExtra.dbc format.
+func WriteCreatureDisplayInfoExtra(w io.Writer, records []CreatureDisplayInfoExtraRecord) error {
+	return writeRecords(w, records, 84, 21)
+}
+
+func (r *CreatureDisplayInfoExtraRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Race = d.uint32()
+	r.Gender = d.uint32()
+	r.SkinColor = d.uint32()
+	r.FaceType = d.uint32()
+	r.HairStyle = d.uint32()
+	r.HairColor = d.uint32()
+	r.FacialHair = d.uint32()
+	r.HelmID = d.uint32()
+	r.ShouldersID = d.uint32()
+	r.ShirtID = d.uint32()
+	r.ChestID = d.uint32()
+	r.BeltID = d.uint32()
+	r.LegsID = d.uint32()
+	r.BootsID = d.uint32()
+	r.WristsID = d.uint32()
+	r.GlovesID = d.uint32()
+	r.TabardID = d.uint32()
+	r.CapeID = d.uint32()
+	r.CanEquip = d.uint32()
+	r.Texture = d.string()
+}
+
+func (r *CreatureDisplayInfoExtraRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Race)
+	e.uint32(r.Gender)
+	e.uint32(r.SkinColor)
+	e.uint32(r.FaceType)
+	e.uint32(r.HairStyle)
+	e.uint32(r.HairColor)
+	e.uint32(r.FacialHair)
+	e.uint32(r.HelmID)
+	e.uint32(r.ShouldersID)
+	e.uint32(r.ShirtID)
+	e.uint32(r.ChestID)
+	e.uint32(r.BeltID)
+	e.uint32(r.LegsID)
+	e.uint32(r.BootsID)
+	e.uint32(r.WristsID)
+	e.uint32(r.GlovesID)
+	e.uint32(r.TabardID)
+	e.uint32(r.CapeID)
+	e.uint32(r.CanEquip)
+	e.string(r.Texture)
+}
@@ -0,0 +1,65 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureFamilyFile is the client file CreatureFamilyRecord is read from.
+const CreatureFamilyFile = "CreatureFamily.dbc"
+
+// CreatureFamilyRecord is one record of CreatureFamily.dbc.
+type CreatureFamilyRecord struct {
+	ID             uint32
+	MinScale       float32
+	MinScaleLevel  uint32
+	MaxScale       float32
+	MaxScaleLevel  uint32
+	SkillLine      [2]uint32
+	PetFoodMask    uint32
+	PetTalentType  int32
+	CategoryEnumID int32
+	Name           Loc
+	Icon           string
+}
+
+// ReadCreatureFamily reads every record of CreatureFamily.dbc from r.
+func ReadCreatureFamily(r io.Reader) ([]CreatureFamilyRecord, error) {
+	return readRecords[CreatureFamilyRecord](r, CreatureFamilyFile, 112)
+}
+
+// WriteCreatureFamily writes records to w in CreatureFamily.dbc format.
+func WriteCreatureFamily(w io.Writer, records []CreatureFamilyRecord) error {
+	return writeRecords(w, records, 112, 28)
+}
+
+func (r *CreatureFamilyRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MinScale = d.float32()
+	r.MinScaleLevel = d.uint32()
+	r.MaxScale = d.float32()
+	r.MaxScaleLevel = d.uint32()
+	for i := range r.SkillLine {
+		r.SkillLine[i] = d.uint32()
+	}
+	r.PetFoodMask = d.uint32()
+	r.PetTalentType = d.int32()
+	r.CategoryEnumID = d.int32()
+	r.Name = d.loc()
+	r.Icon = d.string()
+}
+
+func (r *CreatureFamilyRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.float32(r.MinScale)
+	e.uint32(r.MinScaleLevel)
+	e.float32(r.MaxScale)
+	e.uint32(r.MaxScaleLevel)
+	for _, v := range r.SkillLine {
+		e.uint32(v)
+	}
+	e.uint32(r.PetFoodMask)
+	e.int32(r.PetTalentType)
+	e.int32(r.CategoryEnumID)
+	e.loc(r.Name)
+	e.string(r.Icon)
+}
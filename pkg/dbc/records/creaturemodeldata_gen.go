@@ -0,0 +1,112 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureModelDataFile is the client file CreatureModelDataRecord is read from.
+const CreatureModelDataFile = "CreatureModelData.dbc"
+
+// CreatureModelDataRecord is one record of CreatureModelData.dbc.
+type CreatureModelDataRecord struct {
+	ID                     uint32
+	Flags                  uint32
+	ModelPath              string
+	SizeClass              uint32
+	ModelScale             float32
+	BloodID                uint32
+	FootprintTextureID     uint32
+	FootprintTextureLength float32
+	FootprintTextureWidth  float32
+	FootprintParticleScale float32
+	FoleyMaterialID        uint32
+	FootstepShakeSize      uint32
+	DeathThudShakeSize     uint32
+	SoundData              uint32
+	CollisionWidth         float32
+	CollisionHeight        float32
+	MountHeight            float32
+	GeoBoxMinX             float32
+	GeoBoxMinY             float32
+	GeoBoxMinZ             float32
+	GeoBoxMaxX             float32
+	GeoBoxMaxY             float32
+	GeoBoxMaxZ             float32
+	WorldEffectScale       float32
+	AttachedEffectScale    float32
+	MissileCollisionRadius float32
+	MissileCollisionPush   float32
+	MissileCollisionRaise  float32
+}
+
+// ReadCreatureModelData reads every record of CreatureModelData.dbc from r.
+func ReadCreatureModelData(r io.Reader) ([]CreatureModelDataRecord, error) {
+	return readRecords[CreatureModelDataRecord](r, CreatureModelDataFile, 112)
+}
+
+// WriteCreatureModelData writes records to w in CreatureModelData.dbc format.
+func WriteCreatureModelData(w io.Writer, records []CreatureModelDataRecord) error {
+	return writeRecords(w, records, 112, 28)
+}
+
+func (r *CreatureModelDataRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+	r.ModelPath = d.string()
+	r.SizeClass = d.uint32()
+	r.ModelScale = d.float32()
+	r.BloodID = d.uint32()
+	r.FootprintTextureID = d.uint32()
+	r.FootprintTextureLength = d.float32()
+	r.FootprintTextureWidth = d.float32()
+	r.FootprintParticleScale = d.float32()
+	r.FoleyMaterialID = d.uint32()
+	r.FootstepShakeSize = d.uint32()
+	r.DeathThudShakeSize = d.uint32()
+	r.SoundData = d.uint32()
+	r.CollisionWidth = d.float32()
+	r.CollisionHeight = d.float32()
+	r.MountHeight = d.float32()
+	r.GeoBoxMinX = d.float32()
+	r.GeoBoxMinY = d.float32()
+	r.GeoBoxMinZ = d.float32()
+	r.GeoBoxMaxX = d.float32()
+	r.GeoBoxMaxY = d.float32()
+	r.GeoBoxMaxZ = d.float32()
+	r.WorldEffectScale = d.float32()
+	r.AttachedEffectScale = d.float32()
+	r.MissileCollisionRadius = d.float32()
+	r.MissileCollisionPush = d.float32()
+	r.MissileCollisionRaise = d.float32()
+}
+
+func (r *CreatureModelDataRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+	e.string(r.ModelPath)
+	e.uint32(r.SizeClass)
+	e.float32(r.ModelScale)
+	e.uint32(r.BloodID)
+	e.uint32(r.FootprintTextureID)
+	e.float32(r.FootprintTextureLength)
+	e.float32(r.FootprintTextureWidth)
+	e.float32(r.FootprintParticleScale)
+	e.uint32(r.FoleyMaterialID)
+	e.uint32(r.FootstepShakeSize)
+	e.uint32(r.DeathThudShakeSize)
+	e.uint32(r.SoundData)
+	e.float32(r.CollisionWidth)
+	e.float32(r.CollisionHeight)
+	e.float32(r.MountHeight)
+	e.float32(r.GeoBoxMinX)
+	e.float32(r.GeoBoxMinY)
+	e.float32(r.GeoBoxMinZ)
+	e.float32(r.GeoBoxMaxX)
+	e.float32(r.GeoBoxMaxY)
+	e.float32(r.GeoBoxMaxZ)
+	e.float32(r.WorldEffectScale)
+	e.float32(r.AttachedEffectScale)
+	e.float32(r.MissileCollisionRadius)
+	e.float32(r.MissileCollisionPush)
+	e.float32(r.MissileCollisionRaise)
+}
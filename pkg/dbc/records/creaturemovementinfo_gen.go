@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureMovementInfoFile is the client file CreatureMovementInfoRecord is read from.
+const CreatureMovementInfoFile = "CreatureMovementInfo.dbc"
+
+// CreatureMovementInfoRecord is one record of CreatureMovementInfo.dbc.
+type CreatureMovementInfoRecord struct {
+	ID                    uint32
+	SmoothFacingChaseRate float32
+}
+
+// ReadCreatureMovementInfo reads every record of CreatureMovementInfo.dbc from r.
+func ReadCreatureMovementInfo(r io.Reader) ([]CreatureMovementInfoRecord, error) {
+	return readRecords[CreatureMovementInfoRecord](r, CreatureMovementInfoFile, 8)
+}
+
+// WriteCreatureMovementInfo writes records to w in CreatureMovementInfo.dbc format.
+func WriteCreatureMovementInfo(w io.Writer, records []CreatureMovementInfoRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *CreatureMovementInfoRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SmoothFacingChaseRate = d.float32()
+}
+
+func (r *CreatureMovementInfoRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.float32(r.SmoothFacingChaseRate)
+}
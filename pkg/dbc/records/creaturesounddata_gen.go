@@ -0,0 +1,129 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureSoundDataFile is the client file CreatureSoundDataRecord is read from.
+const CreatureSoundDataFile = "CreatureSoundData.dbc"
+
+// CreatureSoundDataRecord is one record of CreatureSoundData.dbc.
+type CreatureSoundDataRecord struct {
+	ID                        uint32
+	SoundExertionID           uint32
+	SoundExertionCriticalID   uint32
+	SoundInjuryID             uint32
+	SoundInjuryCriticalID     uint32
+	SoundInjuryCrushingBlowID uint32
+	SoundDeathID              uint32
+	SoundStunID               uint32
+	SoundStandID              uint32
+	SoundFootstepID           uint32
+	SoundAggroID              uint32
+	SoundWingFlapID           uint32
+	SoundWingGlideID          uint32
+	SoundAlertID              uint32
+	SoundFidgetID             [5]uint32
+	SoundCustomAttackID       [4]uint32
+	NpcSoundID                uint32
+	LoopSoundID               uint32
+	CreatureImpactType        uint32
+	SoundJumpStartID          uint32
+	SoundJumpEndID            uint32
+	SoundPetAttackID          uint32
+	SoundPetOrderID           uint32
+	SoundPetDismissID         uint32
+	FidgetDelaySecondsMin     float32
+	FidgetDelaySecondsMax     float32
+	BirthSoundID              uint32
+	SpellCastDirectedSoundID  uint32
+	SubmergeSoundID           uint32
+	SubmergedSoundID          uint32
+	CreatureSoundDataIDPet    uint32
+}
+
+// ReadCreatureSoundData reads every record of CreatureSoundData.dbc from r.
+func ReadCreatureSoundData(r io.Reader) ([]CreatureSoundDataRecord, error) {
+	return readRecords[CreatureSoundDataRecord](r, CreatureSoundDataFile, 152)
+}
+
+// WriteCreatureSoundData writes records to w in CreatureSoundData.dbc format.
+func WriteCreatureSoundData(w io.Writer, records []CreatureSoundDataRecord) error {
+	return writeRecords(w, records, 152, 38)
+}
+
+func (r *CreatureSoundDataRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SoundExertionID = d.uint32()
+	r.SoundExertionCriticalID = d.uint32()
+	r.SoundInjuryID = d.uint32()
+	r.SoundInjuryCriticalID = d.uint32()
+	r.SoundInjuryCrushingBlowID = d.uint32()
+	r.SoundDeathID = d.uint32()
+	r.SoundStunID = d.uint32()
+	r.SoundStandID = d.uint32()
+	r.SoundFootstepID = d.uint32()
+	r.SoundAggroID = d.uint32()
+	r.SoundWingFlapID = d.uint32()
+	r.SoundWingGlideID = d.uint32()
+	r.SoundAlertID = d.uint32()
+	for i := range r.SoundFidgetID {
+		r.SoundFidgetID[i] = d.uint32()
+	}
+	for i := range r.SoundCustomAttackID {
+		r.SoundCustomAttackID[i] = d.uint32()
+	}
+	r.NpcSoundID = d.uint32()
+	r.LoopSoundID = d.uint32()
+	r.CreatureImpactType = d.uint32()
+	r.SoundJumpStartID = d.uint32()
+	r.SoundJumpEndID = d.uint32()
+	r.SoundPetAttackID = d.uint32()
+	r.SoundPetOrderID = d.uint32()
+	r.SoundPetDismissID = d.uint32()
+	r.FidgetDelaySecondsMin = d.float32()
+	r.FidgetDelaySecondsMax = d.float32()
+	r.BirthSoundID = d.uint32()
+	r.SpellCastDirectedSoundID = d.uint32()
+	r.SubmergeSoundID = d.uint32()
+	r.SubmergedSoundID = d.uint32()
+	r.CreatureSoundDataIDPet = d.uint32()
+}
+
+func (r *CreatureSoundDataRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.SoundExertionID)
+	e.uint32(r.SoundExertionCriticalID)
+	e.uint32(r.SoundInjuryID)
+	e.uint32(r.SoundInjuryCriticalID)
+	e.uint32(r.SoundInjuryCrushingBlowID)
+	e.uint32(r.SoundDeathID)
+	e.uint32(r.SoundStunID)
+	e.uint32(r.SoundStandID)
+	e.uint32(r.SoundFootstepID)
+	e.uint32(r.SoundAggroID)
+	e.uint32(r.SoundWingFlapID)
+	e.uint32(r.SoundWingGlideID)
+	e.uint32(r.SoundAlertID)
+	for _, v := range r.SoundFidgetID {
+		e.uint32(v)
+	}
+	for _, v := range r.SoundCustomAttackID {
+		e.uint32(v)
+	}
+	e.uint32(r.NpcSoundID)
+	e.uint32(r.LoopSoundID)
+	e.uint32(r.CreatureImpactType)
+	e.uint32(r.SoundJumpStartID)
+	e.uint32(r.SoundJumpEndID)
+	e.uint32(r.SoundPetAttackID)
+	e.uint32(r.SoundPetOrderID)
+	e.uint32(r.SoundPetDismissID)
+	e.float32(r.FidgetDelaySecondsMin)
+	e.float32(r.FidgetDelaySecondsMax)
+	e.uint32(r.BirthSoundID)
+	e.uint32(r.SpellCastDirectedSoundID)
+	e.uint32(r.SubmergeSoundID)
+	e.uint32(r.SubmergedSoundID)
+	e.uint32(r.CreatureSoundDataIDPet)
+}
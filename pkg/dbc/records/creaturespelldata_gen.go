@@ -0,0 +1,45 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureSpellDataFile is the client file CreatureSpellDataRecord is read from.
+const CreatureSpellDataFile = "CreatureSpellData.dbc"
+
+// CreatureSpellDataRecord is one record of CreatureSpellData.dbc.
+type CreatureSpellDataRecord struct {
+	ID             uint32
+	Spell          [4]uint32
+	AvailabilityDs [4]uint32
+}
+
+// ReadCreatureSpellData reads every record of CreatureSpellData.dbc from r.
+func ReadCreatureSpellData(r io.Reader) ([]CreatureSpellDataRecord, error) {
+	return readRecords[CreatureSpellDataRecord](r, CreatureSpellDataFile, 36)
+}
+
+// WriteCreatureSpellData writes records to w in CreatureSpellData.dbc format.
+func WriteCreatureSpellData(w io.Writer, records []CreatureSpellDataRecord) error {
+	return writeRecords(w, records, 36, 9)
+}
+
+func (r *CreatureSpellDataRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.Spell {
+		r.Spell[i] = d.uint32()
+	}
+	for i := range r.AvailabilityDs {
+		r.AvailabilityDs[i] = d.uint32()
+	}
+}
+
+func (r *CreatureSpellDataRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.Spell {
+		e.uint32(v)
+	}
+	for _, v := range r.AvailabilityDs {
+		e.uint32(v)
+	}
+}
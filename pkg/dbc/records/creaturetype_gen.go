@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CreatureTypeFile is the client file CreatureTypeRecord is read from.
+const CreatureTypeFile = "CreatureType.dbc"
+
+// CreatureTypeRecord is one record of CreatureType.dbc.
+type CreatureTypeRecord struct {
+	ID    uint32
+	Name  Loc
+	Flags uint32
+}
+
+// ReadCreatureType reads every record of CreatureType.dbc from r.
+func ReadCreatureType(r io.Reader) ([]CreatureTypeRecord, error) {
+	return readRecords[CreatureTypeRecord](r, CreatureTypeFile, 76)
+}
+
+// WriteCreatureType writes records to w in CreatureType.dbc format.
+func WriteCreatureType(w io.Writer, records []CreatureTypeRecord) error {
+	return writeRecords(w, records, 76, 19)
+}
+
+func (r *CreatureTypeRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	r.Flags = d.uint32()
+}
+
+func (r *CreatureTypeRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	e.uint32(r.Flags)
+}
@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CurrencyCategoryFile is the client file CurrencyCategoryRecord is read from.
+const CurrencyCategoryFile = "CurrencyCategory.dbc"
+
+// CurrencyCategoryRecord is one record of CurrencyCategory.dbc.
+type CurrencyCategoryRecord struct {
+	ID    uint32
+	Flags uint32
+	Name  Loc
+}
+
+// ReadCurrencyCategory reads every record of CurrencyCategory.dbc from r.
+func ReadCurrencyCategory(r io.Reader) ([]CurrencyCategoryRecord, error) {
+	return readRecords[CurrencyCategoryRecord](r, CurrencyCategoryFile, 76)
+}
+
+// WriteCurrencyCategory writes records to w in CurrencyCategory.dbc format.
+func WriteCurrencyCategory(w io.Writer, records []CurrencyCategoryRecord) error {
+	return writeRecords(w, records, 76, 19)
+}
+
+func (r *CurrencyCategoryRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *CurrencyCategoryRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+	e.loc(r.Name)
+}
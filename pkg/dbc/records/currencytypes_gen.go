@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// CurrencyTypesFile is the client file CurrencyTypesRecord is read from.
+const CurrencyTypesFile = "CurrencyTypes.dbc"
+
+// CurrencyTypesRecord is one record of CurrencyTypes.dbc.
+type CurrencyTypesRecord struct {
+	ID       uint32
+	Item     uint32
+	Category uint32
+	BitIndex uint32
+}
+
+// ReadCurrencyTypes reads every record of CurrencyTypes.dbc from r.
+func ReadCurrencyTypes(r io.Reader) ([]CurrencyTypesRecord, error) {
+	return readRecords[CurrencyTypesRecord](r, CurrencyTypesFile, 16)
+}
+
+// WriteCurrencyTypes writes records to w in CurrencyTypes.dbc format.
+func WriteCurrencyTypes(w io.Writer, records []CurrencyTypesRecord) error {
+	return writeRecords(w, records, 16, 4)
+}
+
+func (r *CurrencyTypesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Item = d.uint32()
+	r.Category = d.uint32()
+	r.BitIndex = d.uint32()
+}
+
+func (r *CurrencyTypesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Item)
+	e.uint32(r.Category)
+	e.uint32(r.BitIndex)
+}
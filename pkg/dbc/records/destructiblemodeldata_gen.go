@@ -0,0 +1,85 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// DestructiblemodeldataFile is the client file DestructiblemodeldataRecord is read from.
+const DestructiblemodeldataFile = "Destructiblemodeldata.dbc"
+
+// DestructiblemodeldataRecord is one record of Destructiblemodeldata.dbc.
+type DestructiblemodeldataRecord struct {
+	ID                          uint32
+	State1ImpactEffectDoodadSet uint32
+	State1AmbientDoodadSet      uint32
+	State1NameSet               int32
+	State1Unknown1              uint32
+	State1Unknown2              uint32
+	State2ImpactEffectDoodadSet uint32
+	State2AmbientDoodadSet      int32
+	State2NameSet               uint32
+	State2Unknown1              uint32
+	State2Unknown2              uint32
+	State3ImpactEffectDoodadSet int32
+	State3AmbientDoodadSet      uint32
+	State3NameSet               uint32
+	State3Unknown1              uint32
+	State3Unknown2              int32
+	State4ImpactEffectDoodadSet uint32
+	State4AmbientDoodadSet      uint32
+	State4Unknown               uint32
+}
+
+// ReadDestructiblemodeldata reads every record of Destructiblemodeldata.dbc from r.
+func ReadDestructiblemodeldata(r io.Reader) ([]DestructiblemodeldataRecord, error) {
+	return readRecords[DestructiblemodeldataRecord](r, DestructiblemodeldataFile, 76)
+}
+
+// WriteDestructiblemodeldata writes records to w in Destructiblemodeldata.dbc format.
+func WriteDestructiblemodeldata(w io.Writer, records []DestructiblemodeldataRecord) error {
+	return writeRecords(w, records, 76, 19)
+}
+
+func (r *DestructiblemodeldataRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.State1ImpactEffectDoodadSet = d.uint32()
+	r.State1AmbientDoodadSet = d.uint32()
+	r.State1NameSet = d.int32()
+	r.State1Unknown1 = d.uint32()
+	r.State1Unknown2 = d.uint32()
+	r.State2ImpactEffectDoodadSet = d.uint32()
+	r.State2AmbientDoodadSet = d.int32()
+	r.State2NameSet = d.uint32()
+	r.State2Unknown1 = d.uint32()
+	r.State2Unknown2 = d.uint32()
+	r.State3ImpactEffectDoodadSet = d.int32()
+	r.State3AmbientDoodadSet = d.uint32()
+	r.State3NameSet = d.uint32()
+	r.State3Unknown1 = d.uint32()
+	r.State3Unknown2 = d.int32()
+	r.State4ImpactEffectDoodadSet = d.uint32()
+	r.State4AmbientDoodadSet = d.uint32()
+	r.State4Unknown = d.uint32()
+}
+
+func (r *DestructiblemodeldataRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.State1ImpactEffectDoodadSet)
+	e.uint32(r.State1AmbientDoodadSet)
+	e.int32(r.State1NameSet)
+	e.uint32(r.State1Unknown1)
+	e.uint32(r.State1Unknown2)
+	e.uint32(r.State2ImpactEffectDoodadSet)
+	e.int32(r.State2AmbientDoodadSet)
+	e.uint32(r.State2NameSet)
+	e.uint32(r.State2Unknown1)
+	e.uint32(r.State2Unknown2)
+	e.int32(r.State3ImpactEffectDoodadSet)
+	e.uint32(r.State3AmbientDoodadSet)
+	e.uint32(r.State3NameSet)
+	e.uint32(r.State3Unknown1)
+	e.int32(r.State3Unknown2)
+	e.uint32(r.State4ImpactEffectDoodadSet)
+	e.uint32(r.State4AmbientDoodadSet)
+	e.uint32(r.State4Unknown)
+}
@@ -0,0 +1,49 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// DungeonencounterFile is the client file DungeonencounterRecord is read from.
+const DungeonencounterFile = "Dungeonencounter.dbc"
+
+// DungeonencounterRecord is one record of Dungeonencounter.dbc.
+type DungeonencounterRecord struct {
+	ID          uint32
+	MapID       int32
+	Difficulty  int32
+	OrderIndex  uint32
+	Bit         int32
+	Name        Loc
+	SpellIconID uint32
+}
+
+// ReadDungeonencounter reads every record of Dungeonencounter.dbc from r.
+func ReadDungeonencounter(r io.Reader) ([]DungeonencounterRecord, error) {
+	return readRecords[DungeonencounterRecord](r, DungeonencounterFile, 92)
+}
+
+// WriteDungeonencounter writes records to w in Dungeonencounter.dbc format.
+func WriteDungeonencounter(w io.Writer, records []DungeonencounterRecord) error {
+	return writeRecords(w, records, 92, 23)
+}
+
+func (r *DungeonencounterRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MapID = d.int32()
+	r.Difficulty = d.int32()
+	r.OrderIndex = d.uint32()
+	r.Bit = d.int32()
+	r.Name = d.loc()
+	r.SpellIconID = d.uint32()
+}
+
+func (r *DungeonencounterRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.MapID)
+	e.int32(r.Difficulty)
+	e.uint32(r.OrderIndex)
+	e.int32(r.Bit)
+	e.loc(r.Name)
+	e.uint32(r.SpellIconID)
+}
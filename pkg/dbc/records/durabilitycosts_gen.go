@@ -0,0 +1,118 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// DurabilitycostsFile is the client file DurabilitycostsRecord is read from.
+const DurabilitycostsFile = "Durabilitycosts.dbc"
+
+// DurabilitycostsRecord is one record of Durabilitycosts.dbc.
+type DurabilitycostsRecord struct {
+	ID               uint32
+	WeaponSubclass1  int32
+	WeaponSubclass2  int32
+	WeaponSubclass3  int32
+	WeaponSubclass4  int32
+	WeaponSubclass5  int32
+	WeaponSubclass6  int32
+	WeaponSubclass7  int32
+	WeaponSubclass8  int32
+	WeaponSubclass9  int32
+	WeaponSubclass10 int32
+	WeaponSubclass11 int32
+	WeaponSubclass12 int32
+	WeaponSubclass13 int32
+	WeaponSubclass14 int32
+	WeaponSubclass15 int32
+	WeaponSubclass16 int32
+	WeaponSubclass17 int32
+	WeaponSubclass18 int32
+	WeaponSubclass19 int32
+	WeaponSubclass20 int32
+	WeaponSubclass21 int32
+	ArmorSubclass1   int32
+	ArmorSubclass2   int32
+	ArmorSubclass3   int32
+	ArmorSubclass4   int32
+	ArmorSubclass5   int32
+	ArmorSubclass6   int32
+	ArmorSubclass7   int32
+	ArmorSubclass8   int32
+}
+
+// ReadDurabilitycosts reads every record of Durabilitycosts.dbc from r.
+func ReadDurabilitycosts(r io.Reader) ([]DurabilitycostsRecord, error) {
+	return readRecords[DurabilitycostsRecord](r, DurabilitycostsFile, 120)
+}
+
+// WriteDurabilitycosts writes records to w in Durabilitycosts.dbc format.
+func WriteDurabilitycosts(w io.Writer, records []DurabilitycostsRecord) error {
+	return writeRecords(w, records, 120, 30)
+}
+
+func (r *DurabilitycostsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.WeaponSubclass1 = d.int32()
+	r.WeaponSubclass2 = d.int32()
+	r.WeaponSubclass3 = d.int32()
+	r.WeaponSubclass4 = d.int32()
+	r.WeaponSubclass5 = d.int32()
+	r.WeaponSubclass6 = d.int32()
+	r.WeaponSubclass7 = d.int32()
+	r.WeaponSubclass8 = d.int32()
+	r.WeaponSubclass9 = d.int32()
+	r.WeaponSubclass10 = d.int32()
+	r.WeaponSubclass11 = d.int32()
+	r.WeaponSubclass12 = d.int32()
+	r.WeaponSubclass13 = d.int32()
+	r.WeaponSubclass14 = d.int32()
+	r.WeaponSubclass15 = d.int32()
+	r.WeaponSubclass16 = d.int32()
+	r.WeaponSubclass17 = d.int32()
+	r.WeaponSubclass18 = d.int32()
+	r.WeaponSubclass19 = d.int32()
+	r.WeaponSubclass20 = d.int32()
+	r.WeaponSubclass21 = d.int32()
+	r.ArmorSubclass1 = d.int32()
+	r.ArmorSubclass2 = d.int32()
+	r.ArmorSubclass3 = d.int32()
+	r.ArmorSubclass4 = d.int32()
+	r.ArmorSubclass5 = d.int32()
+	r.ArmorSubclass6 = d.int32()
+	r.ArmorSubclass7 = d.int32()
+	r.ArmorSubclass8 = d.int32()
+}
+
+func (r *DurabilitycostsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.WeaponSubclass1)
+	e.int32(r.WeaponSubclass2)
+	e.int32(r.WeaponSubclass3)
+	e.int32(r.WeaponSubclass4)
+	e.int32(r.WeaponSubclass5)
+	e.int32(r.WeaponSubclass6)
+	e.int32(r.WeaponSubclass7)
+	e.int32(r.WeaponSubclass8)
+	e.int32(r.WeaponSubclass9)
+	e.int32(r.WeaponSubclass10)
+	e.int32(r.WeaponSubclass11)
+	e.int32(r.WeaponSubclass12)
+	e.int32(r.WeaponSubclass13)
+	e.int32(r.WeaponSubclass14)
+	e.int32(r.WeaponSubclass15)
+	e.int32(r.WeaponSubclass16)
+	e.int32(r.WeaponSubclass17)
+	e.int32(r.WeaponSubclass18)
+	e.int32(r.WeaponSubclass19)
+	e.int32(r.WeaponSubclass20)
+	e.int32(r.WeaponSubclass21)
+	e.int32(r.ArmorSubclass1)
+	e.int32(r.ArmorSubclass2)
+	e.int32(r.ArmorSubclass3)
+	e.int32(r.ArmorSubclass4)
+	e.int32(r.ArmorSubclass5)
+	e.int32(r.ArmorSubclass6)
+	e.int32(r.ArmorSubclass7)
+	e.int32(r.ArmorSubclass8)
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// DurabilityqualityFile is the client file DurabilityqualityRecord is read from.
+const DurabilityqualityFile = "Durabilityquality.dbc"
+
+// DurabilityqualityRecord is one record of Durabilityquality.dbc.
+type DurabilityqualityRecord struct {
+	ID         uint32
+	QualityMod float32
+}
+
+// ReadDurabilityquality reads every record of Durabilityquality.dbc from r.
+func ReadDurabilityquality(r io.Reader) ([]DurabilityqualityRecord, error) {
+	return readRecords[DurabilityqualityRecord](r, DurabilityqualityFile, 8)
+}
+
+// WriteDurabilityquality writes records to w in Durabilityquality.dbc format.
+func WriteDurabilityquality(w io.Writer, records []DurabilityqualityRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *DurabilityqualityRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.QualityMod = d.float32()
+}
+
+func (r *DurabilityqualityRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.float32(r.QualityMod)
+}
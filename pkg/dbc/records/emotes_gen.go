@@ -0,0 +1,49 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// EmotesFile is the client file EmotesRecord is read from.
+const EmotesFile = "Emotes.dbc"
+
+// EmotesRecord is one record of Emotes.dbc.
+type EmotesRecord struct {
+	ID             uint32
+	AnimName       uint32
+	AnimID         uint32
+	Flags          int32
+	EmoteType      int32
+	UnitStandState int32
+	SoundID        uint32
+}
+
+// ReadEmotes reads every record of Emotes.dbc from r.
+func ReadEmotes(r io.Reader) ([]EmotesRecord, error) {
+	return readRecords[EmotesRecord](r, EmotesFile, 28)
+}
+
+// WriteEmotes writes records to w in Emotes.dbc format.
+func WriteEmotes(w io.Writer, records []EmotesRecord) error {
+	return writeRecords(w, records, 28, 7)
+}
+
+func (r *EmotesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.AnimName = d.uint32()
+	r.AnimID = d.uint32()
+	r.Flags = d.int32()
+	r.EmoteType = d.int32()
+	r.UnitStandState = d.int32()
+	r.SoundID = d.uint32()
+}
+
+func (r *EmotesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.AnimName)
+	e.uint32(r.AnimID)
+	e.int32(r.Flags)
+	e.int32(r.EmoteType)
+	e.int32(r.UnitStandState)
+	e.uint32(r.SoundID)
+}
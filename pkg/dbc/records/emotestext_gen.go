@@ -0,0 +1,85 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// EmotestextFile is the client file EmotestextRecord is read from.
+const EmotestextFile = "Emotestext.dbc"
+
+// EmotestextRecord is one record of Emotestext.dbc.
+type EmotestextRecord struct {
+	ID         uint32
+	Name       uint32
+	EmoteID    int32
+	TextData1  uint32
+	TextData2  uint32
+	TextData3  uint32
+	TextData4  uint32
+	TextData5  uint32
+	TextData6  uint32
+	TextData7  uint32
+	TextData8  uint32
+	TextData9  uint32
+	TextData10 uint32
+	TextData11 uint32
+	TextData12 uint32
+	TextData13 uint32
+	TextData14 uint32
+	TextData15 uint32
+	TextData16 uint32
+}
+
+// ReadEmotestext reads every record of Emotestext.dbc from r.
+func ReadEmotestext(r io.Reader) ([]EmotestextRecord, error) {
+	return readRecords[EmotestextRecord](r, EmotestextFile, 76)
+}
+
+// WriteEmotestext writes records to w in Emotestext.dbc format.
+func WriteEmotestext(w io.Writer, records []EmotestextRecord) error {
+	return writeRecords(w, records, 76, 19)
+}
+
+func (r *EmotestextRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.uint32()
+	r.EmoteID = d.int32()
+	r.TextData1 = d.uint32()
+	r.TextData2 = d.uint32()
+	r.TextData3 = d.uint32()
+	r.TextData4 = d.uint32()
+	r.TextData5 = d.uint32()
+	r.TextData6 = d.uint32()
+	r.TextData7 = d.uint32()
+	r.TextData8 = d.uint32()
+	r.TextData9 = d.uint32()
+	r.TextData10 = d.uint32()
+	r.TextData11 = d.uint32()
+	r.TextData12 = d.uint32()
+	r.TextData13 = d.uint32()
+	r.TextData14 = d.uint32()
+	r.TextData15 = d.uint32()
+	r.TextData16 = d.uint32()
+}
+
+func (r *EmotestextRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Name)
+	e.int32(r.EmoteID)
+	e.uint32(r.TextData1)
+	e.uint32(r.TextData2)
+	e.uint32(r.TextData3)
+	e.uint32(r.TextData4)
+	e.uint32(r.TextData5)
+	e.uint32(r.TextData6)
+	e.uint32(r.TextData7)
+	e.uint32(r.TextData8)
+	e.uint32(r.TextData9)
+	e.uint32(r.TextData10)
+	e.uint32(r.TextData11)
+	e.uint32(r.TextData12)
+	e.uint32(r.TextData13)
+	e.uint32(r.TextData14)
+	e.uint32(r.TextData15)
+	e.uint32(r.TextData16)
+}
@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// EmotestextsoundFile is the client file EmotestextsoundRecord is read from.
+const EmotestextsoundFile = "Emotestextsound.dbc"
+
+// EmotestextsoundRecord is one record of Emotestextsound.dbc.
+type EmotestextsoundRecord struct {
+	ID           uint32
+	EmotesTextID int32
+	RaceID       int32
+	Gender       int32
+	SoundID      int32
+}
+
+// ReadEmotestextsound reads every record of Emotestextsound.dbc from r.
+func ReadEmotestextsound(r io.Reader) ([]EmotestextsoundRecord, error) {
+	return readRecords[EmotestextsoundRecord](r, EmotestextsoundFile, 20)
+}
+
+// WriteEmotestextsound writes records to w in Emotestextsound.dbc format.
+func WriteEmotestextsound(w io.Writer, records []EmotestextsoundRecord) error {
+	return writeRecords(w, records, 20, 5)
+}
+
+func (r *EmotestextsoundRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.EmotesTextID = d.int32()
+	r.RaceID = d.int32()
+	r.Gender = d.int32()
+	r.SoundID = d.int32()
+}
+
+func (r *EmotestextsoundRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.EmotesTextID)
+	e.int32(r.RaceID)
+	e.int32(r.Gender)
+	e.int32(r.SoundID)
+}
@@ -0,0 +1,85 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// FactionFile is the client file FactionRecord is read from.
+const FactionFile = "Faction.dbc"
+
+// FactionRecord is one record of Faction.dbc.
+type FactionRecord struct {
+	ID                  uint32
+	ReputationIndex     int32
+	ReputationRaceMask  [4]uint32
+	ReputationClassMask [4]uint32
+	ReputationBase      [4]int32
+	ReputationFlags     [4]uint32
+	ParentFactionID     uint32
+	ParentFactionMod    [2]float32
+	ParentFactionCap    [2]uint32
+	Name                Loc
+	Description         Loc
+}
+
+// ReadFaction reads every record of Faction.dbc from r.
+func ReadFaction(r io.Reader) ([]FactionRecord, error) {
+	return readRecords[FactionRecord](r, FactionFile, 228)
+}
+
+// WriteFaction writes records to w in Faction.dbc format.
+func WriteFaction(w io.Writer, records []FactionRecord) error {
+	return writeRecords(w, records, 228, 57)
+}
+
+func (r *FactionRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.ReputationIndex = d.int32()
+	for i := range r.ReputationRaceMask {
+		r.ReputationRaceMask[i] = d.uint32()
+	}
+	for i := range r.ReputationClassMask {
+		r.ReputationClassMask[i] = d.uint32()
+	}
+	for i := range r.ReputationBase {
+		r.ReputationBase[i] = d.int32()
+	}
+	for i := range r.ReputationFlags {
+		r.ReputationFlags[i] = d.uint32()
+	}
+	r.ParentFactionID = d.uint32()
+	for i := range r.ParentFactionMod {
+		r.ParentFactionMod[i] = d.float32()
+	}
+	for i := range r.ParentFactionCap {
+		r.ParentFactionCap[i] = d.uint32()
+	}
+	r.Name = d.loc()
+	r.Description = d.loc()
+}
+
+func (r *FactionRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.ReputationIndex)
+	for _, v := range r.ReputationRaceMask {
+		e.uint32(v)
+	}
+	for _, v := range r.ReputationClassMask {
+		e.uint32(v)
+	}
+	for _, v := range r.ReputationBase {
+		e.int32(v)
+	}
+	for _, v := range r.ReputationFlags {
+		e.uint32(v)
+	}
+	e.uint32(r.ParentFactionID)
+	for _, v := range r.ParentFactionMod {
+		e.float32(v)
+	}
+	for _, v := range r.ParentFactionCap {
+		e.uint32(v)
+	}
+	e.loc(r.Name)
+	e.loc(r.Description)
+}
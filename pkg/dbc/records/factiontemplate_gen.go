@@ -0,0 +1,60 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// FactiontemplateFile is the client file FactiontemplateRecord is read from.
+const FactiontemplateFile = "Factiontemplate.dbc"
+
+// FactiontemplateRecord is one record of Factiontemplate.dbc.
+type FactiontemplateRecord struct {
+	ID           uint32
+	Faction      uint32
+	Flags        uint32
+	FactionGroup uint32
+	FriendGroup  uint32
+	EnemyGroup   uint32
+	Enemies      [4]uint32
+	Friend       [4]uint32
+}
+
+// ReadFactiontemplate reads every record of Factiontemplate.dbc from r.
+func ReadFactiontemplate(r io.Reader) ([]FactiontemplateRecord, error) {
+	return readRecords[FactiontemplateRecord](r, FactiontemplateFile, 56)
+}
+
+// WriteFactiontemplate writes records to w in Factiontemplate.dbc format.
+func WriteFactiontemplate(w io.Writer, records []FactiontemplateRecord) error {
+	return writeRecords(w, records, 56, 14)
+}
+
+func (r *FactiontemplateRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Faction = d.uint32()
+	r.Flags = d.uint32()
+	r.FactionGroup = d.uint32()
+	r.FriendGroup = d.uint32()
+	r.EnemyGroup = d.uint32()
+	for i := range r.Enemies {
+		r.Enemies[i] = d.uint32()
+	}
+	for i := range r.Friend {
+		r.Friend[i] = d.uint32()
+	}
+}
+
+func (r *FactiontemplateRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Faction)
+	e.uint32(r.Flags)
+	e.uint32(r.FactionGroup)
+	e.uint32(r.FriendGroup)
+	e.uint32(r.EnemyGroup)
+	for _, v := range r.Enemies {
+		e.uint32(v)
+	}
+	for _, v := range r.Friend {
+		e.uint32(v)
+	}
+}
@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GameobjectartkitFile is the client file GameobjectartkitRecord is read from.
+const GameobjectartkitFile = "Gameobjectartkit.dbc"
+
+// GameobjectartkitRecord is one record of Gameobjectartkit.dbc.
+type GameobjectartkitRecord struct {
+	ID           uint32
+	Texture1     uint32
+	Texture2     uint32
+	Texture3     uint32
+	AttachModel1 uint32
+	AttachModel2 uint32
+	AttachModel3 uint32
+	AttachModel4 uint32
+}
+
+// ReadGameobjectartkit reads every record of Gameobjectartkit.dbc from r.
+func ReadGameobjectartkit(r io.Reader) ([]GameobjectartkitRecord, error) {
+	return readRecords[GameobjectartkitRecord](r, GameobjectartkitFile, 32)
+}
+
+// WriteGameobjectartkit writes records to w in Gameobjectartkit.dbc format.
+func WriteGameobjectartkit(w io.Writer, records []GameobjectartkitRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *GameobjectartkitRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Texture1 = d.uint32()
+	r.Texture2 = d.uint32()
+	r.Texture3 = d.uint32()
+	r.AttachModel1 = d.uint32()
+	r.AttachModel2 = d.uint32()
+	r.AttachModel3 = d.uint32()
+	r.AttachModel4 = d.uint32()
+}
+
+func (r *GameobjectartkitRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Texture1)
+	e.uint32(r.Texture2)
+	e.uint32(r.Texture3)
+	e.uint32(r.AttachModel1)
+	e.uint32(r.AttachModel2)
+	e.uint32(r.AttachModel3)
+	e.uint32(r.AttachModel4)
+}
@@ -0,0 +1,85 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GameobjectdisplayinfoFile is the client file GameobjectdisplayinfoRecord is read from.
+const GameobjectdisplayinfoFile = "Gameobjectdisplayinfo.dbc"
+
+// GameobjectdisplayinfoRecord is one record of Gameobjectdisplayinfo.dbc.
+type GameobjectdisplayinfoRecord struct {
+	ID                    uint32
+	ModelName             string
+	Sound1                uint32
+	Sound2                uint32
+	Sound3                uint32
+	Sound4                uint32
+	Sound5                uint32
+	Sound6                uint32
+	Sound7                uint32
+	Sound8                uint32
+	Sound9                uint32
+	Sound10               uint32
+	GeoBoxMinX            float32
+	GeoBoxMinY            float32
+	GeoBoxMinZ            float32
+	GeoBoxMaxX            float32
+	GeoBoxMaxY            float32
+	GeoBoxMaxZ            float32
+	ObjectEffectPackageID uint32
+}
+
+// ReadGameobjectdisplayinfo reads every record of Gameobjectdisplayinfo.dbc from r.
+func ReadGameobjectdisplayinfo(r io.Reader) ([]GameobjectdisplayinfoRecord, error) {
+	return readRecords[GameobjectdisplayinfoRecord](r, GameobjectdisplayinfoFile, 76)
+}
+
+// WriteGameobjectdisplayinfo writes records to w in Gameobjectdisplayinfo.dbc format.
+func WriteGameobjectdisplayinfo(w io.Writer, records []GameobjectdisplayinfoRecord) error {
+	return writeRecords(w, records, 76, 19)
+}
+
+func (r *GameobjectdisplayinfoRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.ModelName = d.string()
+	r.Sound1 = d.uint32()
+	r.Sound2 = d.uint32()
+	r.Sound3 = d.uint32()
+	r.Sound4 = d.uint32()
+	r.Sound5 = d.uint32()
+	r.Sound6 = d.uint32()
+	r.Sound7 = d.uint32()
+	r.Sound8 = d.uint32()
+	r.Sound9 = d.uint32()
+	r.Sound10 = d.uint32()
+	r.GeoBoxMinX = d.float32()
+	r.GeoBoxMinY = d.float32()
+	r.GeoBoxMinZ = d.float32()
+	r.GeoBoxMaxX = d.float32()
+	r.GeoBoxMaxY = d.float32()
+	r.GeoBoxMaxZ = d.float32()
+	r.ObjectEffectPackageID = d.uint32()
+}
+
+func (r *GameobjectdisplayinfoRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.ModelName)
+	e.uint32(r.Sound1)
+	e.uint32(r.Sound2)
+	e.uint32(r.Sound3)
+	e.uint32(r.Sound4)
+	e.uint32(r.Sound5)
+	e.uint32(r.Sound6)
+	e.uint32(r.Sound7)
+	e.uint32(r.Sound8)
+	e.uint32(r.Sound9)
+	e.uint32(r.Sound10)
+	e.float32(r.GeoBoxMinX)
+	e.float32(r.GeoBoxMinY)
+	e.float32(r.GeoBoxMinZ)
+	e.float32(r.GeoBoxMaxX)
+	e.float32(r.GeoBoxMaxY)
+	e.float32(r.GeoBoxMaxZ)
+	e.uint32(r.ObjectEffectPackageID)
+}
@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GempropertiesFile is the client file GempropertiesRecord is read from.
+const GempropertiesFile = "Gemproperties.dbc"
+
+// GempropertiesRecord is one record of Gemproperties.dbc.
+type GempropertiesRecord struct {
+	ID           uint32
+	EnchantID    int32
+	MaxCountInv  uint32
+	MaxCountItem uint32
+	Type         int32
+}
+
+// ReadGemproperties reads every record of Gemproperties.dbc from r.
+func ReadGemproperties(r io.Reader) ([]GempropertiesRecord, error) {
+	return readRecords[GempropertiesRecord](r, GempropertiesFile, 20)
+}
+
+// WriteGemproperties writes records to w in Gemproperties.dbc format.
+func WriteGemproperties(w io.Writer, records []GempropertiesRecord) error {
+	return writeRecords(w, records, 20, 5)
+}
+
+func (r *GempropertiesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.EnchantID = d.int32()
+	r.MaxCountInv = d.uint32()
+	r.MaxCountItem = d.uint32()
+	r.Type = d.int32()
+}
+
+func (r *GempropertiesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.EnchantID)
+	e.uint32(r.MaxCountInv)
+	e.uint32(r.MaxCountItem)
+	e.int32(r.Type)
+}
@@ -0,0 +1,73 @@
+//go:build ignore
+
+// gen.go writes one file of typed records per embedded DBC meta. Run it with
+// "go generate ./pkg/dbc/records".
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/suprsokr/mithril/internal/dbc"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	metas, err := dbc.EmbeddedMetas()
+	if err != nil {
+		return err
+	}
+
+	// Remove the previous output so records of deleted metas don't linger
+	old, err := filepath.Glob("*.go")
+	if err != nil {
+		return err
+	}
+	for _, path := range old {
+		if generated(path) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	types := make(map[string]string)
+	for _, meta := range metas {
+		typeName := dbc.RecordTypeName(meta)
+		if other, ok := types[typeName]; ok {
+			return fmt.Errorf("%s and %s both generate %s", other, meta.File, typeName)
+		}
+		types[typeName] = meta.File
+
+		src, err := dbc.GenerateRecords(meta, "records")
+		if err != nil {
+			return err
+		}
+		name := strings.ToLower(strings.TrimSuffix(meta.File, ".dbc")) + "_gen.go"
+		if err := os.WriteFile(name, src, 0644); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("generated %d record types\n", len(metas))
+	return nil
+}
+
+// generated reports whether a file starts with the generated-code header.
+func generated(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	line, _ := bufio.NewReader(f).ReadString('\n')
+	return strings.TrimSpace(line) == dbc.GeneratedHeader
+}
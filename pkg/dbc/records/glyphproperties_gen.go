@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GlyphpropertiesFile is the client file GlyphpropertiesRecord is read from.
+const GlyphpropertiesFile = "Glyphproperties.dbc"
+
+// GlyphpropertiesRecord is one record of Glyphproperties.dbc.
+type GlyphpropertiesRecord struct {
+	ID            uint32
+	SpellID       int32
+	GlyphSlotType int32
+	SpellIconID   int32
+}
+
+// ReadGlyphproperties reads every record of Glyphproperties.dbc from r.
+func ReadGlyphproperties(r io.Reader) ([]GlyphpropertiesRecord, error) {
+	return readRecords[GlyphpropertiesRecord](r, GlyphpropertiesFile, 16)
+}
+
+// WriteGlyphproperties writes records to w in Glyphproperties.dbc format.
+func WriteGlyphproperties(w io.Writer, records []GlyphpropertiesRecord) error {
+	return writeRecords(w, records, 16, 4)
+}
+
+func (r *GlyphpropertiesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SpellID = d.int32()
+	r.GlyphSlotType = d.int32()
+	r.SpellIconID = d.int32()
+}
+
+func (r *GlyphpropertiesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.SpellID)
+	e.int32(r.GlyphSlotType)
+	e.int32(r.SpellIconID)
+}
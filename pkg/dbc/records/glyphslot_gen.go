@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GlyphslotFile is the client file GlyphslotRecord is read from.
+const GlyphslotFile = "Glyphslot.dbc"
+
+// GlyphslotRecord is one record of Glyphslot.dbc.
+type GlyphslotRecord struct {
+	ID        uint32
+	GlyphType int32
+	Tooltip   int32
+}
+
+// ReadGlyphslot reads every record of Glyphslot.dbc from r.
+func ReadGlyphslot(r io.Reader) ([]GlyphslotRecord, error) {
+	return readRecords[GlyphslotRecord](r, GlyphslotFile, 12)
+}
+
+// WriteGlyphslot writes records to w in Glyphslot.dbc format.
+func WriteGlyphslot(w io.Writer, records []GlyphslotRecord) error {
+	return writeRecords(w, records, 12, 3)
+}
+
+func (r *GlyphslotRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.GlyphType = d.int32()
+	r.Tooltip = d.int32()
+}
+
+func (r *GlyphslotRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.GlyphType)
+	e.int32(r.Tooltip)
+}
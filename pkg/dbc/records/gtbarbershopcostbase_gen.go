@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtBarberShopCostBaseFile is the client file GtBarberShopCostBaseRecord is read from.
+const GtBarberShopCostBaseFile = "gtBarberShopCostBase.dbc"
+
+// GtBarberShopCostBaseRecord is one record of gtBarberShopCostBase.dbc.
+type GtBarberShopCostBaseRecord struct {
+	Data float32
+}
+
+// ReadGtBarberShopCostBase reads every record of gtBarberShopCostBase.dbc from r.
+func ReadGtBarberShopCostBase(r io.Reader) ([]GtBarberShopCostBaseRecord, error) {
+	return readRecords[GtBarberShopCostBaseRecord](r, GtBarberShopCostBaseFile, 4)
+}
+
+// WriteGtBarberShopCostBase writes records to w in gtBarberShopCostBase.dbc format.
+func WriteGtBarberShopCostBase(w io.Writer, records []GtBarberShopCostBaseRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtBarberShopCostBaseRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtBarberShopCostBaseRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtChanceToMeleeCritFile is the client file GtChanceToMeleeCritRecord is read from.
+const GtChanceToMeleeCritFile = "gtChanceToMeleeCrit.dbc"
+
+// GtChanceToMeleeCritRecord is one record of gtChanceToMeleeCrit.dbc.
+type GtChanceToMeleeCritRecord struct {
+	Data float32
+}
+
+// ReadGtChanceToMeleeCrit reads every record of gtChanceToMeleeCrit.dbc from r.
+func ReadGtChanceToMeleeCrit(r io.Reader) ([]GtChanceToMeleeCritRecord, error) {
+	return readRecords[GtChanceToMeleeCritRecord](r, GtChanceToMeleeCritFile, 4)
+}
+
+// WriteGtChanceToMeleeCrit writes records to w in gtChanceToMeleeCrit.dbc format.
+func WriteGtChanceToMeleeCrit(w io.Writer, records []GtChanceToMeleeCritRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtChanceToMeleeCritRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtChanceToMeleeCritRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtChanceToMeleeCritBaseFile is the client file GtChanceToMeleeCritBaseRecord is read from.
+const GtChanceToMeleeCritBaseFile = "gtChanceToMeleeCritBase.dbc"
+
+// GtChanceToMeleeCritBaseRecord is one record of gtChanceToMeleeCritBase.dbc.
+type GtChanceToMeleeCritBaseRecord struct {
+	Data float32
+}
+
+// ReadGtChanceToMeleeCritBase reads every record of gtChanceToMeleeCritBase.dbc from r.
+func ReadGtChanceToMeleeCritBase(r io.Reader) ([]GtChanceToMeleeCritBaseRecord, error) {
+	return readRecords[GtChanceToMeleeCritBaseRecord](r, GtChanceToMeleeCritBaseFile, 4)
+}
+
+// WriteGtChanceToMeleeCritBase writes records to w in gtChanceToMeleeCritBase.dbc format.
+func WriteGtChanceToMeleeCritBase(w io.Writer, records []GtChanceToMeleeCritBaseRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtChanceToMeleeCritBaseRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtChanceToMeleeCritBaseRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtChanceToSpellCritFile is the client file GtChanceToSpellCritRecord is read from.
+const GtChanceToSpellCritFile = "gtChanceToSpellCrit.dbc"
+
+// GtChanceToSpellCritRecord is one record of gtChanceToSpellCrit.dbc.
+type GtChanceToSpellCritRecord struct {
+	Data float32
+}
+
+// ReadGtChanceToSpellCrit reads every record of gtChanceToSpellCrit.dbc from r.
+func ReadGtChanceToSpellCrit(r io.Reader) ([]GtChanceToSpellCritRecord, error) {
+	return readRecords[GtChanceToSpellCritRecord](r, GtChanceToSpellCritFile, 4)
+}
+
+// WriteGtChanceToSpellCrit writes records to w in gtChanceToSpellCrit.dbc format.
+func WriteGtChanceToSpellCrit(w io.Writer, records []GtChanceToSpellCritRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtChanceToSpellCritRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtChanceToSpellCritRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
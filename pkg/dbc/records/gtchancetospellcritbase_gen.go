@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtChanceToSpellCritBaseFile is the client file GtChanceToSpellCritBaseRecord is read from.
+const GtChanceToSpellCritBaseFile = "gtChanceToSpellCritBase.dbc"
+
+// GtChanceToSpellCritBaseRecord is one record of gtChanceToSpellCritBase.dbc.
+type GtChanceToSpellCritBaseRecord struct {
+	Data float32
+}
+
+// ReadGtChanceToSpellCritBase reads every record of gtChanceToSpellCritBase.dbc from r.
+func ReadGtChanceToSpellCritBase(r io.Reader) ([]GtChanceToSpellCritBaseRecord, error) {
+	return readRecords[GtChanceToSpellCritBaseRecord](r, GtChanceToSpellCritBaseFile, 4)
+}
+
+// WriteGtChanceToSpellCritBase writes records to w in gtChanceToSpellCritBase.dbc format.
+func WriteGtChanceToSpellCritBase(w io.Writer, records []GtChanceToSpellCritBaseRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtChanceToSpellCritBaseRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtChanceToSpellCritBaseRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
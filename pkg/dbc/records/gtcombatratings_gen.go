@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtCombatRatingsFile is the client file GtCombatRatingsRecord is read from.
+const GtCombatRatingsFile = "gtCombatRatings.dbc"
+
+// GtCombatRatingsRecord is one record of gtCombatRatings.dbc.
+type GtCombatRatingsRecord struct {
+	Data float32
+}
+
+// ReadGtCombatRatings reads every record of gtCombatRatings.dbc from r.
+func ReadGtCombatRatings(r io.Reader) ([]GtCombatRatingsRecord, error) {
+	return readRecords[GtCombatRatingsRecord](r, GtCombatRatingsFile, 4)
+}
+
+// WriteGtCombatRatings writes records to w in gtCombatRatings.dbc format.
+func WriteGtCombatRatings(w io.Writer, records []GtCombatRatingsRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtCombatRatingsRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtCombatRatingsRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
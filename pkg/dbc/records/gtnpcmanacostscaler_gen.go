@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtNPCManaCostScalerFile is the client file GtNPCManaCostScalerRecord is read from.
+const GtNPCManaCostScalerFile = "gtNPCManaCostScaler.dbc"
+
+// GtNPCManaCostScalerRecord is one record of gtNPCManaCostScaler.dbc.
+type GtNPCManaCostScalerRecord struct {
+	Data float32
+}
+
+// ReadGtNPCManaCostScaler reads every record of gtNPCManaCostScaler.dbc from r.
+func ReadGtNPCManaCostScaler(r io.Reader) ([]GtNPCManaCostScalerRecord, error) {
+	return readRecords[GtNPCManaCostScalerRecord](r, GtNPCManaCostScalerFile, 4)
+}
+
+// WriteGtNPCManaCostScaler writes records to w in gtNPCManaCostScaler.dbc format.
+func WriteGtNPCManaCostScaler(w io.Writer, records []GtNPCManaCostScalerRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtNPCManaCostScalerRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtNPCManaCostScalerRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtOCTClassCombatRatingScalarFile is the client file GtOCTClassCombatRatingScalarRecord is read from.
+const GtOCTClassCombatRatingScalarFile = "gtOCTClassCombatRatingScalar.dbc"
+
+// GtOCTClassCombatRatingScalarRecord is one record of gtOCTClassCombatRatingScalar.dbc.
+type GtOCTClassCombatRatingScalarRecord struct {
+	ID   uint32
+	Data float32
+}
+
+// ReadGtOCTClassCombatRatingScalar reads every record of gtOCTClassCombatRatingScalar.dbc from r.
+func ReadGtOCTClassCombatRatingScalar(r io.Reader) ([]GtOCTClassCombatRatingScalarRecord, error) {
+	return readRecords[GtOCTClassCombatRatingScalarRecord](r, GtOCTClassCombatRatingScalarFile, 8)
+}
+
+// WriteGtOCTClassCombatRatingScalar writes records to w in gtOCTClassCombatRatingScalar.dbc format.
+func WriteGtOCTClassCombatRatingScalar(w io.Writer, records []GtOCTClassCombatRatingScalarRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *GtOCTClassCombatRatingScalarRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Data = d.float32()
+}
+
+func (r *GtOCTClassCombatRatingScalarRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.float32(r.Data)
+}
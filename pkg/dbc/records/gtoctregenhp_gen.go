@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtOCTRegenHPFile is the client file GtOCTRegenHPRecord is read from.
+const GtOCTRegenHPFile = "gtOCTRegenHP.dbc"
+
+// GtOCTRegenHPRecord is one record of gtOCTRegenHP.dbc.
+type GtOCTRegenHPRecord struct {
+	Data float32
+}
+
+// ReadGtOCTRegenHP reads every record of gtOCTRegenHP.dbc from r.
+func ReadGtOCTRegenHP(r io.Reader) ([]GtOCTRegenHPRecord, error) {
+	return readRecords[GtOCTRegenHPRecord](r, GtOCTRegenHPFile, 4)
+}
+
+// WriteGtOCTRegenHP writes records to w in gtOCTRegenHP.dbc format.
+func WriteGtOCTRegenHP(w io.Writer, records []GtOCTRegenHPRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtOCTRegenHPRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtOCTRegenHPRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtOCTRegenMPFile is the client file GtOCTRegenMPRecord is read from.
+const GtOCTRegenMPFile = "gtOCTRegenMP.dbc"
+
+// GtOCTRegenMPRecord is one record of gtOCTRegenMP.dbc.
+type GtOCTRegenMPRecord struct {
+	Data float32
+}
+
+// ReadGtOCTRegenMP reads every record of gtOCTRegenMP.dbc from r.
+func ReadGtOCTRegenMP(r io.Reader) ([]GtOCTRegenMPRecord, error) {
+	return readRecords[GtOCTRegenMPRecord](r, GtOCTRegenMPFile, 4)
+}
+
+// WriteGtOCTRegenMP writes records to w in gtOCTRegenMP.dbc format.
+func WriteGtOCTRegenMP(w io.Writer, records []GtOCTRegenMPRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtOCTRegenMPRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtOCTRegenMPRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtRegenHPPerSptFile is the client file GtRegenHPPerSptRecord is read from.
+const GtRegenHPPerSptFile = "gtRegenHPPerSpt.dbc"
+
+// GtRegenHPPerSptRecord is one record of gtRegenHPPerSpt.dbc.
+type GtRegenHPPerSptRecord struct {
+	Data float32
+}
+
+// ReadGtRegenHPPerSpt reads every record of gtRegenHPPerSpt.dbc from r.
+func ReadGtRegenHPPerSpt(r io.Reader) ([]GtRegenHPPerSptRecord, error) {
+	return readRecords[GtRegenHPPerSptRecord](r, GtRegenHPPerSptFile, 4)
+}
+
+// WriteGtRegenHPPerSpt writes records to w in gtRegenHPPerSpt.dbc format.
+func WriteGtRegenHPPerSpt(w io.Writer, records []GtRegenHPPerSptRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtRegenHPPerSptRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtRegenHPPerSptRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,31 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// GtRegenMPPerSptFile is the client file GtRegenMPPerSptRecord is read from.
+const GtRegenMPPerSptFile = "gtRegenMPPerSpt.dbc"
+
+// GtRegenMPPerSptRecord is one record of gtRegenMPPerSpt.dbc.
+type GtRegenMPPerSptRecord struct {
+	Data float32
+}
+
+// ReadGtRegenMPPerSpt reads every record of gtRegenMPPerSpt.dbc from r.
+func ReadGtRegenMPPerSpt(r io.Reader) ([]GtRegenMPPerSptRecord, error) {
+	return readRecords[GtRegenMPPerSptRecord](r, GtRegenMPPerSptFile, 4)
+}
+
+// WriteGtRegenMPPerSpt writes records to w in gtRegenMPPerSpt.dbc format.
+func WriteGtRegenMPPerSpt(w io.Writer, records []GtRegenMPPerSptRecord) error {
+	return writeRecords(w, records, 4, 1)
+}
+
+func (r *GtRegenMPPerSptRecord) decode(d *decoder) {
+	r.Data = d.float32()
+}
+
+func (r *GtRegenMPPerSptRecord) encode(e *encoder) {
+	e.float32(r.Data)
+}
@@ -0,0 +1,76 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// HolidaysFile is the client file HolidaysRecord is read from.
+const HolidaysFile = "Holidays.dbc"
+
+// HolidaysRecord is one record of Holidays.dbc.
+type HolidaysRecord struct {
+	ID                   uint32
+	Duration             [10]uint32
+	Date                 [26]uint32
+	Region               uint32
+	Looping              uint32
+	CalendarFlags        [10]uint32
+	HolidayNameID        uint32
+	HolidayDescriptionID uint32
+	TextureFilename      string
+	Priority             uint32
+	CalendarFilterType   int32
+	Flags                uint32
+}
+
+// ReadHolidays reads every record of Holidays.dbc from r.
+func ReadHolidays(r io.Reader) ([]HolidaysRecord, error) {
+	return readRecords[HolidaysRecord](r, HolidaysFile, 220)
+}
+
+// WriteHolidays writes records to w in Holidays.dbc format.
+func WriteHolidays(w io.Writer, records []HolidaysRecord) error {
+	return writeRecords(w, records, 220, 55)
+}
+
+func (r *HolidaysRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.Duration {
+		r.Duration[i] = d.uint32()
+	}
+	for i := range r.Date {
+		r.Date[i] = d.uint32()
+	}
+	r.Region = d.uint32()
+	r.Looping = d.uint32()
+	for i := range r.CalendarFlags {
+		r.CalendarFlags[i] = d.uint32()
+	}
+	r.HolidayNameID = d.uint32()
+	r.HolidayDescriptionID = d.uint32()
+	r.TextureFilename = d.string()
+	r.Priority = d.uint32()
+	r.CalendarFilterType = d.int32()
+	r.Flags = d.uint32()
+}
+
+func (r *HolidaysRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.Duration {
+		e.uint32(v)
+	}
+	for _, v := range r.Date {
+		e.uint32(v)
+	}
+	e.uint32(r.Region)
+	e.uint32(r.Looping)
+	for _, v := range r.CalendarFlags {
+		e.uint32(v)
+	}
+	e.uint32(r.HolidayNameID)
+	e.uint32(r.HolidayDescriptionID)
+	e.string(r.TextureFilename)
+	e.uint32(r.Priority)
+	e.int32(r.CalendarFilterType)
+	e.uint32(r.Flags)
+}
@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemFile is the client file ItemRecord is read from.
+const ItemFile = "Item.dbc"
+
+// ItemRecord is one record of Item.dbc.
+type ItemRecord struct {
+	ID                    uint32
+	Class                 uint32
+	Subclass              uint32
+	SoundOverrideSubclass int32
+	Material              int32
+	DisplayID             uint32
+	InventoryType         uint32
+	Sheath                uint32
+}
+
+// ReadItem reads every record of Item.dbc from r.
+func ReadItem(r io.Reader) ([]ItemRecord, error) {
+	return readRecords[ItemRecord](r, ItemFile, 32)
+}
+
+// WriteItem writes records to w in Item.dbc format.
+func WriteItem(w io.Writer, records []ItemRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *ItemRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Class = d.uint32()
+	r.Subclass = d.uint32()
+	r.SoundOverrideSubclass = d.int32()
+	r.Material = d.int32()
+	r.DisplayID = d.uint32()
+	r.InventoryType = d.uint32()
+	r.Sheath = d.uint32()
+}
+
+func (r *ItemRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Class)
+	e.uint32(r.Subclass)
+	e.int32(r.SoundOverrideSubclass)
+	e.int32(r.Material)
+	e.uint32(r.DisplayID)
+	e.uint32(r.InventoryType)
+	e.uint32(r.Sheath)
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItembagfamilyFile is the client file ItembagfamilyRecord is read from.
+const ItembagfamilyFile = "Itembagfamily.dbc"
+
+// ItembagfamilyRecord is one record of Itembagfamily.dbc.
+type ItembagfamilyRecord struct {
+	ID   uint32
+	Name Loc
+}
+
+// ReadItembagfamily reads every record of Itembagfamily.dbc from r.
+func ReadItembagfamily(r io.Reader) ([]ItembagfamilyRecord, error) {
+	return readRecords[ItembagfamilyRecord](r, ItembagfamilyFile, 72)
+}
+
+// WriteItembagfamily writes records to w in Itembagfamily.dbc format.
+func WriteItembagfamily(w io.Writer, records []ItembagfamilyRecord) error {
+	return writeRecords(w, records, 72, 18)
+}
+
+func (r *ItembagfamilyRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *ItembagfamilyRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+}
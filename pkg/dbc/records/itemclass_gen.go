@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemClassFile is the client file ItemClassRecord is read from.
+const ItemClassFile = "ItemClass.dbc"
+
+// ItemClassRecord is one record of ItemClass.dbc.
+type ItemClassRecord struct {
+	ID         uint32
+	SubclassID uint32
+	Flags      uint32
+	Name       Loc
+}
+
+// ReadItemClass reads every record of ItemClass.dbc from r.
+func ReadItemClass(r io.Reader) ([]ItemClassRecord, error) {
+	return readRecords[ItemClassRecord](r, ItemClassFile, 80)
+}
+
+// WriteItemClass writes records to w in ItemClass.dbc format.
+func WriteItemClass(w io.Writer, records []ItemClassRecord) error {
+	return writeRecords(w, records, 80, 20)
+}
+
+func (r *ItemClassRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SubclassID = d.uint32()
+	r.Flags = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *ItemClassRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.SubclassID)
+	e.uint32(r.Flags)
+	e.loc(r.Name)
+}
@@ -0,0 +1,102 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemDisplayInfoFile is the client file ItemDisplayInfoRecord is read from.
+const ItemDisplayInfoFile = "ItemDisplayInfo.dbc"
+
+// ItemDisplayInfoRecord is one record of ItemDisplayInfo.dbc.
+type ItemDisplayInfoRecord struct {
+	ID                 uint32
+	LeftModel          string
+	RightModel         string
+	LeftModelTexture   string
+	RightModelTexture  string
+	Icon               [2]string
+	GeosetGroup        [3]uint32
+	Flags              uint32
+	SpellVisualID      uint32
+	GroupSoundIndex    uint32
+	HelmetGeosetMale   uint32
+	HelmetGeosetFemale uint32
+	UpperArmTexture    string
+	LowerArmTexture    string
+	HandsTexture       string
+	UpperTorsoTexture  string
+	LowerTorsoTexture  string
+	UpperLegTexture    string
+	LowerLegTexture    string
+	FootTexture        string
+	ItemVisual         int32
+	ParticleColourID   uint32
+}
+
+// ReadItemDisplayInfo reads every record of ItemDisplayInfo.dbc from r.
+func ReadItemDisplayInfo(r io.Reader) ([]ItemDisplayInfoRecord, error) {
+	return readRecords[ItemDisplayInfoRecord](r, ItemDisplayInfoFile, 100)
+}
+
+// WriteItemDisplayInfo writes records to w in ItemDisplayInfo.dbc format.
+func WriteItemDisplayInfo(w io.Writer, records []ItemDisplayInfoRecord) error {
+	return writeRecords(w, records, 100, 25)
+}
+
+func (r *ItemDisplayInfoRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.LeftModel = d.string()
+	r.RightModel = d.string()
+	r.LeftModelTexture = d.string()
+	r.RightModelTexture = d.string()
+	for i := range r.Icon {
+		r.Icon[i] = d.string()
+	}
+	for i := range r.GeosetGroup {
+		r.GeosetGroup[i] = d.uint32()
+	}
+	r.Flags = d.uint32()
+	r.SpellVisualID = d.uint32()
+	r.GroupSoundIndex = d.uint32()
+	r.HelmetGeosetMale = d.uint32()
+	r.HelmetGeosetFemale = d.uint32()
+	r.UpperArmTexture = d.string()
+	r.LowerArmTexture = d.string()
+	r.HandsTexture = d.string()
+	r.UpperTorsoTexture = d.string()
+	r.LowerTorsoTexture = d.string()
+	r.UpperLegTexture = d.string()
+	r.LowerLegTexture = d.string()
+	r.FootTexture = d.string()
+	r.ItemVisual = d.int32()
+	r.ParticleColourID = d.uint32()
+}
+
+func (r *ItemDisplayInfoRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.LeftModel)
+	e.string(r.RightModel)
+	e.string(r.LeftModelTexture)
+	e.string(r.RightModelTexture)
+	for _, v := range r.Icon {
+		e.string(v)
+	}
+	for _, v := range r.GeosetGroup {
+		e.uint32(v)
+	}
+	e.uint32(r.Flags)
+	e.uint32(r.SpellVisualID)
+	e.uint32(r.GroupSoundIndex)
+	e.uint32(r.HelmetGeosetMale)
+	e.uint32(r.HelmetGeosetFemale)
+	e.string(r.UpperArmTexture)
+	e.string(r.LowerArmTexture)
+	e.string(r.HandsTexture)
+	e.string(r.UpperTorsoTexture)
+	e.string(r.LowerTorsoTexture)
+	e.string(r.UpperLegTexture)
+	e.string(r.LowerLegTexture)
+	e.string(r.FootTexture)
+	e.int32(r.ItemVisual)
+	e.uint32(r.ParticleColourID)
+}
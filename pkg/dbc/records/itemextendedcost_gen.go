@@ -0,0 +1,60 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemExtendedCostFile is the client file ItemExtendedCostRecord is read from.
+const ItemExtendedCostFile = "ItemExtendedCost.dbc"
+
+// ItemExtendedCostRecord is one record of ItemExtendedCost.dbc.
+type ItemExtendedCostRecord struct {
+	ID             uint32
+	HonorPoints    uint32
+	ArenaPoints    uint32
+	ReqArenaSlot   uint32
+	ReqItem        [5]uint32
+	ReqItemCost    [5]uint32
+	ReqArenaRating uint32
+	PurchaseGroup  uint32
+}
+
+// ReadItemExtendedCost reads every record of ItemExtendedCost.dbc from r.
+func ReadItemExtendedCost(r io.Reader) ([]ItemExtendedCostRecord, error) {
+	return readRecords[ItemExtendedCostRecord](r, ItemExtendedCostFile, 64)
+}
+
+// WriteItemExtendedCost writes records to w in ItemExtendedCost.dbc format.
+func WriteItemExtendedCost(w io.Writer, records []ItemExtendedCostRecord) error {
+	return writeRecords(w, records, 64, 16)
+}
+
+func (r *ItemExtendedCostRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.HonorPoints = d.uint32()
+	r.ArenaPoints = d.uint32()
+	r.ReqArenaSlot = d.uint32()
+	for i := range r.ReqItem {
+		r.ReqItem[i] = d.uint32()
+	}
+	for i := range r.ReqItemCost {
+		r.ReqItemCost[i] = d.uint32()
+	}
+	r.ReqArenaRating = d.uint32()
+	r.PurchaseGroup = d.uint32()
+}
+
+func (r *ItemExtendedCostRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.HonorPoints)
+	e.uint32(r.ArenaPoints)
+	e.uint32(r.ReqArenaSlot)
+	for _, v := range r.ReqItem {
+		e.uint32(v)
+	}
+	for _, v := range r.ReqItemCost {
+		e.uint32(v)
+	}
+	e.uint32(r.ReqArenaRating)
+	e.uint32(r.PurchaseGroup)
+}
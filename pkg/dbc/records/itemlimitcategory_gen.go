@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemlimitcategoryFile is the client file ItemlimitcategoryRecord is read from.
+const ItemlimitcategoryFile = "Itemlimitcategory.dbc"
+
+// ItemlimitcategoryRecord is one record of Itemlimitcategory.dbc.
+type ItemlimitcategoryRecord struct {
+	ID       uint32
+	Name     Loc
+	Quantity uint32
+	Flags    uint32
+}
+
+// ReadItemlimitcategory reads every record of Itemlimitcategory.dbc from r.
+func ReadItemlimitcategory(r io.Reader) ([]ItemlimitcategoryRecord, error) {
+	return readRecords[ItemlimitcategoryRecord](r, ItemlimitcategoryFile, 80)
+}
+
+// WriteItemlimitcategory writes records to w in Itemlimitcategory.dbc format.
+func WriteItemlimitcategory(w io.Writer, records []ItemlimitcategoryRecord) error {
+	return writeRecords(w, records, 80, 20)
+}
+
+func (r *ItemlimitcategoryRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	r.Quantity = d.uint32()
+	r.Flags = d.uint32()
+}
+
+func (r *ItemlimitcategoryRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	e.uint32(r.Quantity)
+	e.uint32(r.Flags)
+}
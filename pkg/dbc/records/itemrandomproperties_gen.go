@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemrandompropertiesFile is the client file ItemrandompropertiesRecord is read from.
+const ItemrandompropertiesFile = "Itemrandomproperties.dbc"
+
+// ItemrandompropertiesRecord is one record of Itemrandomproperties.dbc.
+type ItemrandompropertiesRecord struct {
+	ID           uint32
+	NameInternal uint32
+	Enchantment1 int32
+	Enchantment2 int32
+	Enchantment3 int32
+	Unused1      uint32
+	Unused2      uint32
+	Name         Loc
+}
+
+// ReadItemrandomproperties reads every record of Itemrandomproperties.dbc from r.
+func ReadItemrandomproperties(r io.Reader) ([]ItemrandompropertiesRecord, error) {
+	return readRecords[ItemrandompropertiesRecord](r, ItemrandompropertiesFile, 96)
+}
+
+// WriteItemrandomproperties writes records to w in Itemrandomproperties.dbc format.
+func WriteItemrandomproperties(w io.Writer, records []ItemrandompropertiesRecord) error {
+	return writeRecords(w, records, 96, 24)
+}
+
+func (r *ItemrandompropertiesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.NameInternal = d.uint32()
+	r.Enchantment1 = d.int32()
+	r.Enchantment2 = d.int32()
+	r.Enchantment3 = d.int32()
+	r.Unused1 = d.uint32()
+	r.Unused2 = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *ItemrandompropertiesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.NameInternal)
+	e.int32(r.Enchantment1)
+	e.int32(r.Enchantment2)
+	e.int32(r.Enchantment3)
+	e.uint32(r.Unused1)
+	e.uint32(r.Unused2)
+	e.loc(r.Name)
+}
@@ -0,0 +1,65 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemrandomsuffixFile is the client file ItemrandomsuffixRecord is read from.
+const ItemrandomsuffixFile = "Itemrandomsuffix.dbc"
+
+// ItemrandomsuffixRecord is one record of Itemrandomsuffix.dbc.
+type ItemrandomsuffixRecord struct {
+	ID                  uint32
+	Name                Loc
+	InternalName        string
+	Enchantment         [3]uint32
+	UnusedEnchantment   [2]uint32
+	AllocationPct       [3]uint32
+	UnusedAllocationPct [2]uint32
+}
+
+// ReadItemrandomsuffix reads every record of Itemrandomsuffix.dbc from r.
+func ReadItemrandomsuffix(r io.Reader) ([]ItemrandomsuffixRecord, error) {
+	return readRecords[ItemrandomsuffixRecord](r, ItemrandomsuffixFile, 116)
+}
+
+// WriteItemrandomsuffix writes records to w in Itemrandomsuffix.dbc format.
+func WriteItemrandomsuffix(w io.Writer, records []ItemrandomsuffixRecord) error {
+	return writeRecords(w, records, 116, 29)
+}
+
+func (r *ItemrandomsuffixRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	r.InternalName = d.string()
+	for i := range r.Enchantment {
+		r.Enchantment[i] = d.uint32()
+	}
+	for i := range r.UnusedEnchantment {
+		r.UnusedEnchantment[i] = d.uint32()
+	}
+	for i := range r.AllocationPct {
+		r.AllocationPct[i] = d.uint32()
+	}
+	for i := range r.UnusedAllocationPct {
+		r.UnusedAllocationPct[i] = d.uint32()
+	}
+}
+
+func (r *ItemrandomsuffixRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	e.string(r.InternalName)
+	for _, v := range r.Enchantment {
+		e.uint32(v)
+	}
+	for _, v := range r.UnusedEnchantment {
+		e.uint32(v)
+	}
+	for _, v := range r.AllocationPct {
+		e.uint32(v)
+	}
+	for _, v := range r.UnusedAllocationPct {
+		e.uint32(v)
+	}
+}
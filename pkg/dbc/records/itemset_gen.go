@@ -0,0 +1,61 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemSetFile is the client file ItemSetRecord is read from.
+const ItemSetFile = "ItemSet.dbc"
+
+// ItemSetRecord is one record of ItemSet.dbc.
+type ItemSetRecord struct {
+	ID              uint32
+	Name            Loc
+	Item            [17]uint32
+	EffectSpellID   [8]uint32
+	EffectItemCount [8]uint32
+	ReqSkillID      uint32
+	ReqSkillRank    uint32
+}
+
+// ReadItemSet reads every record of ItemSet.dbc from r.
+func ReadItemSet(r io.Reader) ([]ItemSetRecord, error) {
+	return readRecords[ItemSetRecord](r, ItemSetFile, 212)
+}
+
+// WriteItemSet writes records to w in ItemSet.dbc format.
+func WriteItemSet(w io.Writer, records []ItemSetRecord) error {
+	return writeRecords(w, records, 212, 53)
+}
+
+func (r *ItemSetRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	for i := range r.Item {
+		r.Item[i] = d.uint32()
+	}
+	for i := range r.EffectSpellID {
+		r.EffectSpellID[i] = d.uint32()
+	}
+	for i := range r.EffectItemCount {
+		r.EffectItemCount[i] = d.uint32()
+	}
+	r.ReqSkillID = d.uint32()
+	r.ReqSkillRank = d.uint32()
+}
+
+func (r *ItemSetRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	for _, v := range r.Item {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectSpellID {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectItemCount {
+		e.uint32(v)
+	}
+	e.uint32(r.ReqSkillID)
+	e.uint32(r.ReqSkillRank)
+}
@@ -0,0 +1,64 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ItemSubClassFile is the client file ItemSubClassRecord is read from.
+const ItemSubClassFile = "ItemSubClass.dbc"
+
+// ItemSubClassRecord is one record of ItemSubClass.dbc.
+type ItemSubClassRecord struct {
+	Class              uint32
+	Subclass           uint32
+	PrereqProficiency  int32
+	PostreqProficiency int32
+	Flags              uint32
+	DisplayFlags       uint32
+	WepParrySeq        uint32
+	WepReadySeq        uint32
+	WepAttackSeq       uint32
+	WepSwingSize       uint32
+	DisplayName        Loc
+	VerboseName        Loc
+}
+
+// ReadItemSubClass reads every record of ItemSubClass.dbc from r.
+func ReadItemSubClass(r io.Reader) ([]ItemSubClassRecord, error) {
+	return readRecords[ItemSubClassRecord](r, ItemSubClassFile, 176)
+}
+
+// WriteItemSubClass writes records to w in ItemSubClass.dbc format.
+func WriteItemSubClass(w io.Writer, records []ItemSubClassRecord) error {
+	return writeRecords(w, records, 176, 44)
+}
+
+func (r *ItemSubClassRecord) decode(d *decoder) {
+	r.Class = d.uint32()
+	r.Subclass = d.uint32()
+	r.PrereqProficiency = d.int32()
+	r.PostreqProficiency = d.int32()
+	r.Flags = d.uint32()
+	r.DisplayFlags = d.uint32()
+	r.WepParrySeq = d.uint32()
+	r.WepReadySeq = d.uint32()
+	r.WepAttackSeq = d.uint32()
+	r.WepSwingSize = d.uint32()
+	r.DisplayName = d.loc()
+	r.VerboseName = d.loc()
+}
+
+func (r *ItemSubClassRecord) encode(e *encoder) {
+	e.uint32(r.Class)
+	e.uint32(r.Subclass)
+	e.int32(r.PrereqProficiency)
+	e.int32(r.PostreqProficiency)
+	e.uint32(r.Flags)
+	e.uint32(r.DisplayFlags)
+	e.uint32(r.WepParrySeq)
+	e.uint32(r.WepReadySeq)
+	e.uint32(r.WepAttackSeq)
+	e.uint32(r.WepSwingSize)
+	e.loc(r.DisplayName)
+	e.loc(r.VerboseName)
+}
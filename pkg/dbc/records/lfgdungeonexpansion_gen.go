@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// LfgdungeonexpansionFile is the client file LfgdungeonexpansionRecord is read from.
+const LfgdungeonexpansionFile = "Lfgdungeonexpansion.dbc"
+
+// LfgdungeonexpansionRecord is one record of Lfgdungeonexpansion.dbc.
+type LfgdungeonexpansionRecord struct {
+	ID             uint32
+	LfgID          int32
+	ExpansionLevel int32
+	RandomID       uint32
+	HardLevelMin   int32
+	HardLevelMax   int32
+	TargetLevelMin uint32
+	TargetLevelMax uint32
+}
+
+// ReadLfgdungeonexpansion reads every record of Lfgdungeonexpansion.dbc from r.
+func ReadLfgdungeonexpansion(r io.Reader) ([]LfgdungeonexpansionRecord, error) {
+	return readRecords[LfgdungeonexpansionRecord](r, LfgdungeonexpansionFile, 32)
+}
+
+// WriteLfgdungeonexpansion writes records to w in Lfgdungeonexpansion.dbc format.
+func WriteLfgdungeonexpansion(w io.Writer, records []LfgdungeonexpansionRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *LfgdungeonexpansionRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.LfgID = d.int32()
+	r.ExpansionLevel = d.int32()
+	r.RandomID = d.uint32()
+	r.HardLevelMin = d.int32()
+	r.HardLevelMax = d.int32()
+	r.TargetLevelMin = d.uint32()
+	r.TargetLevelMax = d.uint32()
+}
+
+func (r *LfgdungeonexpansionRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.LfgID)
+	e.int32(r.ExpansionLevel)
+	e.uint32(r.RandomID)
+	e.int32(r.HardLevelMin)
+	e.int32(r.HardLevelMax)
+	e.uint32(r.TargetLevelMin)
+	e.uint32(r.TargetLevelMax)
+}
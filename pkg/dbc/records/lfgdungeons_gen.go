@@ -0,0 +1,79 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// LfgdungeonsFile is the client file LfgdungeonsRecord is read from.
+const LfgdungeonsFile = "Lfgdungeons.dbc"
+
+// LfgdungeonsRecord is one record of Lfgdungeons.dbc.
+type LfgdungeonsRecord struct {
+	ID              uint32
+	Name            Loc
+	MinLevel        uint32
+	MaxLevel        uint32
+	TargetLevel     uint32
+	TargetLevelMin  uint32
+	TargetLevelMax  uint32
+	MapID           int32
+	Difficulty      uint32
+	Flags           uint32
+	TypeID          uint32
+	Faction         int32
+	TextureFilename string
+	ExpansionLevel  uint32
+	OrderIndex      uint32
+	GroupID         uint32
+	Description     Loc
+}
+
+// ReadLfgdungeons reads every record of Lfgdungeons.dbc from r.
+func ReadLfgdungeons(r io.Reader) ([]LfgdungeonsRecord, error) {
+	return readRecords[LfgdungeonsRecord](r, LfgdungeonsFile, 196)
+}
+
+// WriteLfgdungeons writes records to w in Lfgdungeons.dbc format.
+func WriteLfgdungeons(w io.Writer, records []LfgdungeonsRecord) error {
+	return writeRecords(w, records, 196, 49)
+}
+
+func (r *LfgdungeonsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	r.MinLevel = d.uint32()
+	r.MaxLevel = d.uint32()
+	r.TargetLevel = d.uint32()
+	r.TargetLevelMin = d.uint32()
+	r.TargetLevelMax = d.uint32()
+	r.MapID = d.int32()
+	r.Difficulty = d.uint32()
+	r.Flags = d.uint32()
+	r.TypeID = d.uint32()
+	r.Faction = d.int32()
+	r.TextureFilename = d.string()
+	r.ExpansionLevel = d.uint32()
+	r.OrderIndex = d.uint32()
+	r.GroupID = d.uint32()
+	r.Description = d.loc()
+}
+
+func (r *LfgdungeonsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	e.uint32(r.MinLevel)
+	e.uint32(r.MaxLevel)
+	e.uint32(r.TargetLevel)
+	e.uint32(r.TargetLevelMin)
+	e.uint32(r.TargetLevelMax)
+	e.int32(r.MapID)
+	e.uint32(r.Difficulty)
+	e.uint32(r.Flags)
+	e.uint32(r.TypeID)
+	e.int32(r.Faction)
+	e.string(r.TextureFilename)
+	e.uint32(r.ExpansionLevel)
+	e.uint32(r.OrderIndex)
+	e.uint32(r.GroupID)
+	e.loc(r.Description)
+}
@@ -0,0 +1,73 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// LightFile is the client file LightRecord is read from.
+const LightFile = "Light.dbc"
+
+// LightRecord is one record of Light.dbc.
+type LightRecord struct {
+	ID            uint32
+	MapID         int32
+	PositionX     float32
+	PositionY     float32
+	PositionZ     float32
+	FalloffStart1 uint32
+	FalloffStart2 uint32
+	FalloffStart3 uint32
+	FalloffStart4 uint32
+	FalloffStart5 uint32
+	FalloffEnd1   uint32
+	FalloffEnd2   uint32
+	FalloffEnd3   uint32
+	FalloffEnd4   uint32
+	FalloffEnd5   uint32
+}
+
+// ReadLight reads every record of Light.dbc from r.
+func ReadLight(r io.Reader) ([]LightRecord, error) {
+	return readRecords[LightRecord](r, LightFile, 60)
+}
+
+// WriteLight writes records to w in Light.dbc format.
+func WriteLight(w io.Writer, records []LightRecord) error {
+	return writeRecords(w, records, 60, 15)
+}
+
+func (r *LightRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MapID = d.int32()
+	r.PositionX = d.float32()
+	r.PositionY = d.float32()
+	r.PositionZ = d.float32()
+	r.FalloffStart1 = d.uint32()
+	r.FalloffStart2 = d.uint32()
+	r.FalloffStart3 = d.uint32()
+	r.FalloffStart4 = d.uint32()
+	r.FalloffStart5 = d.uint32()
+	r.FalloffEnd1 = d.uint32()
+	r.FalloffEnd2 = d.uint32()
+	r.FalloffEnd3 = d.uint32()
+	r.FalloffEnd4 = d.uint32()
+	r.FalloffEnd5 = d.uint32()
+}
+
+func (r *LightRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.MapID)
+	e.float32(r.PositionX)
+	e.float32(r.PositionY)
+	e.float32(r.PositionZ)
+	e.uint32(r.FalloffStart1)
+	e.uint32(r.FalloffStart2)
+	e.uint32(r.FalloffStart3)
+	e.uint32(r.FalloffStart4)
+	e.uint32(r.FalloffStart5)
+	e.uint32(r.FalloffEnd1)
+	e.uint32(r.FalloffEnd2)
+	e.uint32(r.FalloffEnd3)
+	e.uint32(r.FalloffEnd4)
+	e.uint32(r.FalloffEnd5)
+}
@@ -0,0 +1,101 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// LiquidtypeFile is the client file LiquidtypeRecord is read from.
+const LiquidtypeFile = "Liquidtype.dbc"
+
+// LiquidtypeRecord is one record of Liquidtype.dbc.
+type LiquidtypeRecord struct {
+	ID                 uint32
+	Name               string
+	Flags              uint32
+	SoundBank          uint32
+	SoundID            uint32
+	SpellID            uint32
+	MaxDarkenDepth     float32
+	FogDarkenIntensity float32
+	AmbDarkenIntensity float32
+	DirDarkenIntensity float32
+	LightID            uint32
+	ParticleScale      float32
+	ParticleMovement   uint32
+	ParticleTexSlots   uint32
+	MaterialID         uint32
+	Texture            [6]string
+	Color              [2]uint32
+	UnkFloat           [18]float32
+	UnkInt             [4]uint32
+}
+
+// ReadLiquidtype reads every record of Liquidtype.dbc from r.
+func ReadLiquidtype(r io.Reader) ([]LiquidtypeRecord, error) {
+	return readRecords[LiquidtypeRecord](r, LiquidtypeFile, 180)
+}
+
+// WriteLiquidtype writes records to w in Liquidtype.dbc format.
+func WriteLiquidtype(w io.Writer, records []LiquidtypeRecord) error {
+	return writeRecords(w, records, 180, 45)
+}
+
+func (r *LiquidtypeRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.string()
+	r.Flags = d.uint32()
+	r.SoundBank = d.uint32()
+	r.SoundID = d.uint32()
+	r.SpellID = d.uint32()
+	r.MaxDarkenDepth = d.float32()
+	r.FogDarkenIntensity = d.float32()
+	r.AmbDarkenIntensity = d.float32()
+	r.DirDarkenIntensity = d.float32()
+	r.LightID = d.uint32()
+	r.ParticleScale = d.float32()
+	r.ParticleMovement = d.uint32()
+	r.ParticleTexSlots = d.uint32()
+	r.MaterialID = d.uint32()
+	for i := range r.Texture {
+		r.Texture[i] = d.string()
+	}
+	for i := range r.Color {
+		r.Color[i] = d.uint32()
+	}
+	for i := range r.UnkFloat {
+		r.UnkFloat[i] = d.float32()
+	}
+	for i := range r.UnkInt {
+		r.UnkInt[i] = d.uint32()
+	}
+}
+
+func (r *LiquidtypeRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Name)
+	e.uint32(r.Flags)
+	e.uint32(r.SoundBank)
+	e.uint32(r.SoundID)
+	e.uint32(r.SpellID)
+	e.float32(r.MaxDarkenDepth)
+	e.float32(r.FogDarkenIntensity)
+	e.float32(r.AmbDarkenIntensity)
+	e.float32(r.DirDarkenIntensity)
+	e.uint32(r.LightID)
+	e.float32(r.ParticleScale)
+	e.uint32(r.ParticleMovement)
+	e.uint32(r.ParticleTexSlots)
+	e.uint32(r.MaterialID)
+	for _, v := range r.Texture {
+		e.string(v)
+	}
+	for _, v := range r.Color {
+		e.uint32(v)
+	}
+	for _, v := range r.UnkFloat {
+		e.float32(v)
+	}
+	for _, v := range r.UnkInt {
+		e.uint32(v)
+	}
+}
@@ -0,0 +1,127 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// LockFile is the client file LockRecord is read from.
+const LockFile = "Lock.dbc"
+
+// LockRecord is one record of Lock.dbc.
+type LockRecord struct {
+	ID      uint32
+	Type1   int32
+	Type2   int32
+	Type3   int32
+	Type4   int32
+	Type5   int32
+	Type6   int32
+	Type7   int32
+	Type8   int32
+	Index1  int32
+	Index2  int32
+	Index3  int32
+	Index4  int32
+	Index5  int32
+	Index6  int32
+	Index7  int32
+	Index8  int32
+	Skill1  int32
+	Skill2  int32
+	Skill3  int32
+	Skill4  int32
+	Skill5  int32
+	Skill6  int32
+	Skill7  int32
+	Skill8  int32
+	Action1 uint32
+	Action2 uint32
+	Action3 uint32
+	Action4 uint32
+	Action5 uint32
+	Action6 uint32
+	Action7 uint32
+	Action8 uint32
+}
+
+// ReadLock reads every record of Lock.dbc from r.
+func ReadLock(r io.Reader) ([]LockRecord, error) {
+	return readRecords[LockRecord](r, LockFile, 132)
+}
+
+// WriteLock writes records to w in Lock.dbc format.
+func WriteLock(w io.Writer, records []LockRecord) error {
+	return writeRecords(w, records, 132, 33)
+}
+
+func (r *LockRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Type1 = d.int32()
+	r.Type2 = d.int32()
+	r.Type3 = d.int32()
+	r.Type4 = d.int32()
+	r.Type5 = d.int32()
+	r.Type6 = d.int32()
+	r.Type7 = d.int32()
+	r.Type8 = d.int32()
+	r.Index1 = d.int32()
+	r.Index2 = d.int32()
+	r.Index3 = d.int32()
+	r.Index4 = d.int32()
+	r.Index5 = d.int32()
+	r.Index6 = d.int32()
+	r.Index7 = d.int32()
+	r.Index8 = d.int32()
+	r.Skill1 = d.int32()
+	r.Skill2 = d.int32()
+	r.Skill3 = d.int32()
+	r.Skill4 = d.int32()
+	r.Skill5 = d.int32()
+	r.Skill6 = d.int32()
+	r.Skill7 = d.int32()
+	r.Skill8 = d.int32()
+	r.Action1 = d.uint32()
+	r.Action2 = d.uint32()
+	r.Action3 = d.uint32()
+	r.Action4 = d.uint32()
+	r.Action5 = d.uint32()
+	r.Action6 = d.uint32()
+	r.Action7 = d.uint32()
+	r.Action8 = d.uint32()
+}
+
+func (r *LockRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Type1)
+	e.int32(r.Type2)
+	e.int32(r.Type3)
+	e.int32(r.Type4)
+	e.int32(r.Type5)
+	e.int32(r.Type6)
+	e.int32(r.Type7)
+	e.int32(r.Type8)
+	e.int32(r.Index1)
+	e.int32(r.Index2)
+	e.int32(r.Index3)
+	e.int32(r.Index4)
+	e.int32(r.Index5)
+	e.int32(r.Index6)
+	e.int32(r.Index7)
+	e.int32(r.Index8)
+	e.int32(r.Skill1)
+	e.int32(r.Skill2)
+	e.int32(r.Skill3)
+	e.int32(r.Skill4)
+	e.int32(r.Skill5)
+	e.int32(r.Skill6)
+	e.int32(r.Skill7)
+	e.int32(r.Skill8)
+	e.uint32(r.Action1)
+	e.uint32(r.Action2)
+	e.uint32(r.Action3)
+	e.uint32(r.Action4)
+	e.uint32(r.Action5)
+	e.uint32(r.Action6)
+	e.uint32(r.Action7)
+	e.uint32(r.Action8)
+}
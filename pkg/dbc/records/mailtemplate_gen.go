@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// MailtemplateFile is the client file MailtemplateRecord is read from.
+const MailtemplateFile = "Mailtemplate.dbc"
+
+// MailtemplateRecord is one record of Mailtemplate.dbc.
+type MailtemplateRecord struct {
+	ID      uint32
+	Subject Loc
+	Body    Loc
+}
+
+// ReadMailtemplate reads every record of Mailtemplate.dbc from r.
+func ReadMailtemplate(r io.Reader) ([]MailtemplateRecord, error) {
+	return readRecords[MailtemplateRecord](r, MailtemplateFile, 140)
+}
+
+// WriteMailtemplate writes records to w in Mailtemplate.dbc format.
+func WriteMailtemplate(w io.Writer, records []MailtemplateRecord) error {
+	return writeRecords(w, records, 140, 35)
+}
+
+func (r *MailtemplateRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Subject = d.loc()
+	r.Body = d.loc()
+}
+
+func (r *MailtemplateRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Subject)
+	e.loc(r.Body)
+}
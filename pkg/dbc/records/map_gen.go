@@ -0,0 +1,82 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// MapFile is the client file MapRecord is read from.
+const MapFile = "Map.dbc"
+
+// MapRecord is one record of Map.dbc.
+type MapRecord struct {
+	ID               uint32
+	Directory        string
+	InstanceType     uint32
+	Flags            uint32
+	Pvp              uint32
+	Name             Loc
+	AreaTableID      uint32
+	Desc0            Loc
+	Desc1            Loc
+	LoadingScreenID  uint32
+	MinimapIconScale float32
+	CorpseMapID      int32
+	CorpseX          float32
+	CorpseY          float32
+	TimeOverride     int32
+	Expansion        uint32
+	RaidOffset       uint32
+	MaxPlayers       uint32
+}
+
+// ReadMap reads every record of Map.dbc from r.
+func ReadMap(r io.Reader) ([]MapRecord, error) {
+	return readRecords[MapRecord](r, MapFile, 264)
+}
+
+// WriteMap writes records to w in Map.dbc format.
+func WriteMap(w io.Writer, records []MapRecord) error {
+	return writeRecords(w, records, 264, 66)
+}
+
+func (r *MapRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Directory = d.string()
+	r.InstanceType = d.uint32()
+	r.Flags = d.uint32()
+	r.Pvp = d.uint32()
+	r.Name = d.loc()
+	r.AreaTableID = d.uint32()
+	r.Desc0 = d.loc()
+	r.Desc1 = d.loc()
+	r.LoadingScreenID = d.uint32()
+	r.MinimapIconScale = d.float32()
+	r.CorpseMapID = d.int32()
+	r.CorpseX = d.float32()
+	r.CorpseY = d.float32()
+	r.TimeOverride = d.int32()
+	r.Expansion = d.uint32()
+	r.RaidOffset = d.uint32()
+	r.MaxPlayers = d.uint32()
+}
+
+func (r *MapRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Directory)
+	e.uint32(r.InstanceType)
+	e.uint32(r.Flags)
+	e.uint32(r.Pvp)
+	e.loc(r.Name)
+	e.uint32(r.AreaTableID)
+	e.loc(r.Desc0)
+	e.loc(r.Desc1)
+	e.uint32(r.LoadingScreenID)
+	e.float32(r.MinimapIconScale)
+	e.int32(r.CorpseMapID)
+	e.float32(r.CorpseX)
+	e.float32(r.CorpseY)
+	e.int32(r.TimeOverride)
+	e.uint32(r.Expansion)
+	e.uint32(r.RaidOffset)
+	e.uint32(r.MaxPlayers)
+}
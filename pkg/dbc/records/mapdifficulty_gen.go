@@ -0,0 +1,49 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// MapDifficultyFile is the client file MapDifficultyRecord is read from.
+const MapDifficultyFile = "MapDifficulty.dbc"
+
+// MapDifficultyRecord is one record of MapDifficulty.dbc.
+type MapDifficultyRecord struct {
+	ID               uint32
+	MapID            uint32
+	Difficulty       uint32
+	Message          Loc
+	LockoutDuration  uint32
+	MaxPlayers       uint32
+	DifficultyString string
+}
+
+// ReadMapDifficulty reads every record of MapDifficulty.dbc from r.
+func ReadMapDifficulty(r io.Reader) ([]MapDifficultyRecord, error) {
+	return readRecords[MapDifficultyRecord](r, MapDifficultyFile, 92)
+}
+
+// WriteMapDifficulty writes records to w in MapDifficulty.dbc format.
+func WriteMapDifficulty(w io.Writer, records []MapDifficultyRecord) error {
+	return writeRecords(w, records, 92, 23)
+}
+
+func (r *MapDifficultyRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MapID = d.uint32()
+	r.Difficulty = d.uint32()
+	r.Message = d.loc()
+	r.LockoutDuration = d.uint32()
+	r.MaxPlayers = d.uint32()
+	r.DifficultyString = d.string()
+}
+
+func (r *MapDifficultyRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.MapID)
+	e.uint32(r.Difficulty)
+	e.loc(r.Message)
+	e.uint32(r.LockoutDuration)
+	e.uint32(r.MaxPlayers)
+	e.string(r.DifficultyString)
+}
@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// MovieFile is the client file MovieRecord is read from.
+const MovieFile = "Movie.dbc"
+
+// MovieRecord is one record of Movie.dbc.
+type MovieRecord struct {
+	ID       uint32
+	Filename uint32
+	Volume   uint32
+}
+
+// ReadMovie reads every record of Movie.dbc from r.
+func ReadMovie(r io.Reader) ([]MovieRecord, error) {
+	return readRecords[MovieRecord](r, MovieFile, 12)
+}
+
+// WriteMovie writes records to w in Movie.dbc format.
+func WriteMovie(w io.Writer, records []MovieRecord) error {
+	return writeRecords(w, records, 12, 3)
+}
+
+func (r *MovieRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Filename = d.uint32()
+	r.Volume = d.uint32()
+}
+
+func (r *MovieRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Filename)
+	e.uint32(r.Volume)
+}
@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// NamesprofanityFile is the client file NamesprofanityRecord is read from.
+const NamesprofanityFile = "Namesprofanity.dbc"
+
+// NamesprofanityRecord is one record of Namesprofanity.dbc.
+type NamesprofanityRecord struct {
+	ID       uint32
+	Pattern  string
+	Language int32
+}
+
+// ReadNamesprofanity reads every record of Namesprofanity.dbc from r.
+func ReadNamesprofanity(r io.Reader) ([]NamesprofanityRecord, error) {
+	return readRecords[NamesprofanityRecord](r, NamesprofanityFile, 12)
+}
+
+// WriteNamesprofanity writes records to w in Namesprofanity.dbc format.
+func WriteNamesprofanity(w io.Writer, records []NamesprofanityRecord) error {
+	return writeRecords(w, records, 12, 3)
+}
+
+func (r *NamesprofanityRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Pattern = d.string()
+	r.Language = d.int32()
+}
+
+func (r *NamesprofanityRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Pattern)
+	e.int32(r.Language)
+}
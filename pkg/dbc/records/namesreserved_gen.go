@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// NamesreservedFile is the client file NamesreservedRecord is read from.
+const NamesreservedFile = "Namesreserved.dbc"
+
+// NamesreservedRecord is one record of Namesreserved.dbc.
+type NamesreservedRecord struct {
+	ID       uint32
+	Pattern  string
+	Language int32
+}
+
+// ReadNamesreserved reads every record of Namesreserved.dbc from r.
+func ReadNamesreserved(r io.Reader) ([]NamesreservedRecord, error) {
+	return readRecords[NamesreservedRecord](r, NamesreservedFile, 12)
+}
+
+// WriteNamesreserved writes records to w in Namesreserved.dbc format.
+func WriteNamesreserved(w io.Writer, records []NamesreservedRecord) error {
+	return writeRecords(w, records, 12, 3)
+}
+
+func (r *NamesreservedRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Pattern = d.string()
+	r.Language = d.int32()
+}
+
+func (r *NamesreservedRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Pattern)
+	e.int32(r.Language)
+}
@@ -0,0 +1,38 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// NPCSoundsFile is the client file NPCSoundsRecord is read from.
+const NPCSoundsFile = "NPCSounds.dbc"
+
+// NPCSoundsRecord is one record of NPCSounds.dbc.
+type NPCSoundsRecord struct {
+	ID         uint32
+	SoundEntry [4]uint32
+}
+
+// ReadNPCSounds reads every record of NPCSounds.dbc from r.
+func ReadNPCSounds(r io.Reader) ([]NPCSoundsRecord, error) {
+	return readRecords[NPCSoundsRecord](r, NPCSoundsFile, 20)
+}
+
+// WriteNPCSounds writes records to w in NPCSounds.dbc format.
+func WriteNPCSounds(w io.Writer, records []NPCSoundsRecord) error {
+	return writeRecords(w, records, 20, 5)
+}
+
+func (r *NPCSoundsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.SoundEntry {
+		r.SoundEntry[i] = d.uint32()
+	}
+}
+
+func (r *NPCSoundsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.SoundEntry {
+		e.uint32(v)
+	}
+}
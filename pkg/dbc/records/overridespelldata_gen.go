@@ -0,0 +1,64 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// OverridespelldataFile is the client file OverridespelldataRecord is read from.
+const OverridespelldataFile = "Overridespelldata.dbc"
+
+// OverridespelldataRecord is one record of Overridespelldata.dbc.
+type OverridespelldataRecord struct {
+	ID      uint32
+	Spell1  int32
+	Spell2  int32
+	Spell3  int32
+	Spell4  int32
+	Spell5  int32
+	Spell6  int32
+	Spell7  int32
+	Spell8  int32
+	Spell9  int32
+	Spell10 int32
+	Flags   uint32
+}
+
+// ReadOverridespelldata reads every record of Overridespelldata.dbc from r.
+func ReadOverridespelldata(r io.Reader) ([]OverridespelldataRecord, error) {
+	return readRecords[OverridespelldataRecord](r, OverridespelldataFile, 48)
+}
+
+// WriteOverridespelldata writes records to w in Overridespelldata.dbc format.
+func WriteOverridespelldata(w io.Writer, records []OverridespelldataRecord) error {
+	return writeRecords(w, records, 48, 12)
+}
+
+func (r *OverridespelldataRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Spell1 = d.int32()
+	r.Spell2 = d.int32()
+	r.Spell3 = d.int32()
+	r.Spell4 = d.int32()
+	r.Spell5 = d.int32()
+	r.Spell6 = d.int32()
+	r.Spell7 = d.int32()
+	r.Spell8 = d.int32()
+	r.Spell9 = d.int32()
+	r.Spell10 = d.int32()
+	r.Flags = d.uint32()
+}
+
+func (r *OverridespelldataRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Spell1)
+	e.int32(r.Spell2)
+	e.int32(r.Spell3)
+	e.int32(r.Spell4)
+	e.int32(r.Spell5)
+	e.int32(r.Spell6)
+	e.int32(r.Spell7)
+	e.int32(r.Spell8)
+	e.int32(r.Spell9)
+	e.int32(r.Spell10)
+	e.uint32(r.Flags)
+}
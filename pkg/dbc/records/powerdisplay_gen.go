@@ -0,0 +1,46 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// PowerdisplayFile is the client file PowerdisplayRecord is read from.
+const PowerdisplayFile = "Powerdisplay.dbc"
+
+// PowerdisplayRecord is one record of Powerdisplay.dbc.
+type PowerdisplayRecord struct {
+	ID        uint32
+	PowerType uint32
+	Name      string
+	R         uint8
+	G         uint8
+	B         uint8
+}
+
+// ReadPowerdisplay reads every record of Powerdisplay.dbc from r.
+func ReadPowerdisplay(r io.Reader) ([]PowerdisplayRecord, error) {
+	return readRecords[PowerdisplayRecord](r, PowerdisplayFile, 15)
+}
+
+// WritePowerdisplay writes records to w in Powerdisplay.dbc format.
+func WritePowerdisplay(w io.Writer, records []PowerdisplayRecord) error {
+	return writeRecords(w, records, 15, 6)
+}
+
+func (r *PowerdisplayRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.PowerType = d.uint32()
+	r.Name = d.string()
+	r.R = d.uint8()
+	r.G = d.uint8()
+	r.B = d.uint8()
+}
+
+func (r *PowerdisplayRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.PowerType)
+	e.string(r.Name)
+	e.uint8(r.R)
+	e.uint8(r.G)
+	e.uint8(r.B)
+}
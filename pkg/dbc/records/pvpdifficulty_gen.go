@@ -0,0 +1,46 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// PvpdifficultyFile is the client file PvpdifficultyRecord is read from.
+const PvpdifficultyFile = "Pvpdifficulty.dbc"
+
+// PvpdifficultyRecord is one record of Pvpdifficulty.dbc.
+type PvpdifficultyRecord struct {
+	ID         uint32
+	MapID      int32
+	BracketID  int32
+	MinLevel   int32
+	MaxLevel   int32
+	Difficulty int32
+}
+
+// ReadPvpdifficulty reads every record of Pvpdifficulty.dbc from r.
+func ReadPvpdifficulty(r io.Reader) ([]PvpdifficultyRecord, error) {
+	return readRecords[PvpdifficultyRecord](r, PvpdifficultyFile, 24)
+}
+
+// WritePvpdifficulty writes records to w in Pvpdifficulty.dbc format.
+func WritePvpdifficulty(w io.Writer, records []PvpdifficultyRecord) error {
+	return writeRecords(w, records, 24, 6)
+}
+
+func (r *PvpdifficultyRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MapID = d.int32()
+	r.BracketID = d.int32()
+	r.MinLevel = d.int32()
+	r.MaxLevel = d.int32()
+	r.Difficulty = d.int32()
+}
+
+func (r *PvpdifficultyRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.MapID)
+	e.int32(r.BracketID)
+	e.int32(r.MinLevel)
+	e.int32(r.MaxLevel)
+	e.int32(r.Difficulty)
+}
@@ -0,0 +1,61 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// QuestfactionrewardFile is the client file QuestfactionrewardRecord is read from.
+const QuestfactionrewardFile = "Questfactionreward.dbc"
+
+// QuestfactionrewardRecord is one record of Questfactionreward.dbc.
+type QuestfactionrewardRecord struct {
+	ID           uint32
+	Difficulty1  int32
+	Difficulty2  int32
+	Difficulty3  int32
+	Difficulty4  int32
+	Difficulty5  int32
+	Difficulty6  int32
+	Difficulty7  int32
+	Difficulty8  int32
+	Difficulty9  int32
+	Difficulty10 int32
+}
+
+// ReadQuestfactionreward reads every record of Questfactionreward.dbc from r.
+func ReadQuestfactionreward(r io.Reader) ([]QuestfactionrewardRecord, error) {
+	return readRecords[QuestfactionrewardRecord](r, QuestfactionrewardFile, 44)
+}
+
+// WriteQuestfactionreward writes records to w in Questfactionreward.dbc format.
+func WriteQuestfactionreward(w io.Writer, records []QuestfactionrewardRecord) error {
+	return writeRecords(w, records, 44, 11)
+}
+
+func (r *QuestfactionrewardRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Difficulty1 = d.int32()
+	r.Difficulty2 = d.int32()
+	r.Difficulty3 = d.int32()
+	r.Difficulty4 = d.int32()
+	r.Difficulty5 = d.int32()
+	r.Difficulty6 = d.int32()
+	r.Difficulty7 = d.int32()
+	r.Difficulty8 = d.int32()
+	r.Difficulty9 = d.int32()
+	r.Difficulty10 = d.int32()
+}
+
+func (r *QuestfactionrewardRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Difficulty1)
+	e.int32(r.Difficulty2)
+	e.int32(r.Difficulty3)
+	e.int32(r.Difficulty4)
+	e.int32(r.Difficulty5)
+	e.int32(r.Difficulty6)
+	e.int32(r.Difficulty7)
+	e.int32(r.Difficulty8)
+	e.int32(r.Difficulty9)
+	e.int32(r.Difficulty10)
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// QuestsortFile is the client file QuestsortRecord is read from.
+const QuestsortFile = "Questsort.dbc"
+
+// QuestsortRecord is one record of Questsort.dbc.
+type QuestsortRecord struct {
+	ID   uint32
+	Name Loc
+}
+
+// ReadQuestsort reads every record of Questsort.dbc from r.
+func ReadQuestsort(r io.Reader) ([]QuestsortRecord, error) {
+	return readRecords[QuestsortRecord](r, QuestsortFile, 72)
+}
+
+// WriteQuestsort writes records to w in Questsort.dbc format.
+func WriteQuestsort(w io.Writer, records []QuestsortRecord) error {
+	return writeRecords(w, records, 72, 18)
+}
+
+func (r *QuestsortRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *QuestsortRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+}
@@ -0,0 +1,38 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// QuestXPFile is the client file QuestXPRecord is read from.
+const QuestXPFile = "QuestXP.dbc"
+
+// QuestXPRecord is one record of QuestXP.dbc.
+type QuestXPRecord struct {
+	QuestLevel uint32
+	Difficulty [10]uint32
+}
+
+// ReadQuestXP reads every record of QuestXP.dbc from r.
+func ReadQuestXP(r io.Reader) ([]QuestXPRecord, error) {
+	return readRecords[QuestXPRecord](r, QuestXPFile, 44)
+}
+
+// WriteQuestXP writes records to w in QuestXP.dbc format.
+func WriteQuestXP(w io.Writer, records []QuestXPRecord) error {
+	return writeRecords(w, records, 44, 11)
+}
+
+func (r *QuestXPRecord) decode(d *decoder) {
+	r.QuestLevel = d.uint32()
+	for i := range r.Difficulty {
+		r.Difficulty[i] = d.uint32()
+	}
+}
+
+func (r *QuestXPRecord) encode(e *encoder) {
+	e.uint32(r.QuestLevel)
+	for _, v := range r.Difficulty {
+		e.uint32(v)
+	}
+}
@@ -0,0 +1,76 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// RandproppointsFile is the client file RandproppointsRecord is read from.
+const RandproppointsFile = "Randproppoints.dbc"
+
+// RandproppointsRecord is one record of Randproppoints.dbc.
+type RandproppointsRecord struct {
+	ID        uint32
+	Epic1     int32
+	Epic2     int32
+	Epic3     int32
+	Epic4     int32
+	Epic5     int32
+	Superior1 int32
+	Superior2 int32
+	Superior3 int32
+	Superior4 int32
+	Superior5 int32
+	Good1     int32
+	Good2     int32
+	Good3     int32
+	Good4     int32
+	Good5     int32
+}
+
+// ReadRandproppoints reads every record of Randproppoints.dbc from r.
+func ReadRandproppoints(r io.Reader) ([]RandproppointsRecord, error) {
+	return readRecords[RandproppointsRecord](r, RandproppointsFile, 64)
+}
+
+// WriteRandproppoints writes records to w in Randproppoints.dbc format.
+func WriteRandproppoints(w io.Writer, records []RandproppointsRecord) error {
+	return writeRecords(w, records, 64, 16)
+}
+
+func (r *RandproppointsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Epic1 = d.int32()
+	r.Epic2 = d.int32()
+	r.Epic3 = d.int32()
+	r.Epic4 = d.int32()
+	r.Epic5 = d.int32()
+	r.Superior1 = d.int32()
+	r.Superior2 = d.int32()
+	r.Superior3 = d.int32()
+	r.Superior4 = d.int32()
+	r.Superior5 = d.int32()
+	r.Good1 = d.int32()
+	r.Good2 = d.int32()
+	r.Good3 = d.int32()
+	r.Good4 = d.int32()
+	r.Good5 = d.int32()
+}
+
+func (r *RandproppointsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Epic1)
+	e.int32(r.Epic2)
+	e.int32(r.Epic3)
+	e.int32(r.Epic4)
+	e.int32(r.Epic5)
+	e.int32(r.Superior1)
+	e.int32(r.Superior2)
+	e.int32(r.Superior3)
+	e.int32(r.Superior4)
+	e.int32(r.Superior5)
+	e.int32(r.Good1)
+	e.int32(r.Good2)
+	e.int32(r.Good3)
+	e.int32(r.Good4)
+	e.int32(r.Good5)
+}
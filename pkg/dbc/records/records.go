@@ -0,0 +1,200 @@
+// Package records reads and writes WoW 3.3.5a DBC files as typed Go structs,
+// one per DBC file Mithril has a schema for:
+//
+//	f, err := os.Open("DBFilesClient/Spell.dbc")
+//	...
+//	spells, err := records.ReadSpell(f)
+//	for _, s := range spells {
+//		fmt.Println(s.ID, s.SpellName.String(), s.EffectBasePoints[0])
+//	}
+//
+// The types are generated from the embedded *.meta.json schemas; run
+// "go generate ./pkg/dbc/records" after changing a meta. To work with any
+// table, including custom ones, use the Reader and Writer of the parent dbc
+// package.
+package records
+
+//go:generate go run gen.go
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// LocLangs names the 16 text slots of a Loc, in file order.
+var LocLangs = [16]string{
+	"enUS", "koKR", "frFR", "deDE",
+	"enCN", "enTW", "esES", "esMX",
+	"ruRU", "jaJP", "ptPT", "itIT",
+	"unknown1", "unknown2", "unknown3", "unknown4",
+}
+
+// Loc is a localized string: one text per client locale (indexed like
+// LocLangs) plus the flags slot that follows them.
+type Loc struct {
+	Text  [16]string
+	Flags uint32
+}
+
+// String returns the enUS text.
+func (l Loc) String() string {
+	return l.Text[0]
+}
+
+// ErrBadMagic is returned when a file doesn't start with "WDBC".
+var ErrBadMagic = errors.New("not a DBC file (bad magic)")
+
+// codec is implemented by the pointer type of every generated record.
+type codec[T any] interface {
+	*T
+	decode(*decoder)
+	encode(*encoder)
+}
+
+// readRecords reads a whole DBC file into records of type T, checking the
+// header's record size against the one the schema expects.
+func readRecords[T any, P codec[T]](r io.Reader, file string, recordSize uint32) ([]T, error) {
+	header := make([]byte, 20)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("%s: read header: %w", file, err)
+	}
+	if string(header[0:4]) != "WDBC" {
+		return nil, fmt.Errorf("%s: %w", file, ErrBadMagic)
+	}
+	count := binary.LittleEndian.Uint32(header[4:8])
+	size := binary.LittleEndian.Uint32(header[12:16])
+	stringSize := binary.LittleEndian.Uint32(header[16:20])
+	if size != recordSize {
+		return nil, fmt.Errorf("%s: record size is %d bytes, schema expects %d", file, size, recordSize)
+	}
+
+	data := make([]byte, int(count)*int(size)+int(stringSize))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("%s: read %d records: %w", file, count, err)
+	}
+	d := &decoder{strings: data[int(count)*int(size):]}
+	out := make([]T, count)
+	for i := range out {
+		d.rec = data[i*int(size) : (i+1)*int(size)]
+		d.pos = 0
+		P(&out[i]).decode(d)
+	}
+	return out, nil
+}
+
+// writeRecords writes records as a DBC file. Strings are deduplicated, and
+// the empty string is always at offset 0.
+func writeRecords[T any, P codec[T]](w io.Writer, records []T, recordSize, fieldCount uint32) error {
+	e := &encoder{offsets: map[string]uint32{"": 0}}
+	e.strings.WriteByte(0)
+	e.rec = make([]byte, 0, len(records)*int(recordSize))
+	for i := range records {
+		P(&records[i]).encode(e)
+	}
+
+	header := make([]byte, 20)
+	copy(header[0:4], "WDBC")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(records)))
+	binary.LittleEndian.PutUint32(header[8:12], fieldCount)
+	binary.LittleEndian.PutUint32(header[12:16], recordSize)
+	binary.LittleEndian.PutUint32(header[16:20], uint32(e.strings.Len()))
+	for _, chunk := range [][]byte{header, e.rec, e.strings.Bytes()} {
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decoder reads the fields of one record in order.
+type decoder struct {
+	rec     []byte
+	pos     int
+	strings []byte
+}
+
+func (d *decoder) uint32() uint32 {
+	v := binary.LittleEndian.Uint32(d.rec[d.pos:])
+	d.pos += 4
+	return v
+}
+
+func (d *decoder) int32() int32 {
+	return int32(d.uint32())
+}
+
+func (d *decoder) uint8() uint8 {
+	v := d.rec[d.pos]
+	d.pos++
+	return v
+}
+
+func (d *decoder) float32() float32 {
+	return math.Float32frombits(d.uint32())
+}
+
+func (d *decoder) string() string {
+	offset := d.uint32()
+	if offset >= uint32(len(d.strings)) {
+		return ""
+	}
+	end := bytes.IndexByte(d.strings[offset:], 0)
+	if end < 0 {
+		return string(d.strings[offset:])
+	}
+	return string(d.strings[offset : offset+uint32(end)])
+}
+
+func (d *decoder) loc() Loc {
+	var l Loc
+	for i := range l.Text {
+		l.Text[i] = d.string()
+	}
+	l.Flags = d.uint32()
+	return l
+}
+
+// encoder appends the fields of each record in order and collects strings.
+type encoder struct {
+	rec     []byte
+	strings bytes.Buffer
+	offsets map[string]uint32
+}
+
+func (e *encoder) uint32(v uint32) {
+	e.rec = binary.LittleEndian.AppendUint32(e.rec, v)
+}
+
+func (e *encoder) int32(v int32) {
+	e.uint32(uint32(v))
+}
+
+func (e *encoder) uint8(v uint8) {
+	e.rec = append(e.rec, v)
+}
+
+func (e *encoder) float32(v float32) {
+	e.uint32(math.Float32bits(v))
+}
+
+func (e *encoder) string(s string) {
+	offset, ok := e.offsets[s]
+	if !ok {
+		offset = uint32(e.strings.Len())
+		e.offsets[s] = offset
+		e.strings.WriteString(s)
+		e.strings.WriteByte(0)
+	}
+	e.uint32(offset)
+}
+
+func (e *encoder) loc(l Loc) {
+	for _, s := range l.Text {
+		e.string(s)
+	}
+	e.uint32(l.Flags)
+}
@@ -0,0 +1,94 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ScalingstatdistributionFile is the client file ScalingstatdistributionRecord is read from.
+const ScalingstatdistributionFile = "Scalingstatdistribution.dbc"
+
+// ScalingstatdistributionRecord is one record of Scalingstatdistribution.dbc.
+type ScalingstatdistributionRecord struct {
+	ID         uint32
+	StatMod1   int32
+	StatMod2   int32
+	StatMod3   int32
+	StatMod4   int32
+	StatMod5   int32
+	StatMod6   int32
+	StatMod7   int32
+	StatMod8   int32
+	StatMod9   int32
+	StatMod10  int32
+	Modifier1  int32
+	Modifier2  int32
+	Modifier3  int32
+	Modifier4  int32
+	Modifier5  int32
+	Modifier6  int32
+	Modifier7  int32
+	Modifier8  int32
+	Modifier9  int32
+	Modifier10 int32
+	MaxLevel   int32
+}
+
+// ReadScalingstatdistribution reads every record of Scalingstatdistribution.dbc from r.
+func ReadScalingstatdistribution(r io.Reader) ([]ScalingstatdistributionRecord, error) {
+	return readRecords[ScalingstatdistributionRecord](r, ScalingstatdistributionFile, 88)
+}
+
+// WriteScalingstatdistribution writes records to w in Scalingstatdistribution.dbc format.
+func WriteScalingstatdistribution(w io.Writer, records []ScalingstatdistributionRecord) error {
+	return writeRecords(w, records, 88, 22)
+}
+
+func (r *ScalingstatdistributionRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.StatMod1 = d.int32()
+	r.StatMod2 = d.int32()
+	r.StatMod3 = d.int32()
+	r.StatMod4 = d.int32()
+	r.StatMod5 = d.int32()
+	r.StatMod6 = d.int32()
+	r.StatMod7 = d.int32()
+	r.StatMod8 = d.int32()
+	r.StatMod9 = d.int32()
+	r.StatMod10 = d.int32()
+	r.Modifier1 = d.int32()
+	r.Modifier2 = d.int32()
+	r.Modifier3 = d.int32()
+	r.Modifier4 = d.int32()
+	r.Modifier5 = d.int32()
+	r.Modifier6 = d.int32()
+	r.Modifier7 = d.int32()
+	r.Modifier8 = d.int32()
+	r.Modifier9 = d.int32()
+	r.Modifier10 = d.int32()
+	r.MaxLevel = d.int32()
+}
+
+func (r *ScalingstatdistributionRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.StatMod1)
+	e.int32(r.StatMod2)
+	e.int32(r.StatMod3)
+	e.int32(r.StatMod4)
+	e.int32(r.StatMod5)
+	e.int32(r.StatMod6)
+	e.int32(r.StatMod7)
+	e.int32(r.StatMod8)
+	e.int32(r.StatMod9)
+	e.int32(r.StatMod10)
+	e.int32(r.Modifier1)
+	e.int32(r.Modifier2)
+	e.int32(r.Modifier3)
+	e.int32(r.Modifier4)
+	e.int32(r.Modifier5)
+	e.int32(r.Modifier6)
+	e.int32(r.Modifier7)
+	e.int32(r.Modifier8)
+	e.int32(r.Modifier9)
+	e.int32(r.Modifier10)
+	e.int32(r.MaxLevel)
+}
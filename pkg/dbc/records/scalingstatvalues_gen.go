@@ -0,0 +1,100 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// ScalingstatvaluesFile is the client file ScalingstatvaluesRecord is read from.
+const ScalingstatvaluesFile = "Scalingstatvalues.dbc"
+
+// ScalingstatvaluesRecord is one record of Scalingstatvalues.dbc.
+type ScalingstatvaluesRecord struct {
+	ID                   uint32
+	Charlevel            uint32
+	ShoulderBudget       uint32
+	TrinketBudget        uint32
+	WeaponBudget1h       uint32
+	RangedBudget         uint32
+	ClothShoulderArmor   uint32
+	LeatherShoulderArmor uint32
+	MailShoulderArmor    uint32
+	PlateShoulderArmor   uint32
+	WeaponDps1h          uint32
+	WeaponDps2h          uint32
+	SpellcasterDps1h     uint32
+	SpellcasterDps2h     uint32
+	RangedDps            uint32
+	WandDps              uint32
+	SpellPower           uint32
+	PrimaryBudget        uint32
+	TertiaryBudget       uint32
+	ClothCloakArmor      uint32
+	ClothChestArmor      uint32
+	LeatherChestArmor    uint32
+	MailChestArmor       uint32
+	PlateChestArmor      uint32
+}
+
+// ReadScalingstatvalues reads every record of Scalingstatvalues.dbc from r.
+func ReadScalingstatvalues(r io.Reader) ([]ScalingstatvaluesRecord, error) {
+	return readRecords[ScalingstatvaluesRecord](r, ScalingstatvaluesFile, 96)
+}
+
+// WriteScalingstatvalues writes records to w in Scalingstatvalues.dbc format.
+func WriteScalingstatvalues(w io.Writer, records []ScalingstatvaluesRecord) error {
+	return writeRecords(w, records, 96, 24)
+}
+
+func (r *ScalingstatvaluesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Charlevel = d.uint32()
+	r.ShoulderBudget = d.uint32()
+	r.TrinketBudget = d.uint32()
+	r.WeaponBudget1h = d.uint32()
+	r.RangedBudget = d.uint32()
+	r.ClothShoulderArmor = d.uint32()
+	r.LeatherShoulderArmor = d.uint32()
+	r.MailShoulderArmor = d.uint32()
+	r.PlateShoulderArmor = d.uint32()
+	r.WeaponDps1h = d.uint32()
+	r.WeaponDps2h = d.uint32()
+	r.SpellcasterDps1h = d.uint32()
+	r.SpellcasterDps2h = d.uint32()
+	r.RangedDps = d.uint32()
+	r.WandDps = d.uint32()
+	r.SpellPower = d.uint32()
+	r.PrimaryBudget = d.uint32()
+	r.TertiaryBudget = d.uint32()
+	r.ClothCloakArmor = d.uint32()
+	r.ClothChestArmor = d.uint32()
+	r.LeatherChestArmor = d.uint32()
+	r.MailChestArmor = d.uint32()
+	r.PlateChestArmor = d.uint32()
+}
+
+func (r *ScalingstatvaluesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Charlevel)
+	e.uint32(r.ShoulderBudget)
+	e.uint32(r.TrinketBudget)
+	e.uint32(r.WeaponBudget1h)
+	e.uint32(r.RangedBudget)
+	e.uint32(r.ClothShoulderArmor)
+	e.uint32(r.LeatherShoulderArmor)
+	e.uint32(r.MailShoulderArmor)
+	e.uint32(r.PlateShoulderArmor)
+	e.uint32(r.WeaponDps1h)
+	e.uint32(r.WeaponDps2h)
+	e.uint32(r.SpellcasterDps1h)
+	e.uint32(r.SpellcasterDps2h)
+	e.uint32(r.RangedDps)
+	e.uint32(r.WandDps)
+	e.uint32(r.SpellPower)
+	e.uint32(r.PrimaryBudget)
+	e.uint32(r.TertiaryBudget)
+	e.uint32(r.ClothCloakArmor)
+	e.uint32(r.ClothChestArmor)
+	e.uint32(r.LeatherChestArmor)
+	e.uint32(r.MailChestArmor)
+	e.uint32(r.PlateChestArmor)
+}
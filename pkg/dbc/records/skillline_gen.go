@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SkillLineFile is the client file SkillLineRecord is read from.
+const SkillLineFile = "SkillLine.dbc"
+
+// SkillLineRecord is one record of SkillLine.dbc.
+type SkillLineRecord struct {
+	ID       uint32
+	Category uint32
+	Cost     uint32
+	Name     Loc
+	Desc     Loc
+	IconID   uint32
+	Verb     Loc
+	CanLink  uint32
+}
+
+// ReadSkillLine reads every record of SkillLine.dbc from r.
+func ReadSkillLine(r io.Reader) ([]SkillLineRecord, error) {
+	return readRecords[SkillLineRecord](r, SkillLineFile, 224)
+}
+
+// WriteSkillLine writes records to w in SkillLine.dbc format.
+func WriteSkillLine(w io.Writer, records []SkillLineRecord) error {
+	return writeRecords(w, records, 224, 56)
+}
+
+func (r *SkillLineRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Category = d.uint32()
+	r.Cost = d.uint32()
+	r.Name = d.loc()
+	r.Desc = d.loc()
+	r.IconID = d.uint32()
+	r.Verb = d.loc()
+	r.CanLink = d.uint32()
+}
+
+func (r *SkillLineRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Category)
+	e.uint32(r.Cost)
+	e.loc(r.Name)
+	e.loc(r.Desc)
+	e.uint32(r.IconID)
+	e.loc(r.Verb)
+	e.uint32(r.CanLink)
+}
@@ -0,0 +1,71 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SkillLineAbilityFile is the client file SkillLineAbilityRecord is read from.
+const SkillLineAbilityFile = "SkillLineAbility.dbc"
+
+// SkillLineAbilityRecord is one record of SkillLineAbility.dbc.
+type SkillLineAbilityRecord struct {
+	ID               uint32
+	SkillLine        uint32
+	SpellID          uint32
+	RequiredRaces    uint32
+	RequiredClasses  uint32
+	ExcludedRaces    uint32
+	ExcludedClasses  uint32
+	MinSkillValue    uint32
+	SpellParentID    uint32
+	AcquireMethod    uint32
+	SkillGreyLevel   uint32
+	SkillYellowLevel uint32
+	CharacterPoints  [2]uint32
+}
+
+// ReadSkillLineAbility reads every record of SkillLineAbility.dbc from r.
+func ReadSkillLineAbility(r io.Reader) ([]SkillLineAbilityRecord, error) {
+	return readRecords[SkillLineAbilityRecord](r, SkillLineAbilityFile, 56)
+}
+
+// WriteSkillLineAbility writes records to w in SkillLineAbility.dbc format.
+func WriteSkillLineAbility(w io.Writer, records []SkillLineAbilityRecord) error {
+	return writeRecords(w, records, 56, 14)
+}
+
+func (r *SkillLineAbilityRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SkillLine = d.uint32()
+	r.SpellID = d.uint32()
+	r.RequiredRaces = d.uint32()
+	r.RequiredClasses = d.uint32()
+	r.ExcludedRaces = d.uint32()
+	r.ExcludedClasses = d.uint32()
+	r.MinSkillValue = d.uint32()
+	r.SpellParentID = d.uint32()
+	r.AcquireMethod = d.uint32()
+	r.SkillGreyLevel = d.uint32()
+	r.SkillYellowLevel = d.uint32()
+	for i := range r.CharacterPoints {
+		r.CharacterPoints[i] = d.uint32()
+	}
+}
+
+func (r *SkillLineAbilityRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.SkillLine)
+	e.uint32(r.SpellID)
+	e.uint32(r.RequiredRaces)
+	e.uint32(r.RequiredClasses)
+	e.uint32(r.ExcludedRaces)
+	e.uint32(r.ExcludedClasses)
+	e.uint32(r.MinSkillValue)
+	e.uint32(r.SpellParentID)
+	e.uint32(r.AcquireMethod)
+	e.uint32(r.SkillGreyLevel)
+	e.uint32(r.SkillYellowLevel)
+	for _, v := range r.CharacterPoints {
+		e.uint32(v)
+	}
+}
@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SkillLineCategoryFile is the client file SkillLineCategoryRecord is read from.
+const SkillLineCategoryFile = "SkillLineCategory.dbc"
+
+// SkillLineCategoryRecord is one record of SkillLineCategory.dbc.
+type SkillLineCategoryRecord struct {
+	ID        uint32
+	Name      Loc
+	SortOrder uint32
+}
+
+// ReadSkillLineCategory reads every record of SkillLineCategory.dbc from r.
+func ReadSkillLineCategory(r io.Reader) ([]SkillLineCategoryRecord, error) {
+	return readRecords[SkillLineCategoryRecord](r, SkillLineCategoryFile, 76)
+}
+
+// WriteSkillLineCategory writes records to w in SkillLineCategory.dbc format.
+func WriteSkillLineCategory(w io.Writer, records []SkillLineCategoryRecord) error {
+	return writeRecords(w, records, 76, 19)
+}
+
+func (r *SkillLineCategoryRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	r.SortOrder = d.uint32()
+}
+
+func (r *SkillLineCategoryRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	e.uint32(r.SortOrder)
+}
@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SkillRaceClassInfoFile is the client file SkillRaceClassInfoRecord is read from.
+const SkillRaceClassInfoFile = "SkillRaceClassInfo.dbc"
+
+// SkillRaceClassInfoRecord is one record of SkillRaceClassInfo.dbc.
+type SkillRaceClassInfoRecord struct {
+	ID          uint32
+	SkillID     uint32
+	RaceMask    uint32
+	ClassMask   uint32
+	Flags       uint32
+	MinLevel    uint32
+	SkillTierID uint32
+	SkillCostID uint32
+}
+
+// ReadSkillRaceClassInfo reads every record of SkillRaceClassInfo.dbc from r.
+func ReadSkillRaceClassInfo(r io.Reader) ([]SkillRaceClassInfoRecord, error) {
+	return readRecords[SkillRaceClassInfoRecord](r, SkillRaceClassInfoFile, 32)
+}
+
+// WriteSkillRaceClassInfo writes records to w in SkillRaceClassInfo.dbc format.
+func WriteSkillRaceClassInfo(w io.Writer, records []SkillRaceClassInfoRecord) error {
+	return writeRecords(w, records, 32, 8)
+}
+
+func (r *SkillRaceClassInfoRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SkillID = d.uint32()
+	r.RaceMask = d.uint32()
+	r.ClassMask = d.uint32()
+	r.Flags = d.uint32()
+	r.MinLevel = d.uint32()
+	r.SkillTierID = d.uint32()
+	r.SkillCostID = d.uint32()
+}
+
+func (r *SkillRaceClassInfoRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.SkillID)
+	e.uint32(r.RaceMask)
+	e.uint32(r.ClassMask)
+	e.uint32(r.Flags)
+	e.uint32(r.MinLevel)
+	e.uint32(r.SkillTierID)
+	e.uint32(r.SkillCostID)
+}
@@ -0,0 +1,45 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SkillTiersFile is the client file SkillTiersRecord is read from.
+const SkillTiersFile = "SkillTiers.dbc"
+
+// SkillTiersRecord is one record of SkillTiers.dbc.
+type SkillTiersRecord struct {
+	ID    uint32
+	Cost  [16]uint32
+	Value [16]uint32
+}
+
+// ReadSkillTiers reads every record of SkillTiers.dbc from r.
+func ReadSkillTiers(r io.Reader) ([]SkillTiersRecord, error) {
+	return readRecords[SkillTiersRecord](r, SkillTiersFile, 132)
+}
+
+// WriteSkillTiers writes records to w in SkillTiers.dbc format.
+func WriteSkillTiers(w io.Writer, records []SkillTiersRecord) error {
+	return writeRecords(w, records, 132, 33)
+}
+
+func (r *SkillTiersRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.Cost {
+		r.Cost[i] = d.uint32()
+	}
+	for i := range r.Value {
+		r.Value[i] = d.uint32()
+	}
+}
+
+func (r *SkillTiersRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.Cost {
+		e.uint32(v)
+	}
+	for _, v := range r.Value {
+		e.uint32(v)
+	}
+}
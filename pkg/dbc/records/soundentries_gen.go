@@ -0,0 +1,72 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SoundEntriesFile is the client file SoundEntriesRecord is read from.
+const SoundEntriesFile = "SoundEntries.dbc"
+
+// SoundEntriesRecord is one record of SoundEntries.dbc.
+type SoundEntriesRecord struct {
+	ID             uint32
+	SoundType      uint32
+	Name           string
+	File           [10]string
+	Frequency      [10]uint32
+	BaseDir        string
+	Volume         float32
+	Flags          uint32
+	MinDistance    float32
+	DistanceCutoff float32
+	EaxDef         uint32
+	AdvancedID     uint32
+}
+
+// ReadSoundEntries reads every record of SoundEntries.dbc from r.
+func ReadSoundEntries(r io.Reader) ([]SoundEntriesRecord, error) {
+	return readRecords[SoundEntriesRecord](r, SoundEntriesFile, 120)
+}
+
+// WriteSoundEntries writes records to w in SoundEntries.dbc format.
+func WriteSoundEntries(w io.Writer, records []SoundEntriesRecord) error {
+	return writeRecords(w, records, 120, 30)
+}
+
+func (r *SoundEntriesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SoundType = d.uint32()
+	r.Name = d.string()
+	for i := range r.File {
+		r.File[i] = d.string()
+	}
+	for i := range r.Frequency {
+		r.Frequency[i] = d.uint32()
+	}
+	r.BaseDir = d.string()
+	r.Volume = d.float32()
+	r.Flags = d.uint32()
+	r.MinDistance = d.float32()
+	r.DistanceCutoff = d.float32()
+	r.EaxDef = d.uint32()
+	r.AdvancedID = d.uint32()
+}
+
+func (r *SoundEntriesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.SoundType)
+	e.string(r.Name)
+	for _, v := range r.File {
+		e.string(v)
+	}
+	for _, v := range r.Frequency {
+		e.uint32(v)
+	}
+	e.string(r.BaseDir)
+	e.float32(r.Volume)
+	e.uint32(r.Flags)
+	e.float32(r.MinDistance)
+	e.float32(r.DistanceCutoff)
+	e.uint32(r.EaxDef)
+	e.uint32(r.AdvancedID)
+}
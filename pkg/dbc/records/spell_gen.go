@@ -0,0 +1,447 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellFile is the client file SpellRecord is read from.
+const SpellFile = "Spell.dbc"
+
+// SpellRecord is one record of Spell.dbc.
+type SpellRecord struct {
+	ID                            uint32
+	Category                      uint32
+	Dispel                        uint32
+	Mechanic                      uint32
+	Attributes                    uint32
+	AttributesEx                  [7]uint32
+	Stances                       uint32
+	Unk1                          uint32
+	ExcludedStances               uint32
+	Unk2                          uint32
+	Targets                       uint32
+	TargetCreatureType            uint32
+	SpellFocusObject              uint32
+	FacingCasterFlags             uint32
+	CasterAuraState               uint32
+	TargetAuraState               uint32
+	ExcludedCasterAuraState       uint32
+	ExcludedTargetAuraState       uint32
+	CasterAuraSpell               uint32
+	TargetAuraSpell               uint32
+	ExcludedCasterAuraSpell       uint32
+	ExcludedTargetAuraSpell       uint32
+	CastTimeIndex                 uint32
+	RecoveryTime                  uint32
+	CategoryRecoveryTime          uint32
+	InterruptFlags                uint32
+	AuraInterruptFlags            uint32
+	ChannelInterruptFlags         uint32
+	ProcFlags                     uint32
+	ProcChance                    uint32
+	ProcCharges                   uint32
+	MaxLevel                      uint32
+	BaseLevel                     uint32
+	SpellLevel                    uint32
+	DurationIndex                 uint32
+	PowerType                     uint32
+	PowerCost                     uint32
+	PowerCostPerLevel             uint32
+	PowerPerSecond                uint32
+	PowerPerSecondPerLevel        uint32
+	RangeIndex                    uint32
+	Speed                         float32
+	ModalNextSpell                uint32
+	StackAmount                   uint32
+	Totem                         [2]uint32
+	Reagent                       [8]int32
+	ReagentCount                  [8]uint32
+	EquippedItemClass             int32
+	EquippedItemSubclassMask      int32
+	EquippedItemInventorytypeMask int32
+	Effect                        [3]uint32
+	EffectDieSides                [3]int32
+	EffectRealPointsPerLevel      [3]float32
+	EffectBasePoints              [3]int32
+	EffectMechanic                [3]uint32
+	EffectImplicitTargetA         [3]uint32
+	EffectImplicitTargetB         [3]uint32
+	EffectRadiusIndex             [3]uint32
+	EffectApplyAuraName           [3]uint32
+	EffectAmplitude               [3]uint32
+	EffectMultipleValue           [3]float32
+	EffectChainTarget             [3]uint32
+	EffectItemType                [3]uint32
+	EffectMiscValueA              [3]int32
+	EffectMiscValueB              [3]int32
+	EffectTriggerSpell            [3]uint32
+	EffectPointsPerComboPoint     [3]float32
+	EffectSpellClassMaskA         [3]uint32
+	EffectSpellClassMaskB         [3]uint32
+	EffectSpellClassMaskC         [3]uint32
+	SpellVisual                   [2]uint32
+	SpellIconID                   uint32
+	ActiveIconID                  uint32
+	SpellPriority                 uint32
+	SpellName                     Loc
+	SpellSubtext                  Loc
+	SpellDesc                     Loc
+	SpellTooltip                  Loc
+	PowerCostPercentage           uint32
+	StartRecoveryCategory         uint32
+	StartRecoveryTime             uint32
+	MaximumTargetLevel            uint32
+	SpellClassSet                 uint32
+	SpellClassMask                [3]uint32
+	MaxAffectedTargets            uint32
+	DamageClass                   uint32
+	PreventionType                uint32
+	StanceBarOrder                uint32
+	EffectDamageMultiplier        [3]float32
+	MinFactionID                  uint32
+	MinReputation                 uint32
+	ReqAuraVision                 uint32
+	TotemCategory                 [2]uint32
+	AreaGroupID                   uint32
+	SchoolMask                    uint32
+	RuneCostID                    uint32
+	SpellMissileID                uint32
+	PowerDisplayID                uint32
+	EffectBonusMultiplier         [3]float32
+	SpellDescVariableID           uint32
+	SpellDifficultyID             uint32
+}
+
+// ReadSpell reads every record of Spell.dbc from r.
+func ReadSpell(r io.Reader) ([]SpellRecord, error) {
+	return readRecords[SpellRecord](r, SpellFile, 936)
+}
+
+// WriteSpell writes records to w in Spell.dbc format.
+func WriteSpell(w io.Writer, records []SpellRecord) error {
+	return writeRecords(w, records, 936, 234)
+}
+
+func (r *SpellRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Category = d.uint32()
+	r.Dispel = d.uint32()
+	r.Mechanic = d.uint32()
+	r.Attributes = d.uint32()
+	for i := range r.AttributesEx {
+		r.AttributesEx[i] = d.uint32()
+	}
+	r.Stances = d.uint32()
+	r.Unk1 = d.uint32()
+	r.ExcludedStances = d.uint32()
+	r.Unk2 = d.uint32()
+	r.Targets = d.uint32()
+	r.TargetCreatureType = d.uint32()
+	r.SpellFocusObject = d.uint32()
+	r.FacingCasterFlags = d.uint32()
+	r.CasterAuraState = d.uint32()
+	r.TargetAuraState = d.uint32()
+	r.ExcludedCasterAuraState = d.uint32()
+	r.ExcludedTargetAuraState = d.uint32()
+	r.CasterAuraSpell = d.uint32()
+	r.TargetAuraSpell = d.uint32()
+	r.ExcludedCasterAuraSpell = d.uint32()
+	r.ExcludedTargetAuraSpell = d.uint32()
+	r.CastTimeIndex = d.uint32()
+	r.RecoveryTime = d.uint32()
+	r.CategoryRecoveryTime = d.uint32()
+	r.InterruptFlags = d.uint32()
+	r.AuraInterruptFlags = d.uint32()
+	r.ChannelInterruptFlags = d.uint32()
+	r.ProcFlags = d.uint32()
+	r.ProcChance = d.uint32()
+	r.ProcCharges = d.uint32()
+	r.MaxLevel = d.uint32()
+	r.BaseLevel = d.uint32()
+	r.SpellLevel = d.uint32()
+	r.DurationIndex = d.uint32()
+	r.PowerType = d.uint32()
+	r.PowerCost = d.uint32()
+	r.PowerCostPerLevel = d.uint32()
+	r.PowerPerSecond = d.uint32()
+	r.PowerPerSecondPerLevel = d.uint32()
+	r.RangeIndex = d.uint32()
+	r.Speed = d.float32()
+	r.ModalNextSpell = d.uint32()
+	r.StackAmount = d.uint32()
+	for i := range r.Totem {
+		r.Totem[i] = d.uint32()
+	}
+	for i := range r.Reagent {
+		r.Reagent[i] = d.int32()
+	}
+	for i := range r.ReagentCount {
+		r.ReagentCount[i] = d.uint32()
+	}
+	r.EquippedItemClass = d.int32()
+	r.EquippedItemSubclassMask = d.int32()
+	r.EquippedItemInventorytypeMask = d.int32()
+	for i := range r.Effect {
+		r.Effect[i] = d.uint32()
+	}
+	for i := range r.EffectDieSides {
+		r.EffectDieSides[i] = d.int32()
+	}
+	for i := range r.EffectRealPointsPerLevel {
+		r.EffectRealPointsPerLevel[i] = d.float32()
+	}
+	for i := range r.EffectBasePoints {
+		r.EffectBasePoints[i] = d.int32()
+	}
+	for i := range r.EffectMechanic {
+		r.EffectMechanic[i] = d.uint32()
+	}
+	for i := range r.EffectImplicitTargetA {
+		r.EffectImplicitTargetA[i] = d.uint32()
+	}
+	for i := range r.EffectImplicitTargetB {
+		r.EffectImplicitTargetB[i] = d.uint32()
+	}
+	for i := range r.EffectRadiusIndex {
+		r.EffectRadiusIndex[i] = d.uint32()
+	}
+	for i := range r.EffectApplyAuraName {
+		r.EffectApplyAuraName[i] = d.uint32()
+	}
+	for i := range r.EffectAmplitude {
+		r.EffectAmplitude[i] = d.uint32()
+	}
+	for i := range r.EffectMultipleValue {
+		r.EffectMultipleValue[i] = d.float32()
+	}
+	for i := range r.EffectChainTarget {
+		r.EffectChainTarget[i] = d.uint32()
+	}
+	for i := range r.EffectItemType {
+		r.EffectItemType[i] = d.uint32()
+	}
+	for i := range r.EffectMiscValueA {
+		r.EffectMiscValueA[i] = d.int32()
+	}
+	for i := range r.EffectMiscValueB {
+		r.EffectMiscValueB[i] = d.int32()
+	}
+	for i := range r.EffectTriggerSpell {
+		r.EffectTriggerSpell[i] = d.uint32()
+	}
+	for i := range r.EffectPointsPerComboPoint {
+		r.EffectPointsPerComboPoint[i] = d.float32()
+	}
+	for i := range r.EffectSpellClassMaskA {
+		r.EffectSpellClassMaskA[i] = d.uint32()
+	}
+	for i := range r.EffectSpellClassMaskB {
+		r.EffectSpellClassMaskB[i] = d.uint32()
+	}
+	for i := range r.EffectSpellClassMaskC {
+		r.EffectSpellClassMaskC[i] = d.uint32()
+	}
+	for i := range r.SpellVisual {
+		r.SpellVisual[i] = d.uint32()
+	}
+	r.SpellIconID = d.uint32()
+	r.ActiveIconID = d.uint32()
+	r.SpellPriority = d.uint32()
+	r.SpellName = d.loc()
+	r.SpellSubtext = d.loc()
+	r.SpellDesc = d.loc()
+	r.SpellTooltip = d.loc()
+	r.PowerCostPercentage = d.uint32()
+	r.StartRecoveryCategory = d.uint32()
+	r.StartRecoveryTime = d.uint32()
+	r.MaximumTargetLevel = d.uint32()
+	r.SpellClassSet = d.uint32()
+	for i := range r.SpellClassMask {
+		r.SpellClassMask[i] = d.uint32()
+	}
+	r.MaxAffectedTargets = d.uint32()
+	r.DamageClass = d.uint32()
+	r.PreventionType = d.uint32()
+	r.StanceBarOrder = d.uint32()
+	for i := range r.EffectDamageMultiplier {
+		r.EffectDamageMultiplier[i] = d.float32()
+	}
+	r.MinFactionID = d.uint32()
+	r.MinReputation = d.uint32()
+	r.ReqAuraVision = d.uint32()
+	for i := range r.TotemCategory {
+		r.TotemCategory[i] = d.uint32()
+	}
+	r.AreaGroupID = d.uint32()
+	r.SchoolMask = d.uint32()
+	r.RuneCostID = d.uint32()
+	r.SpellMissileID = d.uint32()
+	r.PowerDisplayID = d.uint32()
+	for i := range r.EffectBonusMultiplier {
+		r.EffectBonusMultiplier[i] = d.float32()
+	}
+	r.SpellDescVariableID = d.uint32()
+	r.SpellDifficultyID = d.uint32()
+}
+
+func (r *SpellRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Category)
+	e.uint32(r.Dispel)
+	e.uint32(r.Mechanic)
+	e.uint32(r.Attributes)
+	for _, v := range r.AttributesEx {
+		e.uint32(v)
+	}
+	e.uint32(r.Stances)
+	e.uint32(r.Unk1)
+	e.uint32(r.ExcludedStances)
+	e.uint32(r.Unk2)
+	e.uint32(r.Targets)
+	e.uint32(r.TargetCreatureType)
+	e.uint32(r.SpellFocusObject)
+	e.uint32(r.FacingCasterFlags)
+	e.uint32(r.CasterAuraState)
+	e.uint32(r.TargetAuraState)
+	e.uint32(r.ExcludedCasterAuraState)
+	e.uint32(r.ExcludedTargetAuraState)
+	e.uint32(r.CasterAuraSpell)
+	e.uint32(r.TargetAuraSpell)
+	e.uint32(r.ExcludedCasterAuraSpell)
+	e.uint32(r.ExcludedTargetAuraSpell)
+	e.uint32(r.CastTimeIndex)
+	e.uint32(r.RecoveryTime)
+	e.uint32(r.CategoryRecoveryTime)
+	e.uint32(r.InterruptFlags)
+	e.uint32(r.AuraInterruptFlags)
+	e.uint32(r.ChannelInterruptFlags)
+	e.uint32(r.ProcFlags)
+	e.uint32(r.ProcChance)
+	e.uint32(r.ProcCharges)
+	e.uint32(r.MaxLevel)
+	e.uint32(r.BaseLevel)
+	e.uint32(r.SpellLevel)
+	e.uint32(r.DurationIndex)
+	e.uint32(r.PowerType)
+	e.uint32(r.PowerCost)
+	e.uint32(r.PowerCostPerLevel)
+	e.uint32(r.PowerPerSecond)
+	e.uint32(r.PowerPerSecondPerLevel)
+	e.uint32(r.RangeIndex)
+	e.float32(r.Speed)
+	e.uint32(r.ModalNextSpell)
+	e.uint32(r.StackAmount)
+	for _, v := range r.Totem {
+		e.uint32(v)
+	}
+	for _, v := range r.Reagent {
+		e.int32(v)
+	}
+	for _, v := range r.ReagentCount {
+		e.uint32(v)
+	}
+	e.int32(r.EquippedItemClass)
+	e.int32(r.EquippedItemSubclassMask)
+	e.int32(r.EquippedItemInventorytypeMask)
+	for _, v := range r.Effect {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectDieSides {
+		e.int32(v)
+	}
+	for _, v := range r.EffectRealPointsPerLevel {
+		e.float32(v)
+	}
+	for _, v := range r.EffectBasePoints {
+		e.int32(v)
+	}
+	for _, v := range r.EffectMechanic {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectImplicitTargetA {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectImplicitTargetB {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectRadiusIndex {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectApplyAuraName {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectAmplitude {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectMultipleValue {
+		e.float32(v)
+	}
+	for _, v := range r.EffectChainTarget {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectItemType {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectMiscValueA {
+		e.int32(v)
+	}
+	for _, v := range r.EffectMiscValueB {
+		e.int32(v)
+	}
+	for _, v := range r.EffectTriggerSpell {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectPointsPerComboPoint {
+		e.float32(v)
+	}
+	for _, v := range r.EffectSpellClassMaskA {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectSpellClassMaskB {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectSpellClassMaskC {
+		e.uint32(v)
+	}
+	for _, v := range r.SpellVisual {
+		e.uint32(v)
+	}
+	e.uint32(r.SpellIconID)
+	e.uint32(r.ActiveIconID)
+	e.uint32(r.SpellPriority)
+	e.loc(r.SpellName)
+	e.loc(r.SpellSubtext)
+	e.loc(r.SpellDesc)
+	e.loc(r.SpellTooltip)
+	e.uint32(r.PowerCostPercentage)
+	e.uint32(r.StartRecoveryCategory)
+	e.uint32(r.StartRecoveryTime)
+	e.uint32(r.MaximumTargetLevel)
+	e.uint32(r.SpellClassSet)
+	for _, v := range r.SpellClassMask {
+		e.uint32(v)
+	}
+	e.uint32(r.MaxAffectedTargets)
+	e.uint32(r.DamageClass)
+	e.uint32(r.PreventionType)
+	e.uint32(r.StanceBarOrder)
+	for _, v := range r.EffectDamageMultiplier {
+		e.float32(v)
+	}
+	e.uint32(r.MinFactionID)
+	e.uint32(r.MinReputation)
+	e.uint32(r.ReqAuraVision)
+	for _, v := range r.TotemCategory {
+		e.uint32(v)
+	}
+	e.uint32(r.AreaGroupID)
+	e.uint32(r.SchoolMask)
+	e.uint32(r.RuneCostID)
+	e.uint32(r.SpellMissileID)
+	e.uint32(r.PowerDisplayID)
+	for _, v := range r.EffectBonusMultiplier {
+		e.float32(v)
+	}
+	e.uint32(r.SpellDescVariableID)
+	e.uint32(r.SpellDifficultyID)
+}
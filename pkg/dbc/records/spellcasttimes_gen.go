@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellCastTimesFile is the client file SpellCastTimesRecord is read from.
+const SpellCastTimesFile = "SpellCastTimes.dbc"
+
+// SpellCastTimesRecord is one record of SpellCastTimes.dbc.
+type SpellCastTimesRecord struct {
+	ID           uint32
+	Time         int32
+	TimePerLevel int32
+	MinTime      int32
+}
+
+// ReadSpellCastTimes reads every record of SpellCastTimes.dbc from r.
+func ReadSpellCastTimes(r io.Reader) ([]SpellCastTimesRecord, error) {
+	return readRecords[SpellCastTimesRecord](r, SpellCastTimesFile, 16)
+}
+
+// WriteSpellCastTimes writes records to w in SpellCastTimes.dbc format.
+func WriteSpellCastTimes(w io.Writer, records []SpellCastTimesRecord) error {
+	return writeRecords(w, records, 16, 4)
+}
+
+func (r *SpellCastTimesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Time = d.int32()
+	r.TimePerLevel = d.int32()
+	r.MinTime = d.int32()
+}
+
+func (r *SpellCastTimesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Time)
+	e.int32(r.TimePerLevel)
+	e.int32(r.MinTime)
+}
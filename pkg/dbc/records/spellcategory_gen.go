@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellCategoryFile is the client file SpellCategoryRecord is read from.
+const SpellCategoryFile = "SpellCategory.dbc"
+
+// SpellCategoryRecord is one record of SpellCategory.dbc.
+type SpellCategoryRecord struct {
+	ID    uint32
+	Flags uint32
+}
+
+// ReadSpellCategory reads every record of SpellCategory.dbc from r.
+func ReadSpellCategory(r io.Reader) ([]SpellCategoryRecord, error) {
+	return readRecords[SpellCategoryRecord](r, SpellCategoryFile, 8)
+}
+
+// WriteSpellCategory writes records to w in SpellCategory.dbc format.
+func WriteSpellCategory(w io.Writer, records []SpellCategoryRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *SpellCategoryRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+}
+
+func (r *SpellCategoryRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellDescriptionVariablesFile is the client file SpellDescriptionVariablesRecord is read from.
+const SpellDescriptionVariablesFile = "SpellDescriptionVariables.dbc"
+
+// SpellDescriptionVariablesRecord is one record of SpellDescriptionVariables.dbc.
+type SpellDescriptionVariablesRecord struct {
+	ID  uint32
+	Var string
+}
+
+// ReadSpellDescriptionVariables reads every record of SpellDescriptionVariables.dbc from r.
+func ReadSpellDescriptionVariables(r io.Reader) ([]SpellDescriptionVariablesRecord, error) {
+	return readRecords[SpellDescriptionVariablesRecord](r, SpellDescriptionVariablesFile, 8)
+}
+
+// WriteSpellDescriptionVariables writes records to w in SpellDescriptionVariables.dbc format.
+func WriteSpellDescriptionVariables(w io.Writer, records []SpellDescriptionVariablesRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *SpellDescriptionVariablesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Var = d.string()
+}
+
+func (r *SpellDescriptionVariablesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Var)
+}
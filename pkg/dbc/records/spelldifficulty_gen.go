@@ -0,0 +1,38 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellDifficultyFile is the client file SpellDifficultyRecord is read from.
+const SpellDifficultyFile = "SpellDifficulty.dbc"
+
+// SpellDifficultyRecord is one record of SpellDifficulty.dbc.
+type SpellDifficultyRecord struct {
+	ID         uint32
+	Difficulty [4]uint32
+}
+
+// ReadSpellDifficulty reads every record of SpellDifficulty.dbc from r.
+func ReadSpellDifficulty(r io.Reader) ([]SpellDifficultyRecord, error) {
+	return readRecords[SpellDifficultyRecord](r, SpellDifficultyFile, 20)
+}
+
+// WriteSpellDifficulty writes records to w in SpellDifficulty.dbc format.
+func WriteSpellDifficulty(w io.Writer, records []SpellDifficultyRecord) error {
+	return writeRecords(w, records, 20, 5)
+}
+
+func (r *SpellDifficultyRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.Difficulty {
+		r.Difficulty[i] = d.uint32()
+	}
+}
+
+func (r *SpellDifficultyRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.Difficulty {
+		e.uint32(v)
+	}
+}
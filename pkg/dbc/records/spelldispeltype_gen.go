@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellDispelTypeFile is the client file SpellDispelTypeRecord is read from.
+const SpellDispelTypeFile = "SpellDispelType.dbc"
+
+// SpellDispelTypeRecord is one record of SpellDispelType.dbc.
+type SpellDispelTypeRecord struct {
+	ID               uint32
+	Name             Loc
+	Mask             uint32
+	ImmunityPossible uint32
+	InternalName     string
+}
+
+// ReadSpellDispelType reads every record of SpellDispelType.dbc from r.
+func ReadSpellDispelType(r io.Reader) ([]SpellDispelTypeRecord, error) {
+	return readRecords[SpellDispelTypeRecord](r, SpellDispelTypeFile, 84)
+}
+
+// WriteSpellDispelType writes records to w in SpellDispelType.dbc format.
+func WriteSpellDispelType(w io.Writer, records []SpellDispelTypeRecord) error {
+	return writeRecords(w, records, 84, 21)
+}
+
+func (r *SpellDispelTypeRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	r.Mask = d.uint32()
+	r.ImmunityPossible = d.uint32()
+	r.InternalName = d.string()
+}
+
+func (r *SpellDispelTypeRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	e.uint32(r.Mask)
+	e.uint32(r.ImmunityPossible)
+	e.string(r.InternalName)
+}
@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellDurationFile is the client file SpellDurationRecord is read from.
+const SpellDurationFile = "SpellDuration.dbc"
+
+// SpellDurationRecord is one record of SpellDuration.dbc.
+type SpellDurationRecord struct {
+	ID           uint32
+	BaseDuration uint32
+	PerLevel     int32
+	MaxDuration  int32
+}
+
+// ReadSpellDuration reads every record of SpellDuration.dbc from r.
+func ReadSpellDuration(r io.Reader) ([]SpellDurationRecord, error) {
+	return readRecords[SpellDurationRecord](r, SpellDurationFile, 16)
+}
+
+// WriteSpellDuration writes records to w in SpellDuration.dbc format.
+func WriteSpellDuration(w io.Writer, records []SpellDurationRecord) error {
+	return writeRecords(w, records, 16, 4)
+}
+
+func (r *SpellDurationRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.BaseDuration = d.uint32()
+	r.PerLevel = d.int32()
+	r.MaxDuration = d.int32()
+}
+
+func (r *SpellDurationRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.BaseDuration)
+	e.int32(r.PerLevel)
+	e.int32(r.MaxDuration)
+}
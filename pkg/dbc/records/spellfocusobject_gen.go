@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellFocusObjectFile is the client file SpellFocusObjectRecord is read from.
+const SpellFocusObjectFile = "SpellFocusObject.dbc"
+
+// SpellFocusObjectRecord is one record of SpellFocusObject.dbc.
+type SpellFocusObjectRecord struct {
+	ID   uint32
+	Name Loc
+}
+
+// ReadSpellFocusObject reads every record of SpellFocusObject.dbc from r.
+func ReadSpellFocusObject(r io.Reader) ([]SpellFocusObjectRecord, error) {
+	return readRecords[SpellFocusObjectRecord](r, SpellFocusObjectFile, 72)
+}
+
+// WriteSpellFocusObject writes records to w in SpellFocusObject.dbc format.
+func WriteSpellFocusObject(w io.Writer, records []SpellFocusObjectRecord) error {
+	return writeRecords(w, records, 72, 18)
+}
+
+func (r *SpellFocusObjectRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *SpellFocusObjectRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+}
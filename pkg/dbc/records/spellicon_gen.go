@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellIconFile is the client file SpellIconRecord is read from.
+const SpellIconFile = "SpellIcon.dbc"
+
+// SpellIconRecord is one record of SpellIcon.dbc.
+type SpellIconRecord struct {
+	ID   uint32
+	Name string
+}
+
+// ReadSpellIcon reads every record of SpellIcon.dbc from r.
+func ReadSpellIcon(r io.Reader) ([]SpellIconRecord, error) {
+	return readRecords[SpellIconRecord](r, SpellIconFile, 8)
+}
+
+// WriteSpellIcon writes records to w in SpellIcon.dbc format.
+func WriteSpellIcon(w io.Writer, records []SpellIconRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *SpellIconRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.string()
+}
+
+func (r *SpellIconRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Name)
+}
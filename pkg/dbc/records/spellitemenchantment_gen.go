@@ -0,0 +1,86 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellitemenchantmentFile is the client file SpellitemenchantmentRecord is read from.
+const SpellitemenchantmentFile = "Spellitemenchantment.dbc"
+
+// SpellitemenchantmentRecord is one record of Spellitemenchantment.dbc.
+type SpellitemenchantmentRecord struct {
+	ID                uint32
+	Charges           uint32
+	EffectType        [3]uint32
+	EffectPointsMin   [3]int32
+	EffectArg         [3]uint32
+	EffectID          [3]uint32
+	Name              Loc
+	ItemVisual        uint32
+	Flags             uint32
+	SrcItemID         uint32
+	ConditionID       uint32
+	RequiredSkillID   uint32
+	RequiredSkillRank uint32
+	MinLevel          uint32
+}
+
+// ReadSpellitemenchantment reads every record of Spellitemenchantment.dbc from r.
+func ReadSpellitemenchantment(r io.Reader) ([]SpellitemenchantmentRecord, error) {
+	return readRecords[SpellitemenchantmentRecord](r, SpellitemenchantmentFile, 152)
+}
+
+// WriteSpellitemenchantment writes records to w in Spellitemenchantment.dbc format.
+func WriteSpellitemenchantment(w io.Writer, records []SpellitemenchantmentRecord) error {
+	return writeRecords(w, records, 152, 38)
+}
+
+func (r *SpellitemenchantmentRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Charges = d.uint32()
+	for i := range r.EffectType {
+		r.EffectType[i] = d.uint32()
+	}
+	for i := range r.EffectPointsMin {
+		r.EffectPointsMin[i] = d.int32()
+	}
+	for i := range r.EffectArg {
+		r.EffectArg[i] = d.uint32()
+	}
+	for i := range r.EffectID {
+		r.EffectID[i] = d.uint32()
+	}
+	r.Name = d.loc()
+	r.ItemVisual = d.uint32()
+	r.Flags = d.uint32()
+	r.SrcItemID = d.uint32()
+	r.ConditionID = d.uint32()
+	r.RequiredSkillID = d.uint32()
+	r.RequiredSkillRank = d.uint32()
+	r.MinLevel = d.uint32()
+}
+
+func (r *SpellitemenchantmentRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Charges)
+	for _, v := range r.EffectType {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectPointsMin {
+		e.int32(v)
+	}
+	for _, v := range r.EffectArg {
+		e.uint32(v)
+	}
+	for _, v := range r.EffectID {
+		e.uint32(v)
+	}
+	e.loc(r.Name)
+	e.uint32(r.ItemVisual)
+	e.uint32(r.Flags)
+	e.uint32(r.SrcItemID)
+	e.uint32(r.ConditionID)
+	e.uint32(r.RequiredSkillID)
+	e.uint32(r.RequiredSkillRank)
+	e.uint32(r.MinLevel)
+}
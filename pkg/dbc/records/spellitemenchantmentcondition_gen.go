@@ -0,0 +1,73 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellItemEnchantmentConditionFile is the client file SpellItemEnchantmentConditionRecord is read from.
+const SpellItemEnchantmentConditionFile = "SpellItemEnchantmentCondition.dbc"
+
+// SpellItemEnchantmentConditionRecord is one record of SpellItemEnchantmentCondition.dbc.
+type SpellItemEnchantmentConditionRecord struct {
+	ID            uint32
+	LtOperandType [5]uint8
+	LtOperand     [5]uint32
+	Operator      [5]uint8
+	RtOperandType [5]uint8
+	RtOperand     [5]uint32
+	Logic         [5]uint8
+}
+
+// ReadSpellItemEnchantmentCondition reads every record of SpellItemEnchantmentCondition.dbc from r.
+func ReadSpellItemEnchantmentCondition(r io.Reader) ([]SpellItemEnchantmentConditionRecord, error) {
+	return readRecords[SpellItemEnchantmentConditionRecord](r, SpellItemEnchantmentConditionFile, 64)
+}
+
+// WriteSpellItemEnchantmentCondition writes records to w in SpellItemEnchantmentCondition.dbc format.
+func WriteSpellItemEnchantmentCondition(w io.Writer, records []SpellItemEnchantmentConditionRecord) error {
+	return writeRecords(w, records, 64, 31)
+}
+
+func (r *SpellItemEnchantmentConditionRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.LtOperandType {
+		r.LtOperandType[i] = d.uint8()
+	}
+	for i := range r.LtOperand {
+		r.LtOperand[i] = d.uint32()
+	}
+	for i := range r.Operator {
+		r.Operator[i] = d.uint8()
+	}
+	for i := range r.RtOperandType {
+		r.RtOperandType[i] = d.uint8()
+	}
+	for i := range r.RtOperand {
+		r.RtOperand[i] = d.uint32()
+	}
+	for i := range r.Logic {
+		r.Logic[i] = d.uint8()
+	}
+}
+
+func (r *SpellItemEnchantmentConditionRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.LtOperandType {
+		e.uint8(v)
+	}
+	for _, v := range r.LtOperand {
+		e.uint32(v)
+	}
+	for _, v := range r.Operator {
+		e.uint8(v)
+	}
+	for _, v := range r.RtOperandType {
+		e.uint8(v)
+	}
+	for _, v := range r.RtOperand {
+		e.uint32(v)
+	}
+	for _, v := range r.Logic {
+		e.uint8(v)
+	}
+}
@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellMechanicFile is the client file SpellMechanicRecord is read from.
+const SpellMechanicFile = "SpellMechanic.dbc"
+
+// SpellMechanicRecord is one record of SpellMechanic.dbc.
+type SpellMechanicRecord struct {
+	ID   uint32
+	Name Loc
+}
+
+// ReadSpellMechanic reads every record of SpellMechanic.dbc from r.
+func ReadSpellMechanic(r io.Reader) ([]SpellMechanicRecord, error) {
+	return readRecords[SpellMechanicRecord](r, SpellMechanicFile, 72)
+}
+
+// WriteSpellMechanic writes records to w in SpellMechanic.dbc format.
+func WriteSpellMechanic(w io.Writer, records []SpellMechanicRecord) error {
+	return writeRecords(w, records, 72, 18)
+}
+
+func (r *SpellMechanicRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+}
+
+func (r *SpellMechanicRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+}
@@ -0,0 +1,73 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellMissileFile is the client file SpellMissileRecord is read from.
+const SpellMissileFile = "SpellMissile.dbc"
+
+// SpellMissileRecord is one record of SpellMissile.dbc.
+type SpellMissileRecord struct {
+	ID                 uint32
+	Flags              uint32
+	DefaultPitchMin    float32
+	DefaultPitchMax    float32
+	DefaultSpeedMin    float32
+	DefaultSpeedMax    float32
+	RandomizeFacingMin float32
+	RandomizeFacingMax float32
+	RandomizePitchMin  float32
+	RandomizePitchMax  float32
+	RandomizeSpeedMin  float32
+	RandomizeSpeedMax  float32
+	Gravity            float32
+	MaxDuration        float32
+	CollisionRadius    float32
+}
+
+// ReadSpellMissile reads every record of SpellMissile.dbc from r.
+func ReadSpellMissile(r io.Reader) ([]SpellMissileRecord, error) {
+	return readRecords[SpellMissileRecord](r, SpellMissileFile, 60)
+}
+
+// WriteSpellMissile writes records to w in SpellMissile.dbc format.
+func WriteSpellMissile(w io.Writer, records []SpellMissileRecord) error {
+	return writeRecords(w, records, 60, 15)
+}
+
+func (r *SpellMissileRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+	r.DefaultPitchMin = d.float32()
+	r.DefaultPitchMax = d.float32()
+	r.DefaultSpeedMin = d.float32()
+	r.DefaultSpeedMax = d.float32()
+	r.RandomizeFacingMin = d.float32()
+	r.RandomizeFacingMax = d.float32()
+	r.RandomizePitchMin = d.float32()
+	r.RandomizePitchMax = d.float32()
+	r.RandomizeSpeedMin = d.float32()
+	r.RandomizeSpeedMax = d.float32()
+	r.Gravity = d.float32()
+	r.MaxDuration = d.float32()
+	r.CollisionRadius = d.float32()
+}
+
+func (r *SpellMissileRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+	e.float32(r.DefaultPitchMin)
+	e.float32(r.DefaultPitchMax)
+	e.float32(r.DefaultSpeedMin)
+	e.float32(r.DefaultSpeedMax)
+	e.float32(r.RandomizeFacingMin)
+	e.float32(r.RandomizeFacingMax)
+	e.float32(r.RandomizePitchMin)
+	e.float32(r.RandomizePitchMax)
+	e.float32(r.RandomizeSpeedMin)
+	e.float32(r.RandomizeSpeedMax)
+	e.float32(r.Gravity)
+	e.float32(r.MaxDuration)
+	e.float32(r.CollisionRadius)
+}
@@ -0,0 +1,43 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellMissileMotionFile is the client file SpellMissileMotionRecord is read from.
+const SpellMissileMotionFile = "SpellMissileMotion.dbc"
+
+// SpellMissileMotionRecord is one record of SpellMissileMotion.dbc.
+type SpellMissileMotionRecord struct {
+	ID           uint32
+	Name         string
+	Script       string
+	Flags        int32
+	MissileCount int32
+}
+
+// ReadSpellMissileMotion reads every record of SpellMissileMotion.dbc from r.
+func ReadSpellMissileMotion(r io.Reader) ([]SpellMissileMotionRecord, error) {
+	return readRecords[SpellMissileMotionRecord](r, SpellMissileMotionFile, 20)
+}
+
+// WriteSpellMissileMotion writes records to w in SpellMissileMotion.dbc format.
+func WriteSpellMissileMotion(w io.Writer, records []SpellMissileMotionRecord) error {
+	return writeRecords(w, records, 20, 5)
+}
+
+func (r *SpellMissileMotionRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.string()
+	r.Script = d.string()
+	r.Flags = d.int32()
+	r.MissileCount = d.int32()
+}
+
+func (r *SpellMissileMotionRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Name)
+	e.string(r.Script)
+	e.int32(r.Flags)
+	e.int32(r.MissileCount)
+}
@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellRadiusFile is the client file SpellRadiusRecord is read from.
+const SpellRadiusFile = "SpellRadius.dbc"
+
+// SpellRadiusRecord is one record of SpellRadius.dbc.
+type SpellRadiusRecord struct {
+	ID             uint32
+	Radius         float32
+	RadiusPerLevel float32
+	MaxRadius      float32
+}
+
+// ReadSpellRadius reads every record of SpellRadius.dbc from r.
+func ReadSpellRadius(r io.Reader) ([]SpellRadiusRecord, error) {
+	return readRecords[SpellRadiusRecord](r, SpellRadiusFile, 16)
+}
+
+// WriteSpellRadius writes records to w in SpellRadius.dbc format.
+func WriteSpellRadius(w io.Writer, records []SpellRadiusRecord) error {
+	return writeRecords(w, records, 16, 4)
+}
+
+func (r *SpellRadiusRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Radius = d.float32()
+	r.RadiusPerLevel = d.float32()
+	r.MaxRadius = d.float32()
+}
+
+func (r *SpellRadiusRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.float32(r.Radius)
+	e.float32(r.RadiusPerLevel)
+	e.float32(r.MaxRadius)
+}
@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellRangeFile is the client file SpellRangeRecord is read from.
+const SpellRangeFile = "SpellRange.dbc"
+
+// SpellRangeRecord is one record of SpellRange.dbc.
+type SpellRangeRecord struct {
+	ID              uint32
+	MinRangeHostile uint32
+	MinRangeFriend  uint32
+	MaxRangeHostile uint32
+	MaxRangeFriend  uint32
+	Type            int32
+	Name            Loc
+	ShortName       Loc
+}
+
+// ReadSpellRange reads every record of SpellRange.dbc from r.
+func ReadSpellRange(r io.Reader) ([]SpellRangeRecord, error) {
+	return readRecords[SpellRangeRecord](r, SpellRangeFile, 160)
+}
+
+// WriteSpellRange writes records to w in SpellRange.dbc format.
+func WriteSpellRange(w io.Writer, records []SpellRangeRecord) error {
+	return writeRecords(w, records, 160, 40)
+}
+
+func (r *SpellRangeRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MinRangeHostile = d.uint32()
+	r.MinRangeFriend = d.uint32()
+	r.MaxRangeHostile = d.uint32()
+	r.MaxRangeFriend = d.uint32()
+	r.Type = d.int32()
+	r.Name = d.loc()
+	r.ShortName = d.loc()
+}
+
+func (r *SpellRangeRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.MinRangeHostile)
+	e.uint32(r.MinRangeFriend)
+	e.uint32(r.MaxRangeHostile)
+	e.uint32(r.MaxRangeFriend)
+	e.int32(r.Type)
+	e.loc(r.Name)
+	e.loc(r.ShortName)
+}
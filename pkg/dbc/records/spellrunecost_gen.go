@@ -0,0 +1,41 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellRuneCostFile is the client file SpellRuneCostRecord is read from.
+const SpellRuneCostFile = "SpellRuneCost.dbc"
+
+// SpellRuneCostRecord is one record of SpellRuneCost.dbc.
+type SpellRuneCostRecord struct {
+	ID        uint32
+	Cost      [3]uint32
+	PowerGain uint32
+}
+
+// ReadSpellRuneCost reads every record of SpellRuneCost.dbc from r.
+func ReadSpellRuneCost(r io.Reader) ([]SpellRuneCostRecord, error) {
+	return readRecords[SpellRuneCostRecord](r, SpellRuneCostFile, 20)
+}
+
+// WriteSpellRuneCost writes records to w in SpellRuneCost.dbc format.
+func WriteSpellRuneCost(w io.Writer, records []SpellRuneCostRecord) error {
+	return writeRecords(w, records, 20, 5)
+}
+
+func (r *SpellRuneCostRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	for i := range r.Cost {
+		r.Cost[i] = d.uint32()
+	}
+	r.PowerGain = d.uint32()
+}
+
+func (r *SpellRuneCostRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	for _, v := range r.Cost {
+		e.uint32(v)
+	}
+	e.uint32(r.PowerGain)
+}
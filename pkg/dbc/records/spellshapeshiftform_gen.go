@@ -0,0 +1,63 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellshapeshiftformFile is the client file SpellshapeshiftformRecord is read from.
+const SpellshapeshiftformFile = "Spellshapeshiftform.dbc"
+
+// SpellshapeshiftformRecord is one record of Spellshapeshiftform.dbc.
+type SpellshapeshiftformRecord struct {
+	ID                uint32
+	ButtonPosition    uint32
+	Name              Loc
+	Flags             uint32
+	CreatureType      int32
+	AttackIconID      uint32
+	CombatRoundTime   uint32
+	CreatureDisplayID [4]uint32
+	PresetSpellID     [8]uint32
+}
+
+// ReadSpellshapeshiftform reads every record of Spellshapeshiftform.dbc from r.
+func ReadSpellshapeshiftform(r io.Reader) ([]SpellshapeshiftformRecord, error) {
+	return readRecords[SpellshapeshiftformRecord](r, SpellshapeshiftformFile, 140)
+}
+
+// WriteSpellshapeshiftform writes records to w in Spellshapeshiftform.dbc format.
+func WriteSpellshapeshiftform(w io.Writer, records []SpellshapeshiftformRecord) error {
+	return writeRecords(w, records, 140, 35)
+}
+
+func (r *SpellshapeshiftformRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.ButtonPosition = d.uint32()
+	r.Name = d.loc()
+	r.Flags = d.uint32()
+	r.CreatureType = d.int32()
+	r.AttackIconID = d.uint32()
+	r.CombatRoundTime = d.uint32()
+	for i := range r.CreatureDisplayID {
+		r.CreatureDisplayID[i] = d.uint32()
+	}
+	for i := range r.PresetSpellID {
+		r.PresetSpellID[i] = d.uint32()
+	}
+}
+
+func (r *SpellshapeshiftformRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.ButtonPosition)
+	e.loc(r.Name)
+	e.uint32(r.Flags)
+	e.int32(r.CreatureType)
+	e.uint32(r.AttackIconID)
+	e.uint32(r.CombatRoundTime)
+	for _, v := range r.CreatureDisplayID {
+		e.uint32(v)
+	}
+	for _, v := range r.PresetSpellID {
+		e.uint32(v)
+	}
+}
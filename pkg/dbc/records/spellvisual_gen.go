@@ -0,0 +1,124 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellVisualFile is the client file SpellVisualRecord is read from.
+const SpellVisualFile = "SpellVisual.dbc"
+
+// SpellVisualRecord is one record of SpellVisual.dbc.
+type SpellVisualRecord struct {
+	ID                        uint32
+	PrecastKit                uint32
+	CastKit                   uint32
+	ImpactKit                 uint32
+	StateKit                  uint32
+	StateDoneKit              uint32
+	ChannelKit                uint32
+	HasMissile                int32
+	MissileModel              uint32
+	MissilePathType           uint32
+	MissileDestAttachment     uint32
+	MissileSound              uint32
+	AnimEventSoundID          uint32
+	Flags                     uint32
+	CasterImpactKit           uint32
+	TargetImpactKid           uint32
+	MissileAttachment         uint32
+	MissileFollowGroundHeight uint32
+	MissileFollowDropSpeed    uint32
+	MissileFollowApproach     uint32
+	MissileFollowGroundFlags  uint32
+	MissileMotion             uint32
+	MissileTargetingKit       uint32
+	InstantAreaKit            uint32
+	ImpactAreaKit             uint32
+	PersistentAreaKit         uint32
+	MissileCastOffsetX        float32
+	MissileCastOffsetY        float32
+	MissileCastOffsetZ        float32
+	MissileImpactOffsetX      float32
+	MissileImpactOffsetY      float32
+	MissileImpactOffsetZ      float32
+}
+
+// ReadSpellVisual reads every record of SpellVisual.dbc from r.
+func ReadSpellVisual(r io.Reader) ([]SpellVisualRecord, error) {
+	return readRecords[SpellVisualRecord](r, SpellVisualFile, 128)
+}
+
+// WriteSpellVisual writes records to w in SpellVisual.dbc format.
+func WriteSpellVisual(w io.Writer, records []SpellVisualRecord) error {
+	return writeRecords(w, records, 128, 32)
+}
+
+func (r *SpellVisualRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.PrecastKit = d.uint32()
+	r.CastKit = d.uint32()
+	r.ImpactKit = d.uint32()
+	r.StateKit = d.uint32()
+	r.StateDoneKit = d.uint32()
+	r.ChannelKit = d.uint32()
+	r.HasMissile = d.int32()
+	r.MissileModel = d.uint32()
+	r.MissilePathType = d.uint32()
+	r.MissileDestAttachment = d.uint32()
+	r.MissileSound = d.uint32()
+	r.AnimEventSoundID = d.uint32()
+	r.Flags = d.uint32()
+	r.CasterImpactKit = d.uint32()
+	r.TargetImpactKid = d.uint32()
+	r.MissileAttachment = d.uint32()
+	r.MissileFollowGroundHeight = d.uint32()
+	r.MissileFollowDropSpeed = d.uint32()
+	r.MissileFollowApproach = d.uint32()
+	r.MissileFollowGroundFlags = d.uint32()
+	r.MissileMotion = d.uint32()
+	r.MissileTargetingKit = d.uint32()
+	r.InstantAreaKit = d.uint32()
+	r.ImpactAreaKit = d.uint32()
+	r.PersistentAreaKit = d.uint32()
+	r.MissileCastOffsetX = d.float32()
+	r.MissileCastOffsetY = d.float32()
+	r.MissileCastOffsetZ = d.float32()
+	r.MissileImpactOffsetX = d.float32()
+	r.MissileImpactOffsetY = d.float32()
+	r.MissileImpactOffsetZ = d.float32()
+}
+
+func (r *SpellVisualRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.PrecastKit)
+	e.uint32(r.CastKit)
+	e.uint32(r.ImpactKit)
+	e.uint32(r.StateKit)
+	e.uint32(r.StateDoneKit)
+	e.uint32(r.ChannelKit)
+	e.int32(r.HasMissile)
+	e.uint32(r.MissileModel)
+	e.uint32(r.MissilePathType)
+	e.uint32(r.MissileDestAttachment)
+	e.uint32(r.MissileSound)
+	e.uint32(r.AnimEventSoundID)
+	e.uint32(r.Flags)
+	e.uint32(r.CasterImpactKit)
+	e.uint32(r.TargetImpactKid)
+	e.uint32(r.MissileAttachment)
+	e.uint32(r.MissileFollowGroundHeight)
+	e.uint32(r.MissileFollowDropSpeed)
+	e.uint32(r.MissileFollowApproach)
+	e.uint32(r.MissileFollowGroundFlags)
+	e.uint32(r.MissileMotion)
+	e.uint32(r.MissileTargetingKit)
+	e.uint32(r.InstantAreaKit)
+	e.uint32(r.ImpactAreaKit)
+	e.uint32(r.PersistentAreaKit)
+	e.float32(r.MissileCastOffsetX)
+	e.float32(r.MissileCastOffsetY)
+	e.float32(r.MissileCastOffsetZ)
+	e.float32(r.MissileImpactOffsetX)
+	e.float32(r.MissileImpactOffsetY)
+	e.float32(r.MissileImpactOffsetZ)
+}
@@ -0,0 +1,49 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellVisualEffectNameFile is the client file SpellVisualEffectNameRecord is read from.
+const SpellVisualEffectNameFile = "SpellVisualEffectName.dbc"
+
+// SpellVisualEffectNameRecord is one record of SpellVisualEffectName.dbc.
+type SpellVisualEffectNameRecord struct {
+	ID              uint32
+	Name            string
+	FileName        string
+	AreaEffectSize  float32
+	Scale           float32
+	MinAllowedScale float32
+	MaxAllowedScale float32
+}
+
+// ReadSpellVisualEffectName reads every record of SpellVisualEffectName.dbc from r.
+func ReadSpellVisualEffectName(r io.Reader) ([]SpellVisualEffectNameRecord, error) {
+	return readRecords[SpellVisualEffectNameRecord](r, SpellVisualEffectNameFile, 28)
+}
+
+// WriteSpellVisualEffectName writes records to w in SpellVisualEffectName.dbc format.
+func WriteSpellVisualEffectName(w io.Writer, records []SpellVisualEffectNameRecord) error {
+	return writeRecords(w, records, 28, 7)
+}
+
+func (r *SpellVisualEffectNameRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.string()
+	r.FileName = d.string()
+	r.AreaEffectSize = d.float32()
+	r.Scale = d.float32()
+	r.MinAllowedScale = d.float32()
+	r.MaxAllowedScale = d.float32()
+}
+
+func (r *SpellVisualEffectNameRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Name)
+	e.string(r.FileName)
+	e.float32(r.AreaEffectSize)
+	e.float32(r.Scale)
+	e.float32(r.MinAllowedScale)
+	e.float32(r.MaxAllowedScale)
+}
@@ -0,0 +1,115 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellVisualKitFile is the client file SpellVisualKitRecord is read from.
+const SpellVisualKitFile = "SpellVisualKit.dbc"
+
+// SpellVisualKitRecord is one record of SpellVisualKit.dbc.
+type SpellVisualKitRecord struct {
+	ID                uint32
+	StartAnimID       uint32
+	AnimID            uint32
+	HeadEffect        uint32
+	ChestEffect       uint32
+	BaseEffect        uint32
+	LeftHandEffect    uint32
+	RightHandEffect   uint32
+	BreathEffect      uint32
+	LeftWeaponEffect  uint32
+	RightWeaponEffect uint32
+	SpecialEffect     [3]uint32
+	WorldEffect       uint32
+	SoundID           uint32
+	ShakeID           uint32
+	CharProc          [4]uint32
+	CharParam0        [4]float32
+	CharParam1        [4]float32
+	CharParam2        [4]float32
+	CharParam3        [4]float32
+	Flags             uint32
+}
+
+// ReadSpellVisualKit reads every record of SpellVisualKit.dbc from r.
+func ReadSpellVisualKit(r io.Reader) ([]SpellVisualKitRecord, error) {
+	return readRecords[SpellVisualKitRecord](r, SpellVisualKitFile, 152)
+}
+
+// WriteSpellVisualKit writes records to w in SpellVisualKit.dbc format.
+func WriteSpellVisualKit(w io.Writer, records []SpellVisualKitRecord) error {
+	return writeRecords(w, records, 152, 38)
+}
+
+func (r *SpellVisualKitRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.StartAnimID = d.uint32()
+	r.AnimID = d.uint32()
+	r.HeadEffect = d.uint32()
+	r.ChestEffect = d.uint32()
+	r.BaseEffect = d.uint32()
+	r.LeftHandEffect = d.uint32()
+	r.RightHandEffect = d.uint32()
+	r.BreathEffect = d.uint32()
+	r.LeftWeaponEffect = d.uint32()
+	r.RightWeaponEffect = d.uint32()
+	for i := range r.SpecialEffect {
+		r.SpecialEffect[i] = d.uint32()
+	}
+	r.WorldEffect = d.uint32()
+	r.SoundID = d.uint32()
+	r.ShakeID = d.uint32()
+	for i := range r.CharProc {
+		r.CharProc[i] = d.uint32()
+	}
+	for i := range r.CharParam0 {
+		r.CharParam0[i] = d.float32()
+	}
+	for i := range r.CharParam1 {
+		r.CharParam1[i] = d.float32()
+	}
+	for i := range r.CharParam2 {
+		r.CharParam2[i] = d.float32()
+	}
+	for i := range r.CharParam3 {
+		r.CharParam3[i] = d.float32()
+	}
+	r.Flags = d.uint32()
+}
+
+func (r *SpellVisualKitRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.StartAnimID)
+	e.uint32(r.AnimID)
+	e.uint32(r.HeadEffect)
+	e.uint32(r.ChestEffect)
+	e.uint32(r.BaseEffect)
+	e.uint32(r.LeftHandEffect)
+	e.uint32(r.RightHandEffect)
+	e.uint32(r.BreathEffect)
+	e.uint32(r.LeftWeaponEffect)
+	e.uint32(r.RightWeaponEffect)
+	for _, v := range r.SpecialEffect {
+		e.uint32(v)
+	}
+	e.uint32(r.WorldEffect)
+	e.uint32(r.SoundID)
+	e.uint32(r.ShakeID)
+	for _, v := range r.CharProc {
+		e.uint32(v)
+	}
+	for _, v := range r.CharParam0 {
+		e.float32(v)
+	}
+	for _, v := range r.CharParam1 {
+		e.float32(v)
+	}
+	for _, v := range r.CharParam2 {
+		e.float32(v)
+	}
+	for _, v := range r.CharParam3 {
+		e.float32(v)
+	}
+	e.uint32(r.Flags)
+}
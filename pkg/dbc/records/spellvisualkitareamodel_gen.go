@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellVisualKitAreaModelFile is the client file SpellVisualKitAreaModelRecord is read from.
+const SpellVisualKitAreaModelFile = "SpellVisualKitAreaModel.dbc"
+
+// SpellVisualKitAreaModelRecord is one record of SpellVisualKitAreaModel.dbc.
+type SpellVisualKitAreaModelRecord struct {
+	ID     uint32
+	Name   string
+	EnumID uint32
+}
+
+// ReadSpellVisualKitAreaModel reads every record of SpellVisualKitAreaModel.dbc from r.
+func ReadSpellVisualKitAreaModel(r io.Reader) ([]SpellVisualKitAreaModelRecord, error) {
+	return readRecords[SpellVisualKitAreaModelRecord](r, SpellVisualKitAreaModelFile, 12)
+}
+
+// WriteSpellVisualKitAreaModel writes records to w in SpellVisualKitAreaModel.dbc format.
+func WriteSpellVisualKitAreaModel(w io.Writer, records []SpellVisualKitAreaModelRecord) error {
+	return writeRecords(w, records, 12, 3)
+}
+
+func (r *SpellVisualKitAreaModelRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.string()
+	r.EnumID = d.uint32()
+}
+
+func (r *SpellVisualKitAreaModelRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.Name)
+	e.uint32(r.EnumID)
+}
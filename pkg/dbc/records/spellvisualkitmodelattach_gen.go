@@ -0,0 +1,58 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellVisualKitModelAttachFile is the client file SpellVisualKitModelAttachRecord is read from.
+const SpellVisualKitModelAttachFile = "SpellVisualKitModelAttach.dbc"
+
+// SpellVisualKitModelAttachRecord is one record of SpellVisualKitModelAttach.dbc.
+type SpellVisualKitModelAttachRecord struct {
+	ID                   uint32
+	ParentSpellVisKitID  uint32
+	SpellVisEffectNameID uint32
+	AttachmentID         uint32
+	OffsetX              float32
+	OffsetY              float32
+	OffsetZ              float32
+	Yaw                  float32
+	Pitch                float32
+	Roll                 float32
+}
+
+// ReadSpellVisualKitModelAttach reads every record of SpellVisualKitModelAttach.dbc from r.
+func ReadSpellVisualKitModelAttach(r io.Reader) ([]SpellVisualKitModelAttachRecord, error) {
+	return readRecords[SpellVisualKitModelAttachRecord](r, SpellVisualKitModelAttachFile, 40)
+}
+
+// WriteSpellVisualKitModelAttach writes records to w in SpellVisualKitModelAttach.dbc format.
+func WriteSpellVisualKitModelAttach(w io.Writer, records []SpellVisualKitModelAttachRecord) error {
+	return writeRecords(w, records, 40, 10)
+}
+
+func (r *SpellVisualKitModelAttachRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.ParentSpellVisKitID = d.uint32()
+	r.SpellVisEffectNameID = d.uint32()
+	r.AttachmentID = d.uint32()
+	r.OffsetX = d.float32()
+	r.OffsetY = d.float32()
+	r.OffsetZ = d.float32()
+	r.Yaw = d.float32()
+	r.Pitch = d.float32()
+	r.Roll = d.float32()
+}
+
+func (r *SpellVisualKitModelAttachRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.ParentSpellVisKitID)
+	e.uint32(r.SpellVisEffectNameID)
+	e.uint32(r.AttachmentID)
+	e.float32(r.OffsetX)
+	e.float32(r.OffsetY)
+	e.float32(r.OffsetZ)
+	e.float32(r.Yaw)
+	e.float32(r.Pitch)
+	e.float32(r.Roll)
+}
@@ -0,0 +1,37 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SpellVisualPrecastTransitionsFile is the client file SpellVisualPrecastTransitionsRecord is read from.
+const SpellVisualPrecastTransitionsFile = "SpellVisualPrecastTransitions.dbc"
+
+// SpellVisualPrecastTransitionsRecord is one record of SpellVisualPrecastTransitions.dbc.
+type SpellVisualPrecastTransitionsRecord struct {
+	ID                  uint32
+	PrecastLoadAnimName string
+	PrecastHoldAnimName string
+}
+
+// ReadSpellVisualPrecastTransitions reads every record of SpellVisualPrecastTransitions.dbc from r.
+func ReadSpellVisualPrecastTransitions(r io.Reader) ([]SpellVisualPrecastTransitionsRecord, error) {
+	return readRecords[SpellVisualPrecastTransitionsRecord](r, SpellVisualPrecastTransitionsFile, 12)
+}
+
+// WriteSpellVisualPrecastTransitions writes records to w in SpellVisualPrecastTransitions.dbc format.
+func WriteSpellVisualPrecastTransitions(w io.Writer, records []SpellVisualPrecastTransitionsRecord) error {
+	return writeRecords(w, records, 12, 3)
+}
+
+func (r *SpellVisualPrecastTransitionsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.PrecastLoadAnimName = d.string()
+	r.PrecastHoldAnimName = d.string()
+}
+
+func (r *SpellVisualPrecastTransitionsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.string(r.PrecastLoadAnimName)
+	e.string(r.PrecastHoldAnimName)
+}
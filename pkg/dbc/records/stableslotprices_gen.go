@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// StableslotpricesFile is the client file StableslotpricesRecord is read from.
+const StableslotpricesFile = "Stableslotprices.dbc"
+
+// StableslotpricesRecord is one record of Stableslotprices.dbc.
+type StableslotpricesRecord struct {
+	ID   uint32
+	Cost int32
+}
+
+// ReadStableslotprices reads every record of Stableslotprices.dbc from r.
+func ReadStableslotprices(r io.Reader) ([]StableslotpricesRecord, error) {
+	return readRecords[StableslotpricesRecord](r, StableslotpricesFile, 8)
+}
+
+// WriteStableslotprices writes records to w in Stableslotprices.dbc format.
+func WriteStableslotprices(w io.Writer, records []StableslotpricesRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *StableslotpricesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Cost = d.int32()
+}
+
+func (r *StableslotpricesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Cost)
+}
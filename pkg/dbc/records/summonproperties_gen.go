@@ -0,0 +1,46 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// SummonpropertiesFile is the client file SummonpropertiesRecord is read from.
+const SummonpropertiesFile = "Summonproperties.dbc"
+
+// SummonpropertiesRecord is one record of Summonproperties.dbc.
+type SummonpropertiesRecord struct {
+	ID         uint32
+	Category   int32
+	Faction    int32
+	SummonType int32
+	Slot       int32
+	Flags      int32
+}
+
+// ReadSummonproperties reads every record of Summonproperties.dbc from r.
+func ReadSummonproperties(r io.Reader) ([]SummonpropertiesRecord, error) {
+	return readRecords[SummonpropertiesRecord](r, SummonpropertiesFile, 24)
+}
+
+// WriteSummonproperties writes records to w in Summonproperties.dbc format.
+func WriteSummonproperties(w io.Writer, records []SummonpropertiesRecord) error {
+	return writeRecords(w, records, 24, 6)
+}
+
+func (r *SummonpropertiesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Category = d.int32()
+	r.Faction = d.int32()
+	r.SummonType = d.int32()
+	r.Slot = d.int32()
+	r.Flags = d.int32()
+}
+
+func (r *SummonpropertiesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.Category)
+	e.int32(r.Faction)
+	e.int32(r.SummonType)
+	e.int32(r.Slot)
+	e.int32(r.Flags)
+}
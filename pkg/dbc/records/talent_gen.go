@@ -0,0 +1,74 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TalentFile is the client file TalentRecord is read from.
+const TalentFile = "Talent.dbc"
+
+// TalentRecord is one record of Talent.dbc.
+type TalentRecord struct {
+	ID               uint32
+	SpecID           uint32
+	TierID           uint32
+	ColumnIndex      uint32
+	Rank             [9]uint32
+	PreReqTalent     [3]uint32
+	PreReqRank       [3]uint32
+	Flags            uint32
+	ReqSpellID       uint32
+	AllowForPetFlags [2]uint32
+}
+
+// ReadTalent reads every record of Talent.dbc from r.
+func ReadTalent(r io.Reader) ([]TalentRecord, error) {
+	return readRecords[TalentRecord](r, TalentFile, 92)
+}
+
+// WriteTalent writes records to w in Talent.dbc format.
+func WriteTalent(w io.Writer, records []TalentRecord) error {
+	return writeRecords(w, records, 92, 23)
+}
+
+func (r *TalentRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.SpecID = d.uint32()
+	r.TierID = d.uint32()
+	r.ColumnIndex = d.uint32()
+	for i := range r.Rank {
+		r.Rank[i] = d.uint32()
+	}
+	for i := range r.PreReqTalent {
+		r.PreReqTalent[i] = d.uint32()
+	}
+	for i := range r.PreReqRank {
+		r.PreReqRank[i] = d.uint32()
+	}
+	r.Flags = d.uint32()
+	r.ReqSpellID = d.uint32()
+	for i := range r.AllowForPetFlags {
+		r.AllowForPetFlags[i] = d.uint32()
+	}
+}
+
+func (r *TalentRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.SpecID)
+	e.uint32(r.TierID)
+	e.uint32(r.ColumnIndex)
+	for _, v := range r.Rank {
+		e.uint32(v)
+	}
+	for _, v := range r.PreReqTalent {
+		e.uint32(v)
+	}
+	for _, v := range r.PreReqRank {
+		e.uint32(v)
+	}
+	e.uint32(r.Flags)
+	e.uint32(r.ReqSpellID)
+	for _, v := range r.AllowForPetFlags {
+		e.uint32(v)
+	}
+}
@@ -0,0 +1,52 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TalentTabFile is the client file TalentTabRecord is read from.
+const TalentTabFile = "TalentTab.dbc"
+
+// TalentTabRecord is one record of TalentTab.dbc.
+type TalentTabRecord struct {
+	ID             int32
+	Name           Loc
+	SpellIcon      uint32
+	RaceMask       uint32
+	ClassMask      uint32
+	CreatureFamily uint32
+	OrderIndex     uint32
+	BackgroundFile string
+}
+
+// ReadTalentTab reads every record of TalentTab.dbc from r.
+func ReadTalentTab(r io.Reader) ([]TalentTabRecord, error) {
+	return readRecords[TalentTabRecord](r, TalentTabFile, 96)
+}
+
+// WriteTalentTab writes records to w in TalentTab.dbc format.
+func WriteTalentTab(w io.Writer, records []TalentTabRecord) error {
+	return writeRecords(w, records, 96, 24)
+}
+
+func (r *TalentTabRecord) decode(d *decoder) {
+	r.ID = d.int32()
+	r.Name = d.loc()
+	r.SpellIcon = d.uint32()
+	r.RaceMask = d.uint32()
+	r.ClassMask = d.uint32()
+	r.CreatureFamily = d.uint32()
+	r.OrderIndex = d.uint32()
+	r.BackgroundFile = d.string()
+}
+
+func (r *TalentTabRecord) encode(e *encoder) {
+	e.int32(r.ID)
+	e.loc(r.Name)
+	e.uint32(r.SpellIcon)
+	e.uint32(r.RaceMask)
+	e.uint32(r.ClassMask)
+	e.uint32(r.CreatureFamily)
+	e.uint32(r.OrderIndex)
+	e.string(r.BackgroundFile)
+}
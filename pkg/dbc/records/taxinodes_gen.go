@@ -0,0 +1,53 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TaxiNodesFile is the client file TaxiNodesRecord is read from.
+const TaxiNodesFile = "TaxiNodes.dbc"
+
+// TaxiNodesRecord is one record of TaxiNodes.dbc.
+type TaxiNodesRecord struct {
+	ID              uint32
+	Map             uint32
+	X               float32
+	Y               float32
+	Z               float32
+	Name            Loc
+	MountCreatureID [2]uint32
+}
+
+// ReadTaxiNodes reads every record of TaxiNodes.dbc from r.
+func ReadTaxiNodes(r io.Reader) ([]TaxiNodesRecord, error) {
+	return readRecords[TaxiNodesRecord](r, TaxiNodesFile, 96)
+}
+
+// WriteTaxiNodes writes records to w in TaxiNodes.dbc format.
+func WriteTaxiNodes(w io.Writer, records []TaxiNodesRecord) error {
+	return writeRecords(w, records, 96, 24)
+}
+
+func (r *TaxiNodesRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Map = d.uint32()
+	r.X = d.float32()
+	r.Y = d.float32()
+	r.Z = d.float32()
+	r.Name = d.loc()
+	for i := range r.MountCreatureID {
+		r.MountCreatureID[i] = d.uint32()
+	}
+}
+
+func (r *TaxiNodesRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Map)
+	e.float32(r.X)
+	e.float32(r.Y)
+	e.float32(r.Z)
+	e.loc(r.Name)
+	for _, v := range r.MountCreatureID {
+		e.uint32(v)
+	}
+}
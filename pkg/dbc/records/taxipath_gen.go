@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TaxiPathFile is the client file TaxiPathRecord is read from.
+const TaxiPathFile = "TaxiPath.dbc"
+
+// TaxiPathRecord is one record of TaxiPath.dbc.
+type TaxiPathRecord struct {
+	ID           uint32
+	FromTaxiNode int32
+	ToTaxiNode   int32
+	Cost         int32
+}
+
+// ReadTaxiPath reads every record of TaxiPath.dbc from r.
+func ReadTaxiPath(r io.Reader) ([]TaxiPathRecord, error) {
+	return readRecords[TaxiPathRecord](r, TaxiPathFile, 16)
+}
+
+// WriteTaxiPath writes records to w in TaxiPath.dbc format.
+func WriteTaxiPath(w io.Writer, records []TaxiPathRecord) error {
+	return writeRecords(w, records, 16, 4)
+}
+
+func (r *TaxiPathRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.FromTaxiNode = d.int32()
+	r.ToTaxiNode = d.int32()
+	r.Cost = d.int32()
+}
+
+func (r *TaxiPathRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.FromTaxiNode)
+	e.int32(r.ToTaxiNode)
+	e.int32(r.Cost)
+}
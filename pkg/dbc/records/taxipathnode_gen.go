@@ -0,0 +1,61 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TaxiPathNodeFile is the client file TaxiPathNodeRecord is read from.
+const TaxiPathNodeFile = "TaxiPathNode.dbc"
+
+// TaxiPathNodeRecord is one record of TaxiPathNode.dbc.
+type TaxiPathNodeRecord struct {
+	ID               uint32
+	PathID           uint32
+	NodeIndex        uint32
+	Map              uint32
+	X                float32
+	Y                float32
+	Z                float32
+	Flags            uint32
+	Delay            uint32
+	ArrivalEventID   uint32
+	DepartureEventID uint32
+}
+
+// ReadTaxiPathNode reads every record of TaxiPathNode.dbc from r.
+func ReadTaxiPathNode(r io.Reader) ([]TaxiPathNodeRecord, error) {
+	return readRecords[TaxiPathNodeRecord](r, TaxiPathNodeFile, 44)
+}
+
+// WriteTaxiPathNode writes records to w in TaxiPathNode.dbc format.
+func WriteTaxiPathNode(w io.Writer, records []TaxiPathNodeRecord) error {
+	return writeRecords(w, records, 44, 11)
+}
+
+func (r *TaxiPathNodeRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.PathID = d.uint32()
+	r.NodeIndex = d.uint32()
+	r.Map = d.uint32()
+	r.X = d.float32()
+	r.Y = d.float32()
+	r.Z = d.float32()
+	r.Flags = d.uint32()
+	r.Delay = d.uint32()
+	r.ArrivalEventID = d.uint32()
+	r.DepartureEventID = d.uint32()
+}
+
+func (r *TaxiPathNodeRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.PathID)
+	e.uint32(r.NodeIndex)
+	e.uint32(r.Map)
+	e.float32(r.X)
+	e.float32(r.Y)
+	e.float32(r.Z)
+	e.uint32(r.Flags)
+	e.uint32(r.Delay)
+	e.uint32(r.ArrivalEventID)
+	e.uint32(r.DepartureEventID)
+}
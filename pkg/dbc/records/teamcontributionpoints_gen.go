@@ -0,0 +1,34 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TeamcontributionpointsFile is the client file TeamcontributionpointsRecord is read from.
+const TeamcontributionpointsFile = "Teamcontributionpoints.dbc"
+
+// TeamcontributionpointsRecord is one record of Teamcontributionpoints.dbc.
+type TeamcontributionpointsRecord struct {
+	ID   uint32
+	Data float32
+}
+
+// ReadTeamcontributionpoints reads every record of Teamcontributionpoints.dbc from r.
+func ReadTeamcontributionpoints(r io.Reader) ([]TeamcontributionpointsRecord, error) {
+	return readRecords[TeamcontributionpointsRecord](r, TeamcontributionpointsFile, 8)
+}
+
+// WriteTeamcontributionpoints writes records to w in Teamcontributionpoints.dbc format.
+func WriteTeamcontributionpoints(w io.Writer, records []TeamcontributionpointsRecord) error {
+	return writeRecords(w, records, 8, 2)
+}
+
+func (r *TeamcontributionpointsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Data = d.float32()
+}
+
+func (r *TeamcontributionpointsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.float32(r.Data)
+}
@@ -0,0 +1,40 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TotemCategoryFile is the client file TotemCategoryRecord is read from.
+const TotemCategoryFile = "TotemCategory.dbc"
+
+// TotemCategoryRecord is one record of TotemCategory.dbc.
+type TotemCategoryRecord struct {
+	ID           uint32
+	Name         Loc
+	CategoryType uint32
+	CategoryMask uint32
+}
+
+// ReadTotemCategory reads every record of TotemCategory.dbc from r.
+func ReadTotemCategory(r io.Reader) ([]TotemCategoryRecord, error) {
+	return readRecords[TotemCategoryRecord](r, TotemCategoryFile, 80)
+}
+
+// WriteTotemCategory writes records to w in TotemCategory.dbc format.
+func WriteTotemCategory(w io.Writer, records []TotemCategoryRecord) error {
+	return writeRecords(w, records, 80, 20)
+}
+
+func (r *TotemCategoryRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Name = d.loc()
+	r.CategoryType = d.uint32()
+	r.CategoryMask = d.uint32()
+}
+
+func (r *TotemCategoryRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.loc(r.Name)
+	e.uint32(r.CategoryType)
+	e.uint32(r.CategoryMask)
+}
@@ -0,0 +1,49 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TransportAnimationFile is the client file TransportAnimationRecord is read from.
+const TransportAnimationFile = "TransportAnimation.dbc"
+
+// TransportAnimationRecord is one record of TransportAnimation.dbc.
+type TransportAnimationRecord struct {
+	ID          uint32
+	TransportID uint32
+	TimeIndex   uint32
+	X           float32
+	Y           float32
+	Z           float32
+	SequenceID  uint32
+}
+
+// ReadTransportAnimation reads every record of TransportAnimation.dbc from r.
+func ReadTransportAnimation(r io.Reader) ([]TransportAnimationRecord, error) {
+	return readRecords[TransportAnimationRecord](r, TransportAnimationFile, 28)
+}
+
+// WriteTransportAnimation writes records to w in TransportAnimation.dbc format.
+func WriteTransportAnimation(w io.Writer, records []TransportAnimationRecord) error {
+	return writeRecords(w, records, 28, 7)
+}
+
+func (r *TransportAnimationRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.TransportID = d.uint32()
+	r.TimeIndex = d.uint32()
+	r.X = d.float32()
+	r.Y = d.float32()
+	r.Z = d.float32()
+	r.SequenceID = d.uint32()
+}
+
+func (r *TransportAnimationRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.TransportID)
+	e.uint32(r.TimeIndex)
+	e.float32(r.X)
+	e.float32(r.Y)
+	e.float32(r.Z)
+	e.uint32(r.SequenceID)
+}
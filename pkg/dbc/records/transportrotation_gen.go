@@ -0,0 +1,49 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// TransportrotationFile is the client file TransportrotationRecord is read from.
+const TransportrotationFile = "Transportrotation.dbc"
+
+// TransportrotationRecord is one record of Transportrotation.dbc.
+type TransportrotationRecord struct {
+	ID              uint32
+	GameObjectEntry int32
+	TimeIndex       int32
+	RotX            float32
+	RotY            float32
+	RotZ            float32
+	RotW            float32
+}
+
+// ReadTransportrotation reads every record of Transportrotation.dbc from r.
+func ReadTransportrotation(r io.Reader) ([]TransportrotationRecord, error) {
+	return readRecords[TransportrotationRecord](r, TransportrotationFile, 28)
+}
+
+// WriteTransportrotation writes records to w in Transportrotation.dbc format.
+func WriteTransportrotation(w io.Writer, records []TransportrotationRecord) error {
+	return writeRecords(w, records, 28, 7)
+}
+
+func (r *TransportrotationRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.GameObjectEntry = d.int32()
+	r.TimeIndex = d.int32()
+	r.RotX = d.float32()
+	r.RotY = d.float32()
+	r.RotZ = d.float32()
+	r.RotW = d.float32()
+}
+
+func (r *TransportrotationRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.GameObjectEntry)
+	e.int32(r.TimeIndex)
+	e.float32(r.RotX)
+	e.float32(r.RotY)
+	e.float32(r.RotZ)
+	e.float32(r.RotW)
+}
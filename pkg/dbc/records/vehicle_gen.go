@@ -0,0 +1,134 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// VehicleFile is the client file VehicleRecord is read from.
+const VehicleFile = "Vehicle.dbc"
+
+// VehicleRecord is one record of Vehicle.dbc.
+type VehicleRecord struct {
+	ID                      uint32
+	Flags                   uint32
+	TurnSpeed               float32
+	PitchSpeed              float32
+	PitchMin                float32
+	PitchMax                float32
+	SeatID                  [8]uint32
+	MouseLookOffsetPitch    float32
+	CameraFadeDistScalarMin float32
+	CameraFadeDistScalarMax float32
+	CameraPitchOffset       float32
+	FacingLimitRight        float32
+	FacingLimitLeft         float32
+	MsslTrgtTurnLingering   float32
+	MsslTrgtPitchLingering  float32
+	MsslTrgtMouseLingering  float32
+	MsslTrgtEndOpacity      float32
+	MsslTrgtArcSpeed        float32
+	MsslTrgtArcRepeat       float32
+	MsslTrgtArcWidth        float32
+	MsslTrgtImpactRadius    [2]float32
+	MsslTrgtArcTexture      string
+	MsslTrgtImpactTexture   string
+	MsslTrgtImpactModel     [2]string
+	CameraYawOffset         float32
+	UILocomotionType        uint32
+	MsslTrgtImpactTexRadius float32
+	VehicleUIIndicatorID    uint32
+	PowerDisplayID          uint32
+	PowerDisplayIDUnused    [2]uint32
+}
+
+// ReadVehicle reads every record of Vehicle.dbc from r.
+func ReadVehicle(r io.Reader) ([]VehicleRecord, error) {
+	return readRecords[VehicleRecord](r, VehicleFile, 160)
+}
+
+// WriteVehicle writes records to w in Vehicle.dbc format.
+func WriteVehicle(w io.Writer, records []VehicleRecord) error {
+	return writeRecords(w, records, 160, 40)
+}
+
+func (r *VehicleRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+	r.TurnSpeed = d.float32()
+	r.PitchSpeed = d.float32()
+	r.PitchMin = d.float32()
+	r.PitchMax = d.float32()
+	for i := range r.SeatID {
+		r.SeatID[i] = d.uint32()
+	}
+	r.MouseLookOffsetPitch = d.float32()
+	r.CameraFadeDistScalarMin = d.float32()
+	r.CameraFadeDistScalarMax = d.float32()
+	r.CameraPitchOffset = d.float32()
+	r.FacingLimitRight = d.float32()
+	r.FacingLimitLeft = d.float32()
+	r.MsslTrgtTurnLingering = d.float32()
+	r.MsslTrgtPitchLingering = d.float32()
+	r.MsslTrgtMouseLingering = d.float32()
+	r.MsslTrgtEndOpacity = d.float32()
+	r.MsslTrgtArcSpeed = d.float32()
+	r.MsslTrgtArcRepeat = d.float32()
+	r.MsslTrgtArcWidth = d.float32()
+	for i := range r.MsslTrgtImpactRadius {
+		r.MsslTrgtImpactRadius[i] = d.float32()
+	}
+	r.MsslTrgtArcTexture = d.string()
+	r.MsslTrgtImpactTexture = d.string()
+	for i := range r.MsslTrgtImpactModel {
+		r.MsslTrgtImpactModel[i] = d.string()
+	}
+	r.CameraYawOffset = d.float32()
+	r.UILocomotionType = d.uint32()
+	r.MsslTrgtImpactTexRadius = d.float32()
+	r.VehicleUIIndicatorID = d.uint32()
+	r.PowerDisplayID = d.uint32()
+	for i := range r.PowerDisplayIDUnused {
+		r.PowerDisplayIDUnused[i] = d.uint32()
+	}
+}
+
+func (r *VehicleRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+	e.float32(r.TurnSpeed)
+	e.float32(r.PitchSpeed)
+	e.float32(r.PitchMin)
+	e.float32(r.PitchMax)
+	for _, v := range r.SeatID {
+		e.uint32(v)
+	}
+	e.float32(r.MouseLookOffsetPitch)
+	e.float32(r.CameraFadeDistScalarMin)
+	e.float32(r.CameraFadeDistScalarMax)
+	e.float32(r.CameraPitchOffset)
+	e.float32(r.FacingLimitRight)
+	e.float32(r.FacingLimitLeft)
+	e.float32(r.MsslTrgtTurnLingering)
+	e.float32(r.MsslTrgtPitchLingering)
+	e.float32(r.MsslTrgtMouseLingering)
+	e.float32(r.MsslTrgtEndOpacity)
+	e.float32(r.MsslTrgtArcSpeed)
+	e.float32(r.MsslTrgtArcRepeat)
+	e.float32(r.MsslTrgtArcWidth)
+	for _, v := range r.MsslTrgtImpactRadius {
+		e.float32(v)
+	}
+	e.string(r.MsslTrgtArcTexture)
+	e.string(r.MsslTrgtImpactTexture)
+	for _, v := range r.MsslTrgtImpactModel {
+		e.string(v)
+	}
+	e.float32(r.CameraYawOffset)
+	e.uint32(r.UILocomotionType)
+	e.float32(r.MsslTrgtImpactTexRadius)
+	e.uint32(r.VehicleUIIndicatorID)
+	e.uint32(r.PowerDisplayID)
+	for _, v := range r.PowerDisplayIDUnused {
+		e.uint32(v)
+	}
+}
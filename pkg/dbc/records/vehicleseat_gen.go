@@ -0,0 +1,202 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// VehicleseatFile is the client file VehicleseatRecord is read from.
+const VehicleseatFile = "Vehicleseat.dbc"
+
+// VehicleseatRecord is one record of Vehicleseat.dbc.
+type VehicleseatRecord struct {
+	ID                      uint32
+	Flags                   uint32
+	AttachmentID            int32
+	AttachmentOffsetX       float32
+	AttachmentOffsetY       float32
+	AttachmentOffsetZ       float32
+	EnterPreDelay           float32
+	EnterSpeed              float32
+	EnterGravity            float32
+	EnterMinDuration        float32
+	EnterMaxDuration        float32
+	EnterMinArcHeight       float32
+	EnterMaxArcHeight       float32
+	EnterAnimStart          int32
+	EnterAnimLoop           int32
+	RideAnimStart           int32
+	RideAnimLoop            int32
+	RideUpperAnimStart      int32
+	RideUpperAnimLoop       int32
+	ExitPreDelay            float32
+	ExitSpeed               float32
+	ExitGravity             float32
+	ExitMinDuration         float32
+	ExitMaxDuration         float32
+	ExitMinArcHeight        float32
+	ExitMaxArcHeight        float32
+	ExitAnimStart           int32
+	ExitAnimLoop            int32
+	ExitAnimEnd             int32
+	PassengerYaw            float32
+	PassengerPitch          float32
+	PassengerRoll           float32
+	PassengerAttachmentID   int32
+	VehicleEnterAnim        int32
+	VehicleExitAnim         int32
+	VehicleRideAnimLoop     int32
+	VehicleEnterAnimBone    int32
+	VehicleExitAnimBone     int32
+	VehicleRideAnimLoopBone int32
+	VehicleEnterAnimDelay   float32
+	VehicleExitAnimDelay    float32
+	VehicleAbilityDisplay   uint32
+	EnterUISoundID          uint32
+	ExitUISoundID           uint32
+	UISkin                  int32
+	FlagsB                  uint32
+	CameraEnteringDelay     float32
+	CameraEnteringDuration  float32
+	CameraExitingDelay      float32
+	CameraExitingDuration   float32
+	CameraOffsetX           float32
+	CameraOffsetY           float32
+	CameraOffsetZ           float32
+	CameraPosChaseRate      float32
+	CameraFacingChaseRate   float32
+	CameraEnteringZoom      float32
+	CameraSeatZoomMin       float32
+	CameraSeatZoomMax       float32
+}
+
+// ReadVehicleseat reads every record of Vehicleseat.dbc from r.
+func ReadVehicleseat(r io.Reader) ([]VehicleseatRecord, error) {
+	return readRecords[VehicleseatRecord](r, VehicleseatFile, 232)
+}
+
+// WriteVehicleseat writes records to w in Vehicleseat.dbc format.
+func WriteVehicleseat(w io.Writer, records []VehicleseatRecord) error {
+	return writeRecords(w, records, 232, 58)
+}
+
+func (r *VehicleseatRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Flags = d.uint32()
+	r.AttachmentID = d.int32()
+	r.AttachmentOffsetX = d.float32()
+	r.AttachmentOffsetY = d.float32()
+	r.AttachmentOffsetZ = d.float32()
+	r.EnterPreDelay = d.float32()
+	r.EnterSpeed = d.float32()
+	r.EnterGravity = d.float32()
+	r.EnterMinDuration = d.float32()
+	r.EnterMaxDuration = d.float32()
+	r.EnterMinArcHeight = d.float32()
+	r.EnterMaxArcHeight = d.float32()
+	r.EnterAnimStart = d.int32()
+	r.EnterAnimLoop = d.int32()
+	r.RideAnimStart = d.int32()
+	r.RideAnimLoop = d.int32()
+	r.RideUpperAnimStart = d.int32()
+	r.RideUpperAnimLoop = d.int32()
+	r.ExitPreDelay = d.float32()
+	r.ExitSpeed = d.float32()
+	r.ExitGravity = d.float32()
+	r.ExitMinDuration = d.float32()
+	r.ExitMaxDuration = d.float32()
+	r.ExitMinArcHeight = d.float32()
+	r.ExitMaxArcHeight = d.float32()
+	r.ExitAnimStart = d.int32()
+	r.ExitAnimLoop = d.int32()
+	r.ExitAnimEnd = d.int32()
+	r.PassengerYaw = d.float32()
+	r.PassengerPitch = d.float32()
+	r.PassengerRoll = d.float32()
+	r.PassengerAttachmentID = d.int32()
+	r.VehicleEnterAnim = d.int32()
+	r.VehicleExitAnim = d.int32()
+	r.VehicleRideAnimLoop = d.int32()
+	r.VehicleEnterAnimBone = d.int32()
+	r.VehicleExitAnimBone = d.int32()
+	r.VehicleRideAnimLoopBone = d.int32()
+	r.VehicleEnterAnimDelay = d.float32()
+	r.VehicleExitAnimDelay = d.float32()
+	r.VehicleAbilityDisplay = d.uint32()
+	r.EnterUISoundID = d.uint32()
+	r.ExitUISoundID = d.uint32()
+	r.UISkin = d.int32()
+	r.FlagsB = d.uint32()
+	r.CameraEnteringDelay = d.float32()
+	r.CameraEnteringDuration = d.float32()
+	r.CameraExitingDelay = d.float32()
+	r.CameraExitingDuration = d.float32()
+	r.CameraOffsetX = d.float32()
+	r.CameraOffsetY = d.float32()
+	r.CameraOffsetZ = d.float32()
+	r.CameraPosChaseRate = d.float32()
+	r.CameraFacingChaseRate = d.float32()
+	r.CameraEnteringZoom = d.float32()
+	r.CameraSeatZoomMin = d.float32()
+	r.CameraSeatZoomMax = d.float32()
+}
+
+func (r *VehicleseatRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Flags)
+	e.int32(r.AttachmentID)
+	e.float32(r.AttachmentOffsetX)
+	e.float32(r.AttachmentOffsetY)
+	e.float32(r.AttachmentOffsetZ)
+	e.float32(r.EnterPreDelay)
+	e.float32(r.EnterSpeed)
+	e.float32(r.EnterGravity)
+	e.float32(r.EnterMinDuration)
+	e.float32(r.EnterMaxDuration)
+	e.float32(r.EnterMinArcHeight)
+	e.float32(r.EnterMaxArcHeight)
+	e.int32(r.EnterAnimStart)
+	e.int32(r.EnterAnimLoop)
+	e.int32(r.RideAnimStart)
+	e.int32(r.RideAnimLoop)
+	e.int32(r.RideUpperAnimStart)
+	e.int32(r.RideUpperAnimLoop)
+	e.float32(r.ExitPreDelay)
+	e.float32(r.ExitSpeed)
+	e.float32(r.ExitGravity)
+	e.float32(r.ExitMinDuration)
+	e.float32(r.ExitMaxDuration)
+	e.float32(r.ExitMinArcHeight)
+	e.float32(r.ExitMaxArcHeight)
+	e.int32(r.ExitAnimStart)
+	e.int32(r.ExitAnimLoop)
+	e.int32(r.ExitAnimEnd)
+	e.float32(r.PassengerYaw)
+	e.float32(r.PassengerPitch)
+	e.float32(r.PassengerRoll)
+	e.int32(r.PassengerAttachmentID)
+	e.int32(r.VehicleEnterAnim)
+	e.int32(r.VehicleExitAnim)
+	e.int32(r.VehicleRideAnimLoop)
+	e.int32(r.VehicleEnterAnimBone)
+	e.int32(r.VehicleExitAnimBone)
+	e.int32(r.VehicleRideAnimLoopBone)
+	e.float32(r.VehicleEnterAnimDelay)
+	e.float32(r.VehicleExitAnimDelay)
+	e.uint32(r.VehicleAbilityDisplay)
+	e.uint32(r.EnterUISoundID)
+	e.uint32(r.ExitUISoundID)
+	e.int32(r.UISkin)
+	e.uint32(r.FlagsB)
+	e.float32(r.CameraEnteringDelay)
+	e.float32(r.CameraEnteringDuration)
+	e.float32(r.CameraExitingDelay)
+	e.float32(r.CameraExitingDuration)
+	e.float32(r.CameraOffsetX)
+	e.float32(r.CameraOffsetY)
+	e.float32(r.CameraOffsetZ)
+	e.float32(r.CameraPosChaseRate)
+	e.float32(r.CameraFacingChaseRate)
+	e.float32(r.CameraEnteringZoom)
+	e.float32(r.CameraSeatZoomMin)
+	e.float32(r.CameraSeatZoomMax)
+}
@@ -0,0 +1,64 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// WmoareatableFile is the client file WmoareatableRecord is read from.
+const WmoareatableFile = "Wmoareatable.dbc"
+
+// WmoareatableRecord is one record of Wmoareatable.dbc.
+type WmoareatableRecord struct {
+	ID                          uint32
+	WmoID                       int32
+	NameSetID                   int32
+	WmoGroupID                  int32
+	SoundProviderPref           uint32
+	SoundProviderPrefUnderwater uint32
+	AmbienceID                  uint32
+	ZoneMusic                   uint32
+	IntroSound                  uint32
+	Flags                       uint32
+	AreaTableID                 uint32
+	AreaName                    Loc
+}
+
+// ReadWmoareatable reads every record of Wmoareatable.dbc from r.
+func ReadWmoareatable(r io.Reader) ([]WmoareatableRecord, error) {
+	return readRecords[WmoareatableRecord](r, WmoareatableFile, 112)
+}
+
+// WriteWmoareatable writes records to w in Wmoareatable.dbc format.
+func WriteWmoareatable(w io.Writer, records []WmoareatableRecord) error {
+	return writeRecords(w, records, 112, 28)
+}
+
+func (r *WmoareatableRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.WmoID = d.int32()
+	r.NameSetID = d.int32()
+	r.WmoGroupID = d.int32()
+	r.SoundProviderPref = d.uint32()
+	r.SoundProviderPrefUnderwater = d.uint32()
+	r.AmbienceID = d.uint32()
+	r.ZoneMusic = d.uint32()
+	r.IntroSound = d.uint32()
+	r.Flags = d.uint32()
+	r.AreaTableID = d.uint32()
+	r.AreaName = d.loc()
+}
+
+func (r *WmoareatableRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.WmoID)
+	e.int32(r.NameSetID)
+	e.int32(r.WmoGroupID)
+	e.uint32(r.SoundProviderPref)
+	e.uint32(r.SoundProviderPrefUnderwater)
+	e.uint32(r.AmbienceID)
+	e.uint32(r.ZoneMusic)
+	e.uint32(r.IntroSound)
+	e.uint32(r.Flags)
+	e.uint32(r.AreaTableID)
+	e.loc(r.AreaName)
+}
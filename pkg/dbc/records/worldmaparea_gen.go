@@ -0,0 +1,61 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// WorldmapareaFile is the client file WorldmapareaRecord is read from.
+const WorldmapareaFile = "Worldmaparea.dbc"
+
+// WorldmapareaRecord is one record of Worldmaparea.dbc.
+type WorldmapareaRecord struct {
+	ID                  uint32
+	MapID               int32
+	AreaID              uint32
+	Name                uint32
+	LocLeft             float32
+	LocRight            float32
+	LocTop              float32
+	LocBottom           float32
+	DisplayMapID        int32
+	DefaultDungeonFloor uint32
+	ParentWorldMapID    uint32
+}
+
+// ReadWorldmaparea reads every record of Worldmaparea.dbc from r.
+func ReadWorldmaparea(r io.Reader) ([]WorldmapareaRecord, error) {
+	return readRecords[WorldmapareaRecord](r, WorldmapareaFile, 44)
+}
+
+// WriteWorldmaparea writes records to w in Worldmaparea.dbc format.
+func WriteWorldmaparea(w io.Writer, records []WorldmapareaRecord) error {
+	return writeRecords(w, records, 44, 11)
+}
+
+func (r *WorldmapareaRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.MapID = d.int32()
+	r.AreaID = d.uint32()
+	r.Name = d.uint32()
+	r.LocLeft = d.float32()
+	r.LocRight = d.float32()
+	r.LocTop = d.float32()
+	r.LocBottom = d.float32()
+	r.DisplayMapID = d.int32()
+	r.DefaultDungeonFloor = d.uint32()
+	r.ParentWorldMapID = d.uint32()
+}
+
+func (r *WorldmapareaRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.int32(r.MapID)
+	e.uint32(r.AreaID)
+	e.uint32(r.Name)
+	e.float32(r.LocLeft)
+	e.float32(r.LocRight)
+	e.float32(r.LocTop)
+	e.float32(r.LocBottom)
+	e.int32(r.DisplayMapID)
+	e.uint32(r.DefaultDungeonFloor)
+	e.uint32(r.ParentWorldMapID)
+}
@@ -0,0 +1,79 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// WorldmapoverlayFile is the client file WorldmapoverlayRecord is read from.
+const WorldmapoverlayFile = "Worldmapoverlay.dbc"
+
+// WorldmapoverlayRecord is one record of Worldmapoverlay.dbc.
+type WorldmapoverlayRecord struct {
+	ID             uint32
+	WorldMapAreaID uint32
+	AreaID1        int32
+	AreaID2        int32
+	AreaID3        int32
+	AreaID4        int32
+	MapPointX      uint32
+	MapPointY      uint32
+	TextureName    uint32
+	TextureWidth1  uint32
+	TextureWidth2  uint32
+	TextureHeight1 uint32
+	TextureHeight2 uint32
+	OffsetX1       uint32
+	OffsetX2       uint32
+	OffsetY1       uint32
+	OffsetY2       uint32
+}
+
+// ReadWorldmapoverlay reads every record of Worldmapoverlay.dbc from r.
+func ReadWorldmapoverlay(r io.Reader) ([]WorldmapoverlayRecord, error) {
+	return readRecords[WorldmapoverlayRecord](r, WorldmapoverlayFile, 68)
+}
+
+// WriteWorldmapoverlay writes records to w in Worldmapoverlay.dbc format.
+func WriteWorldmapoverlay(w io.Writer, records []WorldmapoverlayRecord) error {
+	return writeRecords(w, records, 68, 17)
+}
+
+func (r *WorldmapoverlayRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.WorldMapAreaID = d.uint32()
+	r.AreaID1 = d.int32()
+	r.AreaID2 = d.int32()
+	r.AreaID3 = d.int32()
+	r.AreaID4 = d.int32()
+	r.MapPointX = d.uint32()
+	r.MapPointY = d.uint32()
+	r.TextureName = d.uint32()
+	r.TextureWidth1 = d.uint32()
+	r.TextureWidth2 = d.uint32()
+	r.TextureHeight1 = d.uint32()
+	r.TextureHeight2 = d.uint32()
+	r.OffsetX1 = d.uint32()
+	r.OffsetX2 = d.uint32()
+	r.OffsetY1 = d.uint32()
+	r.OffsetY2 = d.uint32()
+}
+
+func (r *WorldmapoverlayRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.WorldMapAreaID)
+	e.int32(r.AreaID1)
+	e.int32(r.AreaID2)
+	e.int32(r.AreaID3)
+	e.int32(r.AreaID4)
+	e.uint32(r.MapPointX)
+	e.uint32(r.MapPointY)
+	e.uint32(r.TextureName)
+	e.uint32(r.TextureWidth1)
+	e.uint32(r.TextureWidth2)
+	e.uint32(r.TextureHeight1)
+	e.uint32(r.TextureHeight2)
+	e.uint32(r.OffsetX1)
+	e.uint32(r.OffsetX2)
+	e.uint32(r.OffsetY1)
+	e.uint32(r.OffsetY2)
+}
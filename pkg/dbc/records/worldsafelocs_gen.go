@@ -0,0 +1,46 @@
+// Code generated by gen.go from the embedded DBC metas; DO NOT EDIT.
+
+package records
+
+import "io"
+
+// WorldsafelocsFile is the client file WorldsafelocsRecord is read from.
+const WorldsafelocsFile = "Worldsafelocs.dbc"
+
+// WorldsafelocsRecord is one record of Worldsafelocs.dbc.
+type WorldsafelocsRecord struct {
+	ID        uint32
+	Continent uint32
+	LocX      float32
+	LocY      float32
+	LocZ      float32
+	AreaName  Loc
+}
+
+// ReadWorldsafelocs reads every record of Worldsafelocs.dbc from r.
+func ReadWorldsafelocs(r io.Reader) ([]WorldsafelocsRecord, error) {
+	return readRecords[WorldsafelocsRecord](r, WorldsafelocsFile, 88)
+}
+
+// WriteWorldsafelocs writes records to w in Worldsafelocs.dbc format.
+func WriteWorldsafelocs(w io.Writer, records []WorldsafelocsRecord) error {
+	return writeRecords(w, records, 88, 22)
+}
+
+func (r *WorldsafelocsRecord) decode(d *decoder) {
+	r.ID = d.uint32()
+	r.Continent = d.uint32()
+	r.LocX = d.float32()
+	r.LocY = d.float32()
+	r.LocZ = d.float32()
+	r.AreaName = d.loc()
+}
+
+func (r *WorldsafelocsRecord) encode(e *encoder) {
+	e.uint32(r.ID)
+	e.uint32(r.Continent)
+	e.float32(r.LocX)
+	e.float32(r.LocY)
+	e.float32(r.LocZ)
+	e.loc(r.AreaName)
+}
@@ -0,0 +1,123 @@
+package dbc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	idbc "github.com/suprsokr/mithril/internal/dbc"
+)
+
+// Writer writes a DBC file one Row at a time, building the string block as
+// it goes: each distinct string is stored once and the empty string is at
+// offset 0. The header and string block are written by Close.
+//
+// If the underlying writer is an io.WriteSeeker that can seek (such as an
+// *os.File on disk), records are written as they come and the header is
+// filled in at the end. Otherwise, pipes included, the records are buffered
+// until Close.
+type Writer struct {
+	w       io.Writer
+	seeker  io.WriteSeeker
+	start   int64
+	meta    *Meta
+	cols    []Column
+	strings *idbc.StringBlock
+	buf     []byte // encoded records not yet written
+	count   uint32
+	err     error
+	closed  bool
+}
+
+// NewWriter returns a Writer that writes a file of the given schema to w.
+func NewWriter(w io.Writer, meta *Meta) *Writer {
+	dw := &Writer{
+		w:       w,
+		meta:    meta,
+		cols:    Columns(meta),
+		strings: idbc.NewStringBlock(),
+	}
+	if ws, ok := w.(io.WriteSeeker); ok {
+		// An *os.File may be a pipe or terminal that can't seek, in which
+		// case the records are buffered as for any other writer
+		if start, err := ws.Seek(0, io.SeekCurrent); err == nil {
+			// Reserve room for the header, filled in by Close
+			_, err = ws.Write(make([]byte, 20))
+			dw.seeker, dw.start, dw.err = ws, start, err
+		}
+	}
+	return dw
+}
+
+// WriteRow adds one record. The row must have one value per column of the
+// schema; values are converted to the column's type, so an int or a numeric
+// string is fine for a uint32 column.
+func (w *Writer) WriteRow(row Row) error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.closed {
+		return fmt.Errorf("%s: write after Close", w.meta.File)
+	}
+	if len(row) != len(w.cols) {
+		return fmt.Errorf("%s: row has %d values, schema has %d columns", w.meta.File, len(row), len(w.cols))
+	}
+	rec := idbc.EncodeRow(row, w.cols, w.strings)
+	w.buf = idbc.AppendRecord(w.buf, rec, w.meta)
+	w.count++
+
+	if w.seeker != nil {
+		_, w.err = w.seeker.Write(w.buf)
+		w.buf = w.buf[:0]
+	}
+	return w.err
+}
+
+// Count returns the number of records written so far.
+func (w *Writer) Count() int {
+	return int(w.count)
+}
+
+// Close writes the string block and the header. It does not close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	header := make([]byte, 20)
+	copy(header[0:4], "WDBC")
+	binary.LittleEndian.PutUint32(header[4:8], w.count)
+	binary.LittleEndian.PutUint32(header[8:12], FieldCount(w.meta))
+	binary.LittleEndian.PutUint32(header[12:16], RecordSize(w.meta))
+	binary.LittleEndian.PutUint32(header[16:20], uint32(w.strings.Len()))
+
+	if w.seeker == nil {
+		for _, chunk := range [][]byte{header, w.buf, w.strings.Bytes()} {
+			if _, err := w.w.Write(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if _, err := w.seeker.Write(w.strings.Bytes()); err != nil {
+		return err
+	}
+	end, err := w.seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := w.seeker.Seek(w.start, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := w.seeker.Write(header); err != nil {
+		return err
+	}
+	_, err = w.seeker.Seek(end, io.SeekStart)
+	return err
+}